@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/jar"
 	"github.com/databricks/cli/bundle/artifacts/whl"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
@@ -17,10 +18,12 @@ type mutatorFactory = func(name string) bundle.Mutator
 
 var buildMutators map[config.ArtifactType]mutatorFactory = map[config.ArtifactType]mutatorFactory{
 	config.ArtifactPythonWheel: whl.Build,
+	config.ArtifactJar:         jar.Build,
 }
 
 var prepareMutators map[config.ArtifactType]mutatorFactory = map[config.ArtifactType]mutatorFactory{
 	config.ArtifactPythonWheel: whl.Prepare,
+	config.ArtifactJar:         jar.Prepare,
 }
 
 func getBuildMutator(t config.ArtifactType, name string) bundle.Mutator {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/jar"
 	"github.com/databricks/cli/bundle/artifacts/whl"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/log"
@@ -29,5 +30,6 @@ func (m *autodetect) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnosti
 
 	return bundle.Apply(ctx, b, bundle.Seq(
 		whl.DetectPackage(),
+		jar.DetectPackage(),
 	))
 }
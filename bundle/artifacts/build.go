@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/libs/diag"
 )
 
@@ -33,6 +34,12 @@ func (m *build) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 		return diag.Errorf("artifact doesn't exist: %s", m.name)
 	}
 
+	// Generic file artifacts are not located after the build,
+	// so the files to upload must be specified explicitly.
+	if artifact.Type == config.ArtifactGenericFile && len(artifact.Files) == 0 {
+		return diag.Errorf("misconfigured artifact: please specify 'files' property for artifact of type %s", config.ArtifactGenericFile)
+	}
+
 	var mutators []bundle.Mutator
 
 	// Skip building if build command is not specified or infered
@@ -53,5 +60,9 @@ func (m *build) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 	// if we do it before, any files that are generated by build command will
 	// not be included into artifact.Files and thus will not be uploaded.
 	mutators = append(mutators, &expandGlobs{name: m.name})
+
+	// Remote paths are known before the files are uploaded, which allows
+	// for references to them in the rest of the configuration.
+	mutators = append(mutators, &resolveRemotePaths{name: m.name})
 	return bundle.Apply(ctx, b, bundle.Seq(mutators...))
 }
@@ -0,0 +1,70 @@
+package artifacts
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/internal/bundletest"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGenericFileResolvesRemotePaths(t *testing.T) {
+	tmpDir := t.TempDir()
+	testutil.Touch(t, tmpDir, "dist", "init.sh")
+	testutil.Touch(t, tmpDir, "dist", "data.zip")
+
+	b := &bundle.Bundle{
+		RootPath: tmpDir,
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "/Users/foo@bar.com/artifacts",
+			},
+			Artifacts: config.Artifacts{
+				"test": {
+					Type: config.ArtifactGenericFile,
+					Files: []config.ArtifactFile{
+						{Source: "./dist/*"},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, "artifacts", filepath.Join(tmpDir, "databricks.yml"))
+
+	diags := bundle.Apply(context.Background(), b, bundle.Seq(PrepareAll(), BuildAll()))
+	require.NoError(t, diags.Error())
+
+	a := b.Config.Artifacts["test"]
+	require.Len(t, a.Files, 2)
+	assert.Equal(t, filepath.Join(tmpDir, "dist", "data.zip"), a.Files[0].Source)
+	assert.Equal(t, "/Workspace/Users/foo@bar.com/artifacts/.internal/data.zip", a.Files[0].RemotePath)
+	assert.Equal(t, filepath.Join(tmpDir, "dist", "init.sh"), a.Files[1].Source)
+	assert.Equal(t, "/Workspace/Users/foo@bar.com/artifacts/.internal/init.sh", a.Files[1].RemotePath)
+}
+
+func TestBuildGenericFileRequiresFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	b := &bundle.Bundle{
+		RootPath: tmpDir,
+		Config: config.Root{
+			Artifacts: config.Artifacts{
+				"test": {
+					Type:         config.ArtifactGenericFile,
+					BuildCommand: "zip -r data.zip data",
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, "artifacts", filepath.Join(tmpDir, "databricks.yml"))
+
+	diags := bundle.Apply(context.Background(), b, bundle.Seq(PrepareAll(), BuildAll()))
+	assert.EqualError(t, diags.Error(), "misconfigured artifact: please specify 'files' property for artifact of type file")
+}
@@ -0,0 +1,89 @@
+package jar
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/libraries"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+type detectPkg struct {
+}
+
+func DetectPackage() bundle.Mutator {
+	return &detectPkg{}
+}
+
+func (m *detectPkg) Name() string {
+	return "artifacts.jar.AutoDetect"
+}
+
+func (m *detectPkg) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	if !hasLocalJarLibraries(b) {
+		log.Infof(ctx, "No local jar libraries in databricks.yml config, skipping auto detect")
+		return nil
+	}
+	log.Infof(ctx, "Detecting JAR project...")
+
+	p, ok := detectProject(b.RootPath)
+	if !ok {
+		log.Infof(ctx, "No Maven, sbt or Gradle project found at bundle root folder")
+		return nil
+	}
+
+	log.Infof(ctx, fmt.Sprintf("Found %s project at %s", p.tool, b.RootPath))
+	name := extractProjectName(b.RootPath, p)
+
+	if b.Config.Artifacts == nil {
+		b.Config.Artifacts = make(map[string]*config.Artifact)
+	}
+
+	// Don't replace an artifact that was detected for another project type.
+	if _, ok := b.Config.Artifacts[name]; ok {
+		name = name + "_jar"
+	}
+
+	pkgPath, err := filepath.Abs(b.RootPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	b.Config.Artifacts[name] = &config.Artifact{
+		Path: pkgPath,
+		Type: config.ArtifactJar,
+	}
+
+	return nil
+}
+
+func hasLocalJarLibraries(b *bundle.Bundle) bool {
+	isLocalJar := func(libs []compute.Library) bool {
+		for _, l := range libs {
+			if libraries.IsLibraryLocal(l.Jar) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, job := range b.Config.Resources.Jobs {
+		if job == nil || job.JobSettings == nil {
+			continue
+		}
+		for _, task := range job.Tasks {
+			if isLocalJar(task.Libraries) {
+				return true
+			}
+			if task.ForEachTask != nil && isLocalJar(task.ForEachTask.Task.Libraries) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
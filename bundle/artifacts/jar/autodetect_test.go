@@ -0,0 +1,67 @@
+package jar
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bundleWithJarLibrary(dir, jar string) *bundle.Bundle {
+	return &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									TaskKey: "task",
+									SparkJarTask: &jobs.SparkJarTask{
+										MainClassName: "com.example.Main",
+									},
+									Libraries: []compute.Library{
+										{Jar: jar},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDetectPackage(t *testing.T) {
+	dir := t.TempDir()
+	pom := `<project><artifactId>my-app</artifactId></project>`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pom.xml"), []byte(pom), 0644))
+
+	b := bundleWithJarLibrary(dir, "./target/*.jar")
+	diags := bundle.Apply(context.Background(), b, DetectPackage())
+	require.NoError(t, diags.Error())
+
+	require.Contains(t, b.Config.Artifacts, "my-app")
+	assert.Equal(t, config.ArtifactJar, b.Config.Artifacts["my-app"].Type)
+	assert.Equal(t, dir, b.Config.Artifacts["my-app"].Path)
+}
+
+func TestDetectPackageSkipsRemoteLibraries(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pom.xml"), []byte("<project/>"), 0644))
+
+	b := bundleWithJarLibrary(dir, "/Volumes/main/default/libs/app.jar")
+	diags := bundle.Apply(context.Background(), b, DetectPackage())
+	require.NoError(t, diags.Error())
+	assert.Empty(t, b.Config.Artifacts)
+}
@@ -0,0 +1,66 @@
+package jar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/log"
+)
+
+type build struct {
+	name string
+}
+
+func Build(name string) bundle.Mutator {
+	return &build{
+		name: name,
+	}
+}
+
+func (m *build) Name() string {
+	return fmt.Sprintf("artifacts.jar.Build(%s)", m.name)
+}
+
+func (m *build) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	artifact, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return diag.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Building %s...", m.name))
+
+	out, err := artifact.Build(ctx)
+	if err != nil {
+		return diag.Errorf("build failed %s, error: %v, output: %s", m.name, err, out)
+	}
+	log.Infof(ctx, "Build succeeded")
+
+	// Files that are specified explicitly are expanded after the build.
+	if len(artifact.Files) > 0 {
+		return nil
+	}
+
+	p, ok := detectProject(artifact.Path)
+	if !ok {
+		return diag.Errorf("cannot find built jar for artifact %s: no Maven, sbt or Gradle project found in %s; please specify 'files' property", m.name, artifact.Path)
+	}
+
+	jars, err := p.findJars(artifact.Path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(jars) == 0 {
+		return diag.Errorf("cannot find built jar in %s for artifact %s", artifact.Path, m.name)
+	}
+	for _, jar := range jars {
+		artifact.Files = append(artifact.Files, config.ArtifactFile{
+			Source: jar,
+		})
+	}
+
+	return nil
+}
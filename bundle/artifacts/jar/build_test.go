@@ -0,0 +1,81 @@
+package jar
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareAndBuild(t *testing.T) {
+	dir := t.TempDir()
+	testutil.Touch(t, dir, "pom.xml")
+	testutil.Touch(t, dir, "target", "app-0.9.jar")
+
+	b := &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Artifacts: config.Artifacts{
+				"app": {
+					Type:         config.ArtifactJar,
+					Path:         dir,
+					BuildCommand: "touch target/app-1.0.jar target/app-1.0-sources.jar",
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, bundle.Seq(Prepare("app"), Build("app")))
+	require.NoError(t, diags.Error())
+
+	// The jar of the previous build is removed and not uploaded.
+	a := b.Config.Artifacts["app"]
+	require.Len(t, a.Files, 1)
+	assert.Equal(t, filepath.Join(dir, "target", "app-1.0.jar"), a.Files[0].Source)
+	assert.NoFileExists(t, filepath.Join(dir, "target", "app-0.9.jar"))
+}
+
+func TestInferBuildCommand(t *testing.T) {
+	dir := t.TempDir()
+	testutil.Touch(t, dir, "build.sbt")
+
+	b := &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Artifacts: config.Artifacts{
+				"app": {
+					Type: config.ArtifactJar,
+					Path: dir,
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, InferBuildCommand("app"))
+	require.NoError(t, diags.Error())
+	assert.Equal(t, "sbt package", b.Config.Artifacts["app"].BuildCommand)
+}
+
+func TestInferBuildCommandNoProject(t *testing.T) {
+	dir := t.TempDir()
+
+	b := &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Artifacts: config.Artifacts{
+				"app": {
+					Type: config.ArtifactJar,
+					Path: dir,
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, InferBuildCommand("app"))
+	assert.ErrorContains(t, diags.Error(), "no Maven, sbt or Gradle project found")
+}
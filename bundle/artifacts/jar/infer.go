@@ -0,0 +1,38 @@
+package jar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+)
+
+type infer struct {
+	name string
+}
+
+func (m *infer) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	artifact := b.Config.Artifacts[m.name]
+
+	p, ok := detectProject(artifact.Path)
+	if !ok {
+		return diag.Errorf("unable to infer build command for artifact %s: no Maven, sbt or Gradle project found in %s; please specify 'build' or 'files' property", m.name, artifact.Path)
+	}
+
+	// The built jars are located after the build because their names
+	// depend on the project's version and build configuration.
+	artifact.BuildCommand = p.command
+
+	return nil
+}
+
+func (m *infer) Name() string {
+	return fmt.Sprintf("artifacts.jar.Infer(%s)", m.name)
+}
+
+func InferBuildCommand(name string) bundle.Mutator {
+	return &infer{
+		name: name,
+	}
+}
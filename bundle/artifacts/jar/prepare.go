@@ -0,0 +1,58 @@
+package jar
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/log"
+)
+
+type prepare struct {
+	name string
+}
+
+func Prepare(name string) bundle.Mutator {
+	return &prepare{
+		name: name,
+	}
+}
+
+func (m *prepare) Name() string {
+	return fmt.Sprintf("artifacts.jar.Prepare(%s)", m.name)
+}
+
+func (m *prepare) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	artifact, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return diag.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	// If there is no build command for the artifact or its files are specified
+	// explicitly, the jars are not located after the build and there is nothing to clean up.
+	if artifact.BuildCommand == "" || len(artifact.Files) > 0 {
+		return nil
+	}
+
+	p, ok := detectProject(artifact.Path)
+	if !ok {
+		return nil
+	}
+
+	// Remove jars from previous builds so that stale versions are not uploaded.
+	// The rest of the build output is kept to allow for incremental builds.
+	jars, err := p.findJars(artifact.Path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, jar := range jars {
+		err := os.Remove(jar)
+		if err != nil {
+			log.Infof(ctx, "Failed to remove %s: %v", jar, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package jar
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// project describes a JVM project built with one of the supported build tools.
+type project struct {
+	// Name of the build tool, for example "maven".
+	tool string
+
+	// Command that builds the project's jar.
+	command string
+
+	// Glob patterns relative to the project directory that match built jars.
+	outputs []string
+}
+
+// detectProject returns the project in the specified directory,
+// or false if the directory doesn't contain a Maven, sbt or Gradle project.
+func detectProject(dir string) (*project, bool) {
+	switch {
+	case exists(dir, "pom.xml"):
+		return &project{
+			tool:    "maven",
+			command: "mvn --batch-mode package -DskipTests",
+			outputs: []string{filepath.Join("target", "*.jar")},
+		}, true
+
+	case exists(dir, "build.sbt"):
+		return &project{
+			tool:    "sbt",
+			command: "sbt package",
+			outputs: []string{filepath.Join("target", "scala-*", "*.jar")},
+		}, true
+
+	case exists(dir, "build.gradle") || exists(dir, "build.gradle.kts"):
+		command := "gradle jar"
+		if exists(dir, "gradlew") {
+			command = "./gradlew jar"
+		}
+		return &project{
+			tool:    "gradle",
+			command: command,
+			outputs: []string{filepath.Join("build", "libs", "*.jar")},
+		}, true
+	}
+
+	return nil, false
+}
+
+// findJars returns the jars built for the project in the specified directory.
+// Auxiliary jars such as sources, javadoc and test jars are excluded.
+func (p *project) findJars(dir string) ([]string, error) {
+	var out []string
+	for _, pattern := range p.outputs {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			if isAuxiliaryJar(filepath.Base(match)) {
+				continue
+			}
+			out = append(out, match)
+		}
+	}
+
+	slices.Sort(out)
+	return out, nil
+}
+
+func isAuxiliaryJar(name string) bool {
+	// The Maven shade plugin keeps the unshaded jar with this prefix.
+	if strings.HasPrefix(name, "original-") {
+		return true
+	}
+
+	for _, suffix := range []string{"-sources.jar", "-javadoc.jar", "-tests.jar"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	sbtNameRegex    = regexp.MustCompile(`(?m)^\s*name\s*:=\s*"([^"]+)"`)
+	gradleNameRegex = regexp.MustCompile(`(?m)^\s*rootProject\.name\s*=\s*['"]([^'"]+)['"]`)
+)
+
+// extractProjectName returns the name of the project in the specified directory.
+// It falls back to the name of the directory if the build files don't specify one.
+func extractProjectName(dir string, p *project) string {
+	var name string
+	switch p.tool {
+	case "maven":
+		name = extractMavenArtifactId(filepath.Join(dir, "pom.xml"))
+	case "sbt":
+		name = extractWithRegex(filepath.Join(dir, "build.sbt"), sbtNameRegex)
+	case "gradle":
+		name = extractWithRegex(filepath.Join(dir, "settings.gradle"), gradleNameRegex)
+		if name == "" {
+			name = extractWithRegex(filepath.Join(dir, "settings.gradle.kts"), gradleNameRegex)
+		}
+	}
+
+	if name == "" {
+		name = filepath.Base(dir)
+	}
+	return name
+}
+
+func extractMavenArtifactId(pomXml string) string {
+	data, err := os.ReadFile(pomXml)
+	if err != nil {
+		return ""
+	}
+
+	// Only direct children of the project element are decoded,
+	// so the artifactId of the parent and dependencies is ignored.
+	var pom struct {
+		ArtifactId string `xml:"artifactId"`
+	}
+	err = xml.Unmarshal(data, &pom)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(pom.ArtifactId)
+}
+
+func extractWithRegex(path string, r *regexp.Regexp) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	matches := r.FindSubmatch(data)
+	if len(matches) == 0 {
+		return ""
+	}
+	return string(matches[1])
+}
+
+func exists(dir, name string) bool {
+	_, err := os.Stat(filepath.Join(dir, name))
+	return err == nil
+}
@@ -0,0 +1,102 @@
+package jar
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectProject(t *testing.T) {
+	for _, tc := range []struct {
+		files   []string
+		tool    string
+		command string
+	}{
+		{[]string{"pom.xml"}, "maven", "mvn --batch-mode package -DskipTests"},
+		{[]string{"build.sbt"}, "sbt", "sbt package"},
+		{[]string{"build.gradle"}, "gradle", "gradle jar"},
+		{[]string{"build.gradle.kts", "gradlew"}, "gradle", "./gradlew jar"},
+	} {
+		t.Run(tc.files[0], func(t *testing.T) {
+			dir := t.TempDir()
+			for _, f := range tc.files {
+				testutil.Touch(t, dir, f)
+			}
+
+			p, ok := detectProject(dir)
+			require.True(t, ok)
+			assert.Equal(t, tc.tool, p.tool)
+			assert.Equal(t, tc.command, p.command)
+		})
+	}
+}
+
+func TestDetectProjectNotFound(t *testing.T) {
+	dir := t.TempDir()
+	testutil.Touch(t, dir, "setup.py")
+
+	_, ok := detectProject(dir)
+	assert.False(t, ok)
+}
+
+func TestFindJars(t *testing.T) {
+	dir := t.TempDir()
+	testutil.Touch(t, dir, "pom.xml")
+	testutil.Touch(t, dir, "target", "app-1.0.jar")
+	testutil.Touch(t, dir, "target", "original-app-1.0.jar")
+	testutil.Touch(t, dir, "target", "app-1.0-sources.jar")
+	testutil.Touch(t, dir, "target", "app-1.0-javadoc.jar")
+	testutil.Touch(t, dir, "target", "classes", "App.class")
+
+	p, ok := detectProject(dir)
+	require.True(t, ok)
+
+	jars, err := p.findJars(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "target", "app-1.0.jar")}, jars)
+}
+
+func TestExtractProjectName(t *testing.T) {
+	for _, tc := range []struct {
+		file     string
+		content  string
+		expected string
+	}{
+		{
+			"pom.xml",
+			`<project>
+  <parent><artifactId>parent</artifactId></parent>
+  <artifactId>my-app</artifactId>
+  <dependencies><dependency><artifactId>spark-sql</artifactId></dependency></dependencies>
+</project>`,
+			"my-app",
+		},
+		{"build.sbt", "ThisBuild / scalaVersion := \"2.12.18\"\nname := \"my-sbt-app\"\n", "my-sbt-app"},
+		{"settings.gradle", "rootProject.name = 'my-gradle-app'\n", "my-gradle-app"},
+	} {
+		t.Run(tc.file, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, tc.file), []byte(tc.content), 0644))
+			if tc.file == "settings.gradle" {
+				testutil.Touch(t, dir, "build.gradle")
+			}
+
+			p, ok := detectProject(dir)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, extractProjectName(dir, p))
+		})
+	}
+}
+
+func TestExtractProjectNameFallback(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my-project")
+	testutil.Touch(t, dir, "build.sbt")
+
+	p, ok := detectProject(dir)
+	require.True(t, ok)
+	assert.Equal(t, "my-project", extractProjectName(dir, p))
+}
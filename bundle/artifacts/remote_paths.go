@@ -0,0 +1,42 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/libraries"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/log"
+)
+
+// resolveRemotePaths sets the remote path of the artifact's files to the
+// location they are uploaded to, such that references like
+// ${artifacts.<name>.files[0].remote_path} can be resolved after the build.
+type resolveRemotePaths struct {
+	name string
+}
+
+func (m *resolveRemotePaths) Name() string {
+	return fmt.Sprintf("artifacts.ResolveRemotePaths(%s)", m.name)
+}
+
+func (m *resolveRemotePaths) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	artifact, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return diag.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	uploadPath, err := libraries.GetUploadBasePath(b)
+	if err != nil {
+		log.Debugf(ctx, "Not resolving remote paths for artifact %s: %v", m.name, err)
+		return nil
+	}
+
+	for i := range artifact.Files {
+		f := &artifact.Files[i]
+		f.RemotePath = libraries.RemotePath(uploadPath, f.Source)
+	}
+
+	return nil
+}
@@ -26,6 +26,15 @@ func (m *cleanUp) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics
 		return diag.FromErr(err)
 	}
 
+	// There is nothing to clean up in a volume that hasn't been created yet.
+	volumePath, err := libraries.MissingBundleVolume(ctx, b, uploadPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if volumePath != "" {
+		return nil
+	}
+
 	client, err := libraries.GetFilerForLibraries(b.WorkspaceClient(), uploadPath)
 	if err != nil {
 		return diag.FromErr(err)
@@ -73,6 +73,10 @@ type Bundle struct {
 
 	Plan *terraform.Plan
 
+	// Local libraries that are uploaded after resources are deployed, because they are
+	// uploaded to a volume that is defined in the bundle and doesn't exist yet.
+	DeferredLibraries []string
+
 	// if true, we skip approval checks for deploy, destroy resources and delete
 	// files
 	AutoApprove bool
@@ -11,7 +11,14 @@ type Artifacts map[string]*Artifact
 
 type ArtifactType string
 
-const ArtifactPythonWheel ArtifactType = `whl`
+const (
+	ArtifactPythonWheel ArtifactType = `whl`
+	ArtifactJar         ArtifactType = `jar`
+
+	// Generic build output (for example, an archive or an init script)
+	// that is uploaded as-is and referenced through its remote path.
+	ArtifactGenericFile ArtifactType = `file`
+)
 
 type ArtifactFile struct {
 	Source     string `json:"source"`
@@ -11,10 +11,6 @@ type Bundle struct {
 	// Default cluster to run commands on (Python, Scala).
 	// DefaultCluster string `json:"default_cluster,omitempty"`
 
-	// TODO
-	// Default warehouse to run SQL on.
-	// DefaultWarehouse string `json:"default_warehouse,omitempty"`
-
 	// Target is set by the mutator that selects the target.
 	Target string `json:"target,omitempty" bundle:"readonly"`
 
@@ -41,6 +37,9 @@ type Bundle struct {
 	// Overrides the compute used for jobs and other supported assets.
 	ComputeID string `json:"compute_id,omitempty"`
 
+	// SQL warehouse to run SQL files on with `bundle run`.
+	WarehouseID string `json:"warehouse_id,omitempty"`
+
 	// Deployment section specifies deployment related configuration for bundle
 	Deployment Deployment `json:"deployment,omitempty"`
 
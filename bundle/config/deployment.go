@@ -1,5 +1,16 @@
 package config
 
+type Engine string
+
+const (
+	// EngineTerraform deploys resources using Terraform. This is the default.
+	EngineTerraform Engine = "terraform"
+
+	// EngineDirect deploys resources by calling the Databricks APIs directly,
+	// without requiring the Terraform binary.
+	EngineDirect Engine = "direct"
+)
+
 type Deployment struct {
 	// FailOnActiveRuns specifies whether to fail the deployment if there are
 	// running jobs or pipelines in the workspace. Defaults to false.
@@ -7,4 +18,12 @@ type Deployment struct {
 
 	// Lock configures locking behavior on deployment.
 	Lock Lock `json:"lock,omitempty"`
+
+	// Engine selects how resources are deployed. Defaults to "terraform".
+	Engine Engine `json:"engine,omitempty"`
+}
+
+// IsDirect returns true if resources are deployed without Terraform.
+func (d Deployment) IsDirect() bool {
+	return d.Engine == EngineDirect
 }
@@ -0,0 +1,28 @@
+package generate
+
+import (
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+)
+
+var experimentOrder = yamlsaver.NewOrder([]string{"name", "artifact_location", "tags"})
+
+func ConvertExperimentToValue(experiment *ml.Experiment) (dyn.Value, error) {
+	value := make(map[string]dyn.Value)
+
+	if len(experiment.Tags) > 0 {
+		tags := make([]dyn.Value, 0)
+		for _, tag := range experiment.Tags {
+			tags = append(tags, convertTagToValue(tag.Key, tag.Value))
+		}
+		value["tags"] = dyn.NewValue(tags, []dyn.Location{{Line: experimentOrder.Get("tags")}})
+	}
+
+	// We ignore the following fields because they are read-only:
+	// - experiment_id
+	// - lifecycle_stage
+	// - creation_time
+	// - last_update_time
+	return yamlsaver.ConvertToMapValue(experiment, experimentOrder, []string{"experiment_id", "lifecycle_stage", "creation_time", "last_update_time"}, value)
+}
@@ -0,0 +1,105 @@
+package generate
+
+import (
+	"encoding/json"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+)
+
+var modelServingEndpointOrder = yamlsaver.NewOrder([]string{"name", "config", "tags"})
+var endpointConfigOrder = yamlsaver.NewOrder([]string{"served_entities", "served_models", "traffic_config", "auto_capture_config"})
+var servedEntityOrder = yamlsaver.NewOrder([]string{
+	"name",
+	"entity_name",
+	"entity_version",
+	"model_name",
+	"model_version",
+	"external_model",
+	"workload_type",
+	"workload_size",
+	"scale_to_zero_enabled",
+	"min_provisioned_throughput",
+	"max_provisioned_throughput",
+	"instance_profile_arn",
+	"environment_vars",
+})
+
+func ConvertModelServingEndpointToValue(endpoint *serving.ServingEndpointDetailed) (dyn.Value, error) {
+	value := make(map[string]dyn.Value)
+
+	create := serving.CreateServingEndpoint{
+		Name:           endpoint.Name,
+		RouteOptimized: endpoint.RouteOptimized,
+		Tags:           endpoint.Tags,
+	}
+
+	// The output type of the configuration includes read-only fields, such as the
+	// state of the served entities. We drop these by converting through JSON.
+	if endpoint.Config != nil {
+		buf, err := json.Marshal(endpoint.Config)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+		err = json.Unmarshal(buf, &create.Config)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+
+		v, err := convertEndpointConfigToValue(&create.Config)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+		value["config"] = dyn.NewValue(v.Value(), []dyn.Location{{Line: modelServingEndpointOrder.Get("config")}})
+	}
+
+	if len(endpoint.Tags) > 0 {
+		tags := make([]dyn.Value, 0)
+		for _, tag := range endpoint.Tags {
+			tags = append(tags, convertTagToValue(tag.Key, tag.Value))
+		}
+		value["tags"] = dyn.NewValue(tags, []dyn.Location{{Line: modelServingEndpointOrder.Get("tags")}})
+	}
+
+	return yamlsaver.ConvertToMapValue(create, modelServingEndpointOrder, []string{}, value)
+}
+
+// convertTagToValue converts a tag that is defined as a key and value pair.
+func convertTagToValue(key string, value string) dyn.Value {
+	return dyn.V(map[string]dyn.Value{
+		"key":   dyn.NewValue(key, []dyn.Location{{Line: 0}}), // We use Line: 0 to ensure that the key goes first.
+		"value": dyn.NewValue(value, []dyn.Location{{Line: 1}}),
+	})
+}
+
+func convertEndpointConfigToValue(config *serving.EndpointCoreConfigInput) (dyn.Value, error) {
+	value := make(map[string]dyn.Value)
+
+	// We're processing the served entities and models separately to define the order of their keys.
+	if len(config.ServedEntities) > 0 {
+		entities := make([]dyn.Value, 0)
+		for _, entity := range config.ServedEntities {
+			v, err := yamlsaver.ConvertToMapValue(entity, servedEntityOrder, []string{}, make(map[string]dyn.Value))
+			if err != nil {
+				return dyn.InvalidValue, err
+			}
+			entities = append(entities, v)
+		}
+		value["served_entities"] = dyn.NewValue(entities, []dyn.Location{{Line: endpointConfigOrder.Get("served_entities")}})
+	}
+
+	if len(config.ServedModels) > 0 {
+		models := make([]dyn.Value, 0)
+		for _, model := range config.ServedModels {
+			v, err := yamlsaver.ConvertToMapValue(model, servedEntityOrder, []string{}, make(map[string]dyn.Value))
+			if err != nil {
+				return dyn.InvalidValue, err
+			}
+			models = append(models, v)
+		}
+		value["served_models"] = dyn.NewValue(models, []dyn.Location{{Line: endpointConfigOrder.Get("served_models")}})
+	}
+
+	return yamlsaver.ConvertToMapValue(config, endpointConfigOrder, []string{}, value)
+}
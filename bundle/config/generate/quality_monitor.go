@@ -0,0 +1,35 @@
+package generate
+
+import (
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+var qualityMonitorOrder = yamlsaver.NewOrder([]string{"table_name", "assets_dir", "output_schema_name"})
+
+func ConvertQualityMonitorToValue(monitor *catalog.MonitorInfo) (dyn.Value, error) {
+	value := make(map[string]dyn.Value)
+
+	// The monitor is wrapped in the resource type because the table name
+	// is only included in the configuration of the resource.
+	qm := resources.QualityMonitor{
+		CreateMonitor: &catalog.CreateMonitor{
+			TableName:                monitor.TableName,
+			AssetsDir:                monitor.AssetsDir,
+			OutputSchemaName:         monitor.OutputSchemaName,
+			BaselineTableName:        monitor.BaselineTableName,
+			CustomMetrics:            monitor.CustomMetrics,
+			DataClassificationConfig: monitor.DataClassificationConfig,
+			InferenceLog:             monitor.InferenceLog,
+			Notifications:            monitor.Notifications,
+			Schedule:                 monitor.Schedule,
+			SlicingExprs:             monitor.SlicingExprs,
+			Snapshot:                 monitor.Snapshot,
+			TimeSeries:               monitor.TimeSeries,
+		},
+	}
+
+	return yamlsaver.ConvertToMapValue(qm, qualityMonitorOrder, []string{"modified_status"}, value)
+}
@@ -0,0 +1,24 @@
+package generate
+
+import (
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+var registeredModelOrder = yamlsaver.NewOrder([]string{"name", "catalog_name", "schema_name", "comment"})
+
+func ConvertRegisteredModelToValue(model *catalog.RegisteredModelInfo) (dyn.Value, error) {
+	value := make(map[string]dyn.Value)
+
+	// The storage location is not included because it is managed by
+	// Unity Catalog unless the model was created with an explicit location.
+	create := catalog.CreateRegisteredModelRequest{
+		CatalogName: model.CatalogName,
+		SchemaName:  model.SchemaName,
+		Name:        model.Name,
+		Comment:     model.Comment,
+	}
+
+	return yamlsaver.ConvertToMapValue(create, registeredModelOrder, []string{}, value)
+}
@@ -0,0 +1,23 @@
+package generate
+
+import (
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+var schemaOrder = yamlsaver.NewOrder([]string{"name", "catalog_name", "comment", "properties", "storage_root"})
+
+func ConvertSchemaToValue(schema *catalog.SchemaInfo) (dyn.Value, error) {
+	value := make(map[string]dyn.Value)
+
+	create := catalog.CreateSchema{
+		CatalogName: schema.CatalogName,
+		Name:        schema.Name,
+		Comment:     schema.Comment,
+		Properties:  schema.Properties,
+		StorageRoot: schema.StorageRoot,
+	}
+
+	return yamlsaver.ConvertToMapValue(create, schemaOrder, []string{}, value)
+}
@@ -0,0 +1,263 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/databricks/databricks-sdk-go"
+)
+
+// lookupKind describes a type of workspace object that is referenced by ID
+// in generated configuration and can be looked up by name in a variable.
+type lookupKind struct {
+	// Key of the variable lookup, see [variable.Lookup].
+	lookup string
+
+	// Suffix of the name of the generated variable.
+	suffix string
+
+	// Returns the name of the object that a variable lookup refers to, if it is of this kind.
+	get func(l *variable.Lookup) string
+
+	// Returns the name of the object with the specified ID.
+	name func(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error)
+}
+
+var clusterLookup = lookupKind{
+	lookup: "cluster",
+	suffix: "cluster_id",
+	get: func(l *variable.Lookup) string {
+		return l.Cluster
+	},
+	name: func(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+		c, err := w.Clusters.GetByClusterId(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return c.ClusterName, nil
+	},
+}
+
+var clusterPolicyLookup = lookupKind{
+	lookup: "cluster_policy",
+	suffix: "policy_id",
+	get: func(l *variable.Lookup) string {
+		return l.ClusterPolicy
+	},
+	name: func(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+		p, err := w.ClusterPolicies.GetByPolicyId(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return p.Name, nil
+	},
+}
+
+var instancePoolLookup = lookupKind{
+	lookup: "instance_pool",
+	suffix: "instance_pool_id",
+	get: func(l *variable.Lookup) string {
+		return l.InstancePool
+	},
+	name: func(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+		p, err := w.InstancePools.GetByInstancePoolId(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return p.InstancePoolName, nil
+	},
+}
+
+var warehouseLookup = lookupKind{
+	lookup: "warehouse",
+	suffix: "warehouse_id",
+	get: func(l *variable.Lookup) string {
+		return l.Warehouse
+	},
+	name: func(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+		wh, err := w.Warehouses.GetById(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return wh.Name, nil
+	},
+}
+
+// Fields of generated configuration that hold the ID of a workspace object.
+var lookupFields = map[string]lookupKind{
+	"existing_cluster_id":     clusterLookup,
+	"policy_id":               clusterPolicyLookup,
+	"instance_pool_id":        instancePoolLookup,
+	"driver_instance_pool_id": instancePoolLookup,
+	"warehouse_id":            warehouseLookup,
+}
+
+type lookupVariable struct {
+	name       string
+	lookupKey  string
+	lookupName string
+
+	// Whether the variable is already defined in the bundle.
+	defined bool
+}
+
+// VariableGenerator makes generated configuration portable across workspaces.
+// It replaces the IDs of clusters, cluster policies, instance pools and SQL warehouses
+// with references to variables that look up these objects by name, and it replaces
+// the home folder of the current user with a reference to the user name.
+type VariableGenerator struct {
+	w *databricks.WorkspaceClient
+
+	// Matches the home folder of the current user in a path.
+	homeFolder *regexp.Regexp
+
+	// Variables by the kind and ID of the object they look up.
+	// The value is nil if the name of the object cannot be determined.
+	variables map[string]*lookupVariable
+
+	// Names of the variables that are defined in the bundle or have been generated.
+	names map[string]bool
+
+	// Names of the variables defined in the bundle by the kind and name of the object they look up.
+	defined map[string]string
+}
+
+// NewVariableGenerator returns a generator for a bundle that defines the specified variables.
+// Generated variables don't conflict with them, and variables that look up the same object
+// are referenced instead of generating another one.
+func NewVariableGenerator(w *databricks.WorkspaceClient, userName string, variables map[string]*variable.Variable) *VariableGenerator {
+	g := &VariableGenerator{
+		w:         w,
+		variables: make(map[string]*lookupVariable),
+		names:     make(map[string]bool),
+		defined:   make(map[string]string),
+	}
+	if userName != "" {
+		g.homeFolder = regexp.MustCompile(`/Users/` + regexp.QuoteMeta(userName) + `(/|$)`)
+	}
+
+	for name, v := range variables {
+		g.names[name] = true
+		if v == nil || v.Lookup == nil {
+			continue
+		}
+		for _, kind := range lookupFields {
+			if lookupName := kind.get(v.Lookup); lookupName != "" {
+				g.defined[kind.lookup+":"+lookupName] = name
+			}
+		}
+	}
+	return g
+}
+
+// Apply replaces the workspace-specific values in the configuration of a resource.
+// It returns the updated configuration and the definitions of the variables it references.
+func (g *VariableGenerator) Apply(ctx context.Context, v dyn.Value) (dyn.Value, dyn.Value, error) {
+	var used []*lookupVariable
+	nv, err := dyn.Walk(v, func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+		s, ok := v.AsString()
+		if !ok || dynvar.ContainsVariableReference(s) {
+			return v, nil
+		}
+
+		if len(p) > 0 {
+			if kind, ok := lookupFields[p[len(p)-1].Key()]; ok {
+				lv := g.variable(ctx, kind, s)
+				if lv != nil {
+					if !slices.Contains(used, lv) {
+						used = append(used, lv)
+					}
+					return dyn.NewValue(fmt.Sprintf("${var.%s}", lv.name), v.Locations()), nil
+				}
+				return v, nil
+			}
+		}
+
+		if g.homeFolder != nil && g.homeFolder.MatchString(s) {
+			s = g.homeFolder.ReplaceAllString(s, "/Users/${workspace.current_user.userName}$1")
+			return dyn.NewValue(s, v.Locations()), nil
+		}
+
+		return v, nil
+	})
+	if err != nil {
+		return dyn.InvalidValue, dyn.InvalidValue, err
+	}
+
+	// Variables that are already defined in the bundle are not defined again.
+	used = slices.DeleteFunc(used, func(lv *lookupVariable) bool {
+		return lv.defined
+	})
+	if len(used) == 0 {
+		return nv, dyn.NilValue, nil
+	}
+
+	// Variables are sorted by name. We're using location lines to define the order.
+	slices.SortFunc(used, func(a, b *lookupVariable) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	variables := make(map[string]dyn.Value)
+	for i, lv := range used {
+		variables[lv.name] = dyn.NewValue(map[string]dyn.Value{
+			"description": dyn.NewValue(fmt.Sprintf("ID of the %s %q", strings.ReplaceAll(lv.lookupKey, "_", " "), lv.lookupName), []dyn.Location{{Line: 0}}),
+			"lookup": dyn.NewValue(map[string]dyn.Value{
+				lv.lookupKey: dyn.V(lv.lookupName),
+			}, []dyn.Location{{Line: 1}}),
+		}, []dyn.Location{{Line: i}})
+	}
+
+	return nv, dyn.V(variables), nil
+}
+
+// variable returns the variable that looks up the object with the specified ID.
+// It returns nil if the name of the object cannot be determined.
+func (g *VariableGenerator) variable(ctx context.Context, kind lookupKind, id string) *lookupVariable {
+	key := kind.lookup + ":" + id
+	if lv, ok := g.variables[key]; ok {
+		return lv
+	}
+
+	name, err := kind.name(ctx, g.w, id)
+	if err != nil || name == "" {
+		// The object may have been deleted or may not be accessible to the current user.
+		// In that case we keep the ID as is.
+		log.Warnf(ctx, "Failed to look up the name of %s %s, keeping the ID: %v", kind.lookup, id, err)
+		g.variables[key] = nil
+		return nil
+	}
+
+	if varName, ok := g.defined[kind.lookup+":"+name]; ok {
+		lv := &lookupVariable{
+			name:       varName,
+			lookupKey:  kind.lookup,
+			lookupName: name,
+			defined:    true,
+		}
+		g.variables[key] = lv
+		return lv
+	}
+
+	base := textutil.NormalizeString(name) + "_" + kind.suffix
+	varName := base
+	for i := 2; g.names[varName]; i++ {
+		varName = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.names[varName] = true
+
+	lv := &lookupVariable{
+		name:       varName,
+		lookupKey:  kind.lookup,
+		lookupName: name,
+	}
+	g.variables[key] = lv
+	return lv
+}
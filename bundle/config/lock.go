@@ -1,5 +1,13 @@
 package config
 
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultLockLeaseDuration is the lease duration used if none is configured.
+const DefaultLockLeaseDuration = 15 * time.Minute
+
 type Lock struct {
 	// Enabled toggles deployment lock. True by default except in development mode.
 	// Use a pointer value so that only explicitly configured values are set
@@ -9,6 +17,16 @@ type Lock struct {
 	// Force acquisition of deployment lock even if it is currently held.
 	// This may be necessary if a prior deployment failed to release the lock.
 	Force bool `json:"force,omitempty"`
+
+	// LeaseDuration is the duration after which a deployment lock that has
+	// not been refreshed by its holder is considered stale and can be taken
+	// over by another deployment, for example "15m". Defaults to 15 minutes.
+	LeaseDuration string `json:"lease_duration,omitempty"`
+
+	// Timeout is how long to wait for a deployment lock that is held by
+	// another deployment to be released, for example "10m".
+	// Defaults to failing immediately if the lock is held.
+	Timeout string `json:"timeout,omitempty"`
 }
 
 // IsEnabled checks if the deployment lock is enabled.
@@ -28,3 +46,27 @@ func (lock Lock) IsExplicitlyEnabled() bool {
 	}
 	return false
 }
+
+// GetLeaseDuration returns the configured lease duration or the default.
+func (lock Lock) GetLeaseDuration() (time.Duration, error) {
+	if lock.LeaseDuration == "" {
+		return DefaultLockLeaseDuration, nil
+	}
+	d, err := time.ParseDuration(lock.LeaseDuration)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid lock lease duration %q: expected a positive duration such as \"15m\"", lock.LeaseDuration)
+	}
+	return d, nil
+}
+
+// GetTimeout returns the configured time to wait for the lock, or zero.
+func (lock Lock) GetTimeout() (time.Duration, error) {
+	if lock.Timeout == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(lock.Timeout)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid lock timeout %q: expected a duration such as \"10m\"", lock.Timeout)
+	}
+	return d, nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -20,3 +21,32 @@ func TestLockIsEnabled(t *testing.T) {
 	*lock.Enabled = true
 	assert.True(t, lock.IsEnabled())
 }
+
+func TestLockLeaseDuration(t *testing.T) {
+	d, err := Lock{}.GetLeaseDuration()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultLockLeaseDuration, d)
+
+	d, err = Lock{LeaseDuration: "5m"}.GetLeaseDuration()
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, d)
+
+	_, err = Lock{LeaseDuration: "0s"}.GetLeaseDuration()
+	assert.ErrorContains(t, err, `invalid lock lease duration "0s"`)
+
+	_, err = Lock{LeaseDuration: "forever"}.GetLeaseDuration()
+	assert.ErrorContains(t, err, `invalid lock lease duration "forever"`)
+}
+
+func TestLockTimeout(t *testing.T) {
+	d, err := Lock{}.GetTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), d)
+
+	d, err = Lock{Timeout: "10m"}.GetTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, d)
+
+	_, err = Lock{Timeout: "-1m"}.GetTimeout()
+	assert.ErrorContains(t, err, `invalid lock timeout "-1m"`)
+}
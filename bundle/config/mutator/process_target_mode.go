@@ -16,6 +16,10 @@ type processTargetMode struct{}
 
 const developmentConcurrentRuns = 4
 
+// Clusters deployed in development mode terminate after this many minutes
+// of inactivity unless auto-termination is configured explicitly.
+const developmentAutoterminationMinutes = 60
+
 func ProcessTargetMode() bundle.Mutator {
 	return &processTargetMode{}
 }
@@ -61,6 +65,19 @@ func transformDevelopmentMode(ctx context.Context, b *bundle.Bundle) {
 		enabled := true
 		t.PipelinesDevelopment = &enabled
 	}
+
+	// Make sure interactive clusters don't keep running when they are no longer used.
+	// An explicit value of 0 disables auto-termination and is left as is.
+	for key, c := range b.Config.Resources.Clusters {
+		if c.ClusterSpec == nil || c.AutoterminationMinutes != 0 {
+			continue
+		}
+		p := dyn.NewPath(dyn.Key("resources"), dyn.Key("clusters"), dyn.Key(key), dyn.Key("autotermination_minutes"))
+		if _, err := dyn.GetByPath(b.Config.Value(), p); err == nil {
+			continue
+		}
+		c.AutoterminationMinutes = developmentAutoterminationMinutes
+	}
 }
 
 func validateDevelopmentMode(b *bundle.Bundle) diag.Diagnostics {
@@ -124,7 +141,10 @@ func findNonUserPath(b *bundle.Bundle) string {
 	if b.Config.Workspace.FilePath != "" && !containsName(b.Config.Workspace.FilePath) {
 		return "file_path"
 	}
-	if b.Config.Workspace.ArtifactPath != "" && !containsName(b.Config.Workspace.ArtifactPath) {
+	// Volumes defined in the bundle are prefixed with the current username
+	// in development mode, so references to their path are unique as well.
+	if b.Config.Workspace.ArtifactPath != "" && !containsName(b.Config.Workspace.ArtifactPath) &&
+		!strings.Contains(b.Config.Workspace.ArtifactPath, "${resources.volumes.") {
 		return "artifact_path"
 	}
 	return ""
@@ -13,6 +13,7 @@ import (
 	"github.com/databricks/cli/libs/tags"
 	sdkconfig "github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
@@ -119,6 +120,12 @@ func mockBundle(mode config.Mode) *bundle.Bundle {
 				Schemas: map[string]*resources.Schema{
 					"schema1": {CreateSchema: &catalog.CreateSchema{Name: "schema1"}},
 				},
+				Clusters: map[string]*resources.Cluster{
+					"cluster1": {ClusterSpec: &compute.ClusterSpec{ClusterName: "cluster1", SparkVersion: "13.2.x", NumWorkers: 1}},
+				},
+				Volumes: map[string]*resources.Volume{
+					"volume1": {CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{CatalogName: "catalog1", SchemaName: "schema1", Name: "volume1"}},
+				},
 			},
 		},
 		// Use AWS implementation for testing.
@@ -177,6 +184,14 @@ func TestProcessTargetModeDevelopment(t *testing.T) {
 
 	// Schema 1
 	assert.Equal(t, "dev_lennart_schema1", b.Config.Resources.Schemas["schema1"].Name)
+
+	// Clusters
+	assert.Equal(t, "[dev lennart] cluster1", b.Config.Resources.Clusters["cluster1"].ClusterName)
+	assert.Equal(t, "lennart", b.Config.Resources.Clusters["cluster1"].CustomTags["dev"])
+	assert.Equal(t, developmentAutoterminationMinutes, b.Config.Resources.Clusters["cluster1"].AutoterminationMinutes)
+
+	// Volumes
+	assert.Equal(t, "dev_lennart_volume1", b.Config.Resources.Volumes["volume1"].Name)
 }
 
 func TestProcessTargetModeDevelopmentTagNormalizationForAws(t *testing.T) {
@@ -239,6 +254,12 @@ func TestValidateDevelopmentMode(t *testing.T) {
 	diags = validateDevelopmentMode(b)
 	require.ErrorContains(t, diags.Error(), "artifact_path should contain the current username or ${workspace.current_user.short_name} to ensure uniqueness when using 'mode: development'")
 
+	// Test with a reference to a volume defined in the bundle
+	b = mockBundle(config.Development)
+	b.Config.Workspace.ArtifactPath = "${resources.volumes.volume1.volume_path}/libs"
+	diags = validateDevelopmentMode(b)
+	require.NoError(t, diags.Error())
+
 	// Test with a bundle that has a non-user path
 	b = mockBundle(config.Development)
 	b.Config.Workspace.RootPath = "/Shared/.bundle/x/y/state"
@@ -281,6 +302,8 @@ func TestProcessTargetModeDefault(t *testing.T) {
 	assert.Equal(t, "servingendpoint1", b.Config.Resources.ModelServingEndpoints["servingendpoint1"].Name)
 	assert.Equal(t, "registeredmodel1", b.Config.Resources.RegisteredModels["registeredmodel1"].Name)
 	assert.Equal(t, "qualityMonitor1", b.Config.Resources.QualityMonitors["qualityMonitor1"].TableName)
+	assert.Equal(t, "cluster1", b.Config.Resources.Clusters["cluster1"].ClusterName)
+	assert.Equal(t, 0, b.Config.Resources.Clusters["cluster1"].AutoterminationMinutes)
 }
 
 func TestProcessTargetModeProduction(t *testing.T) {
@@ -384,6 +407,17 @@ func TestAllResourcesRenamed(t *testing.T) {
 	}
 }
 
+func TestProcessTargetModeDevelopmentKeepsClusterAutotermination(t *testing.T) {
+	b := mockBundle(config.Development)
+	b.Config.Resources.Clusters["cluster1"].AutoterminationMinutes = 30
+
+	m := bundle.Seq(ProcessTargetMode(), ApplyPresets())
+	diags := bundle.Apply(context.Background(), b, m)
+	require.NoError(t, diags.Error())
+
+	assert.Equal(t, 30, b.Config.Resources.Clusters["cluster1"].AutoterminationMinutes)
+}
+
 func TestDisableLocking(t *testing.T) {
 	ctx := context.Background()
 	b := mockBundle(config.Development)
@@ -0,0 +1,72 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+)
+
+type resolveVolumeArtifactPath struct{}
+
+// ResolveVolumeArtifactPath resolves references to volumes defined in the bundle
+// in the artifact path, e.g. "${resources.volumes.my_volume.volume_path}".
+//
+// The path of a volume is fully determined by its configuration, so unlike other
+// resource references, it can be resolved before the volume is deployed. This must
+// run after presets are applied because they may change the name of the volume.
+func ResolveVolumeArtifactPath() bundle.Mutator {
+	return &resolveVolumeArtifactPath{}
+}
+
+func (m *resolveVolumeArtifactPath) Name() string {
+	return "ResolveVolumeArtifactPath"
+}
+
+func (m *resolveVolumeArtifactPath) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	if !strings.Contains(b.Config.Workspace.ArtifactPath, "${resources.") {
+		return nil
+	}
+
+	artifactPath := dyn.NewPath(dyn.Key("workspace"), dyn.Key("artifact_path"))
+	volumes := dyn.NewPath(dyn.Key("resources"), dyn.Key("volumes"))
+
+	err := b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		lookup := func(p dyn.Path) (dyn.Value, error) {
+			if len(p) != 4 || !p.HasPrefix(volumes) || p[3] != dyn.Key("volume_path") {
+				return dyn.GetByPath(root, p)
+			}
+
+			// Make sure the volume exists in the configuration.
+			if _, err := dyn.GetByPath(root, p[:3]); err != nil {
+				return dyn.InvalidValue, err
+			}
+
+			// The path is resolved from the fields of the volume, which may
+			// in turn refer to other resources (e.g. the schema it belongs to).
+			prefix := p[:3].String()
+			return dyn.V(fmt.Sprintf(
+				"/Volumes/${%s.catalog_name}/${%s.schema_name}/${%s.name}",
+				prefix, prefix, prefix,
+			)), nil
+		}
+
+		v, err := dyn.GetByPath(root, artifactPath)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+
+		v, err = dynvar.Resolve(v, lookup)
+		if err != nil {
+			return dyn.InvalidValue, fmt.Errorf("failed to resolve workspace.artifact_path: %w", err)
+		}
+
+		return dyn.SetByPath(root, artifactPath, v)
+	})
+
+	return diag.FromErr(err)
+}
@@ -0,0 +1,98 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveVolumeArtifactPath(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "${resources.volumes.my_volume.volume_path}/artifacts",
+			},
+			Resources: config.Resources{
+				Schemas: map[string]*resources.Schema{
+					"my_schema": {
+						CreateSchema: &catalog.CreateSchema{
+							CatalogName: "main",
+							Name:        "dev_lennart_schema",
+						},
+					},
+				},
+				Volumes: map[string]*resources.Volume{
+					"my_volume": {
+						CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+							CatalogName: "main",
+							SchemaName:  "${resources.schemas.my_schema.name}",
+							Name:        "dev_lennart_volume",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, mutator.ResolveVolumeArtifactPath())
+	require.NoError(t, diags.Error())
+	assert.Equal(t, "/Volumes/main/dev_lennart_schema/dev_lennart_volume/artifacts", b.Config.Workspace.ArtifactPath)
+}
+
+func TestResolveVolumeArtifactPathWithoutReferences(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "/Volumes/main/schema/volume",
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, mutator.ResolveVolumeArtifactPath())
+	require.NoError(t, diags.Error())
+	assert.Equal(t, "/Volumes/main/schema/volume", b.Config.Workspace.ArtifactPath)
+}
+
+func TestResolveVolumeArtifactPathUnknownVolume(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "${resources.volumes.unknown.volume_path}",
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, mutator.ResolveVolumeArtifactPath())
+	assert.ErrorContains(t, diags.Error(), "failed to resolve workspace.artifact_path: reference does not exist: ${resources.volumes.unknown.volume_path}")
+}
+
+func TestResolveVolumeArtifactPathUnknownBeforeDeploy(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "${resources.volumes.my_volume.volume_path}",
+			},
+			Resources: config.Resources{
+				Volumes: map[string]*resources.Volume{
+					"my_volume": {
+						CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+							CatalogName: "main",
+							SchemaName:  "${resources.schemas.my_schema.id}",
+							Name:        "volume",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, mutator.ResolveVolumeArtifactPath())
+	assert.ErrorContains(t, diags.Error(), "reference does not exist: ${resources.schemas.my_schema.id}")
+}
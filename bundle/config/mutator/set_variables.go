@@ -2,13 +2,18 @@ package mutator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"slices"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/variable"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/cli/libs/env"
+	"golang.org/x/exp/maps"
 )
 
 const bundleVarPrefix = "BUNDLE_VAR_"
@@ -23,7 +28,57 @@ func (m *setVariables) Name() string {
 	return "SetVariables"
 }
 
-func setVariable(ctx context.Context, v dyn.Value, variable *variable.Variable, name string) (dyn.Value, error) {
+// variableOverrides holds the values from the variable overrides files of the selected target.
+type variableOverrides struct {
+	values map[string]dyn.Value
+
+	// Path of the file that each value was read from, relative to the bundle root.
+	paths map[string]string
+}
+
+func (o *variableOverrides) get(name string) (dyn.Value, string, bool) {
+	if o == nil {
+		return dyn.InvalidValue, "", false
+	}
+	v, ok := o.values[name]
+	return v, o.paths[name], ok
+}
+
+func loadVariableOverrides(b *bundle.Bundle) (*variableOverrides, error) {
+	overrides := &variableOverrides{
+		values: make(map[string]dyn.Value),
+		paths:  make(map[string]string),
+	}
+
+	// Values in later files take precedence over values in earlier files.
+	for _, path := range config.VariableOverridesPaths(b.RootPath, b.Config.Bundle.Target) {
+		values, err := config.LoadVariableFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		source := config.VariableFileSource(b.RootPath, path)
+		for name, v := range values {
+			overrides.values[name] = v
+			overrides.paths[name] = source
+		}
+	}
+	return overrides, nil
+}
+
+// setValue assigns the value of the variable and records where it came from.
+func setValue(v dyn.Value, value dyn.Value, source string) (dyn.Value, error) {
+	v, err := dyn.Set(v, "value", value)
+	if err != nil {
+		return dyn.InvalidValue, err
+	}
+	return dyn.Set(v, "source", dyn.V(source))
+}
+
+func setVariable(ctx context.Context, v dyn.Value, variable *variable.Variable, name string, overrides *variableOverrides) (dyn.Value, error) {
 	// case: variable already has value initialized, so skip
 	if variable.HasValue() {
 		return v, nil
@@ -36,17 +91,36 @@ func setVariable(ctx context.Context, v dyn.Value, variable *variable.Variable,
 			return dyn.InvalidValue, fmt.Errorf(`setting via environment variables (%s) is not supported for complex variable %s`, envVarName, name)
 		}
 
-		v, err := dyn.Set(v, "value", dyn.V(val))
+		v, err := setValue(v, dyn.V(val), "environment variable "+envVarName)
 		if err != nil {
 			return dyn.InvalidValue, fmt.Errorf(`failed to assign value "%s" to variable %s from environment variable %s with error: %v`, val, name, envVarName, err)
 		}
 		return v, nil
 	}
 
+	// case: read and set variable value from the variable overrides file of the target
+	if val, path, ok := overrides.get(name); ok {
+		if !variable.IsComplex() && (val.Kind() == dyn.KindMap || val.Kind() == dyn.KindSequence) {
+			return dyn.InvalidValue, fmt.Errorf(`%s assigns a complex value to variable %s, which is not of complex type`, path, name)
+		}
+
+		v, err := setValue(v, val, path)
+		if err != nil {
+			return dyn.InvalidValue, fmt.Errorf(`failed to assign value from %s to variable %s with error: %v`, path, name, err)
+		}
+		return v, nil
+	}
+
 	// case: Defined a variable for named lookup for a resource
 	// It will be resolved later in ResolveResourceReferences mutator
 	if variable.Lookup != nil {
-		return v, nil
+		return dyn.Set(v, "source", dyn.V("lookup"))
+	}
+
+	// case: Defined a variable that refers to a secret
+	// References to it are resolved when resources are deployed
+	if variable.Secret != nil {
+		return dyn.Set(v, "source", dyn.V("secret "+variable.Secret.String()))
 	}
 
 	// case: Set the variable to its default value
@@ -56,7 +130,7 @@ func setVariable(ctx context.Context, v dyn.Value, variable *variable.Variable,
 			return dyn.InvalidValue, fmt.Errorf(`failed to get default value from config "%s" for variable %s with error: %v`, variable.Default, name, err)
 		}
 
-		v, err := dyn.Set(v, "value", vDefault)
+		v, err := setValue(v, vDefault, "default")
 		if err != nil {
 			return dyn.InvalidValue, fmt.Errorf(`failed to assign default value from config "%s" to variable %s with error: %v`, variable.Default, name, err)
 		}
@@ -64,12 +138,33 @@ func setVariable(ctx context.Context, v dyn.Value, variable *variable.Variable,
 	}
 
 	// We should have had a value to set for the variable at this point.
-	return dyn.InvalidValue, fmt.Errorf(`no value assigned to required variable %s. Assignment can be done through the "--var" flag or by setting the %s environment variable`, name, bundleVarPrefix+name)
+	return dyn.InvalidValue, fmt.Errorf(`no value assigned to required variable %s. Assignment can be done through the "--var" flag or by setting the %s environment variable. It can also be assigned in a variable file passed through the "--var-file" flag`, name, bundleVarPrefix+name)
 
 }
 
 func (m *setVariables) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
-	err := b.Config.Mutate(func(v dyn.Value) (dyn.Value, error) {
+	overrides, err := loadVariableOverrides(b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	names := maps.Keys(overrides.values)
+	slices.Sort(names)
+	for _, name := range names {
+		if _, ok := b.Config.Variables[name]; !ok {
+			diags = diags.Append(diag.Diagnostic{
+				Severity:  diag.Error,
+				Summary:   fmt.Sprintf("variable %s has not been defined, but is assigned a value in %s", name, overrides.paths[name]),
+				Locations: overrides.values[name].Locations(),
+			})
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	err = b.Config.Mutate(func(v dyn.Value) (dyn.Value, error) {
 		return dyn.Map(v, "variables", dyn.Foreach(func(p dyn.Path, variable dyn.Value) (dyn.Value, error) {
 			name := p[1].Key()
 			v, ok := b.Config.Variables[name]
@@ -77,9 +172,21 @@ func (m *setVariables) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnos
 				return dyn.InvalidValue, fmt.Errorf(`variable "%s" is not defined`, name)
 			}
 
-			return setVariable(ctx, variable, v, name)
+			// Report all variables that cannot be set with the location of their definition.
+			nv, err := setVariable(ctx, variable, v, name, overrides)
+			if err != nil {
+				diags = diags.Append(diag.Diagnostic{
+					Severity:  diag.Error,
+					Summary:   err.Error(),
+					Locations: variable.Locations(),
+					Paths:     []dyn.Path{slices.Clone(p)},
+				})
+				return variable, nil
+			}
+
+			return nv, nil
 		}))
 	})
 
-	return diag.FromErr(err)
+	return diags.Extend(diag.FromErr(err))
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/internal/testutil"
 	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/cli/libs/dyn/convert"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func TestSetVariableFromProcessEnvVar(t *testing.T) {
 	v, err := convert.FromTyped(variable, dyn.NilValue)
 	require.NoError(t, err)
 
-	v, err = setVariable(context.Background(), v, &variable, "foo")
+	v, err = setVariable(context.Background(), v, &variable, "foo", nil)
 	require.NoError(t, err)
 
 	err = convert.ToTyped(&variable, v)
@@ -43,7 +44,7 @@ func TestSetVariableUsingDefaultValue(t *testing.T) {
 	v, err := convert.FromTyped(variable, dyn.NilValue)
 	require.NoError(t, err)
 
-	v, err = setVariable(context.Background(), v, &variable, "foo")
+	v, err = setVariable(context.Background(), v, &variable, "foo", nil)
 	require.NoError(t, err)
 
 	err = convert.ToTyped(&variable, v)
@@ -65,7 +66,7 @@ func TestSetVariableWhenAlreadyAValueIsAssigned(t *testing.T) {
 	v, err := convert.FromTyped(variable, dyn.NilValue)
 	require.NoError(t, err)
 
-	v, err = setVariable(context.Background(), v, &variable, "foo")
+	v, err = setVariable(context.Background(), v, &variable, "foo", nil)
 	require.NoError(t, err)
 
 	err = convert.ToTyped(&variable, v)
@@ -90,7 +91,7 @@ func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
 	v, err := convert.FromTyped(variable, dyn.NilValue)
 	require.NoError(t, err)
 
-	v, err = setVariable(context.Background(), v, &variable, "foo")
+	v, err = setVariable(context.Background(), v, &variable, "foo", nil)
 	require.NoError(t, err)
 
 	err = convert.ToTyped(&variable, v)
@@ -107,7 +108,7 @@ func TestSetVariablesErrorsIfAValueCouldNotBeResolved(t *testing.T) {
 	v, err := convert.FromTyped(variable, dyn.NilValue)
 	require.NoError(t, err)
 
-	_, err = setVariable(context.Background(), v, &variable, "foo")
+	_, err = setVariable(context.Background(), v, &variable, "foo", nil)
 	assert.ErrorContains(t, err, "no value assigned to required variable foo. Assignment can be done through the \"--var\" flag or by setting the BUNDLE_VAR_foo environment variable")
 }
 
@@ -157,6 +158,118 @@ func TestSetComplexVariablesViaEnvVariablesIsNotAllowed(t *testing.T) {
 	v, err := convert.FromTyped(variable, dyn.NilValue)
 	require.NoError(t, err)
 
-	_, err = setVariable(context.Background(), v, &variable, "foo")
+	_, err = setVariable(context.Background(), v, &variable, "foo", nil)
 	assert.ErrorContains(t, err, "setting via environment variables (BUNDLE_VAR_foo) is not supported for complex variable foo")
 }
+
+func TestSetVariableLeavesSecretUnresolved(t *testing.T) {
+	variable := variable.Variable{
+		Description: "a test variable",
+		Secret:      &variable.Secret{Scope: "scope", Key: "key"},
+	}
+
+	v, err := convert.FromTyped(variable, dyn.NilValue)
+	require.NoError(t, err)
+
+	v, err = setVariable(context.Background(), v, &variable, "foo", nil)
+	require.NoError(t, err)
+
+	err = convert.ToTyped(&variable, v)
+	require.NoError(t, err)
+	assert.Nil(t, variable.Value)
+	assert.True(t, variable.IsSecret())
+}
+
+func TestSetVariablesFromOverridesFile(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, `{"b": "override-b", "c": "override-c", "cluster": {"num_workers": 2}}`, dir, ".databricks", "bundle", "dev", "variable-overrides.json")
+
+	b := &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Target: "dev",
+			},
+			Variables: map[string]*variable.Variable{
+				"a": {
+					Default: "default-a",
+				},
+				"b": {
+					Default: "default-b",
+				},
+				"c": {
+					Default: "default-c",
+				},
+				"cluster": {
+					Type: variable.VariableTypeComplex,
+				},
+			},
+		},
+	}
+
+	// Environment variables take precedence over the overrides file.
+	t.Setenv("BUNDLE_VAR_c", "env-var-c")
+
+	diags := bundle.Apply(context.Background(), b, SetVariables())
+	require.NoError(t, diags.Error())
+	assert.Equal(t, "default-a", b.Config.Variables["a"].Value)
+	assert.Equal(t, "default", b.Config.Variables["a"].Source)
+	assert.Equal(t, "override-b", b.Config.Variables["b"].Value)
+	assert.Equal(t, ".databricks/bundle/dev/variable-overrides.json", b.Config.Variables["b"].Source)
+	assert.Equal(t, "env-var-c", b.Config.Variables["c"].Value)
+	assert.Equal(t, "environment variable BUNDLE_VAR_c", b.Config.Variables["c"].Source)
+	assert.Equal(t, map[string]any{"num_workers": 2}, b.Config.Variables["cluster"].Value)
+}
+
+func TestSetVariablesFromBothOverridesFiles(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, `{"a": "variables-a", "b": "variables-b"}`, dir, ".databricks", "bundle", "dev", "variables.json")
+	testutil.WriteFile(t, `{"b": "override-b"}`, dir, ".databricks", "bundle", "dev", "variable-overrides.json")
+
+	b := &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Target: "dev",
+			},
+			Variables: map[string]*variable.Variable{
+				"a": {
+					Default: "default-a",
+				},
+				"b": {
+					Default: "default-b",
+				},
+			},
+		},
+	}
+
+	// Values in variable-overrides.json take precedence over values in variables.json.
+	diags := bundle.Apply(context.Background(), b, SetVariables())
+	require.NoError(t, diags.Error())
+	assert.Equal(t, "variables-a", b.Config.Variables["a"].Value)
+	assert.Equal(t, ".databricks/bundle/dev/variables.json", b.Config.Variables["a"].Source)
+	assert.Equal(t, "override-b", b.Config.Variables["b"].Value)
+	assert.Equal(t, ".databricks/bundle/dev/variable-overrides.json", b.Config.Variables["b"].Source)
+}
+
+func TestSetVariablesFromOverridesFileUndefinedVariable(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, `{"undefined": "value"}`, dir, ".databricks", "bundle", "dev", "variable-overrides.json")
+
+	b := &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Target: "dev",
+			},
+			Variables: map[string]*variable.Variable{
+				"a": {
+					Default: "default-a",
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, SetVariables())
+	assert.EqualError(t, diags.Error(), "variable undefined has not been defined, but is assigned a value in .databricks/bundle/dev/variable-overrides.json")
+}
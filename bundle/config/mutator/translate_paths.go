@@ -227,6 +227,38 @@ func (m *translatePaths) Apply(_ context.Context, b *bundle.Bundle) diag.Diagnos
 	return diag.FromErr(err)
 }
 
+// TranslateLocalPath converts the path of a local file in the sync root into
+// the path the file is synchronized to in the workspace. It also returns
+// whether the file is a notebook, in which case the extension is stripped
+// like it is upon import.
+func TranslateLocalPath(b *bundle.Bundle, localPath string) (string, bool, error) {
+	t := &translateContext{
+		b:    b,
+		seen: make(map[string]string),
+	}
+
+	localRelPath, err := filepath.Rel(b.SyncRootPath, localPath)
+	if err != nil {
+		return "", false, err
+	}
+
+	isNotebook := true
+	out := filepath.ToSlash(localRelPath)
+	err = t.rewritePath(b.SyncRootPath, &out, func(literal, localFullPath, localRelPath, remotePath string) (string, error) {
+		interp, err := t.translateNotebookPath(literal, localFullPath, localRelPath, remotePath)
+		if target := (&ErrIsNotNotebook{}); errors.As(err, target) {
+			isNotebook = false
+			return t.translateFilePath(literal, localFullPath, localRelPath, remotePath)
+		}
+		return interp, err
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	return out, isNotebook, nil
+}
+
 func gatherFallbackPaths(v dyn.Value, typ string) (map[string]string, error) {
 	var fallback = make(map[string]string)
 	var pattern = dyn.NewPattern(dyn.Key("resources"), dyn.Key(typ), dyn.AnyKey())
@@ -781,3 +781,35 @@ func TestTranslatePathWithComplexVariables(t *testing.T) {
 		b.Config.Resources.Jobs["job"].Tasks[0].Libraries[0].Whl,
 	)
 }
+
+func TestTranslateLocalPath(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "src", "my_script.py"))
+	touchNotebookFile(t, filepath.Join(dir, "src", "my_notebook.py"))
+
+	b := &bundle.Bundle{
+		SyncRootPath: dir,
+		SyncRoot:     vfs.MustNew(dir),
+		Config: config.Root{
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+		},
+	}
+
+	remotePath, isNotebook, err := mutator.TranslateLocalPath(b, filepath.Join(dir, "src", "my_notebook.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "/bundle/src/my_notebook", remotePath)
+	assert.True(t, isNotebook)
+
+	remotePath, isNotebook, err = mutator.TranslateLocalPath(b, filepath.Join(dir, "src", "my_script.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "/bundle/src/my_script.py", remotePath)
+	assert.False(t, isNotebook)
+
+	_, _, err = mutator.TranslateLocalPath(b, filepath.Join(dir, "src", "missing.py"))
+	assert.ErrorContains(t, err, "not found")
+
+	_, _, err = mutator.TranslateLocalPath(b, filepath.Join(dir, "..", "outside.py"))
+	assert.ErrorContains(t, err, "is not contained in sync root path")
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/databricks-sdk-go"
@@ -19,6 +21,8 @@ type Resources struct {
 	RegisteredModels      map[string]*resources.RegisteredModel      `json:"registered_models,omitempty"`
 	QualityMonitors       map[string]*resources.QualityMonitor       `json:"quality_monitors,omitempty"`
 	Schemas               map[string]*resources.Schema               `json:"schemas,omitempty"`
+	Clusters              map[string]*resources.Cluster              `json:"clusters,omitempty"`
+	Volumes               map[string]*resources.Volume               `json:"volumes,omitempty"`
 }
 
 type ConfigResource interface {
@@ -29,6 +33,48 @@ type ConfigResource interface {
 	// Terraform equivalent name of the resource. For example "databricks_job"
 	// for jobs and "databricks_pipeline" for pipelines.
 	TerraformResourceName() string
+
+	// Function to create the resource in the workspace configured in the input
+	// workspace client. Returns the ID of the created resource.
+	Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error)
+
+	// Function to update the resource with the specified ID to match its
+	// configuration. Returns the ID of the resource after the update. This is
+	// different from the input ID for resources that are identified by their
+	// name if the update renames them.
+	Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error)
+
+	// Function to delete the resource with the specified ID.
+	Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error
+}
+
+// ResourceGroups returns the names of all resource groups, for example "jobs"
+// and "pipelines", in the order they are defined in [Resources].
+func ResourceGroups() []string {
+	typ := reflect.TypeOf(Resources{})
+	groups := make([]string, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		groups = append(groups, name)
+	}
+	return groups
+}
+
+// NewConfigResource returns a new, empty resource of the type used for the
+// specified resource group. It returns false if the group does not exist.
+func NewConfigResource(group string) (ConfigResource, bool) {
+	typ := reflect.TypeOf(Resources{})
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if name != group {
+			continue
+		}
+
+		// All fields are maps of pointers to the resource type.
+		r, ok := reflect.New(typ.Field(i).Type.Elem().Elem()).Interface().(ConfigResource)
+		return r, ok
+	}
+	return nil, false
 }
 
 func (r *Resources) FindResourceByConfigKey(key string) (ConfigResource, error) {
@@ -43,6 +89,11 @@ func (r *Resources) FindResourceByConfigKey(key string) (ConfigResource, error)
 			found = append(found, r.Pipelines[k])
 		}
 	}
+	for k := range r.Clusters {
+		if k == key {
+			found = append(found, r.Clusters[k])
+		}
+	}
 
 	if len(found) == 0 {
 		return nil, fmt.Errorf("no such resource: %s", key)
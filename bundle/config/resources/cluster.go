@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/marshal"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+type Cluster struct {
+	ID             string         `json:"id,omitempty" bundle:"readonly"`
+	Permissions    []Permission   `json:"permissions,omitempty"`
+	ModifiedStatus ModifiedStatus `json:"modified_status,omitempty" bundle:"internal"`
+
+	*compute.ClusterSpec
+}
+
+func (s *Cluster) UnmarshalJSON(b []byte) error {
+	return marshal.Unmarshal(b, s)
+}
+
+func (s Cluster) MarshalJSON() ([]byte, error) {
+	return marshal.Marshal(s)
+}
+
+func (s *Cluster) Exists(ctx context.Context, w *databricks.WorkspaceClient, id string) (bool, error) {
+	_, err := w.Clusters.GetByClusterId(ctx, id)
+	if err != nil {
+		log.Debugf(ctx, "cluster %s does not exist", id)
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Cluster) TerraformResourceName() string {
+	return "databricks_cluster"
+}
+
+// Create creates the cluster without waiting for it to start.
+func (s *Cluster) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	var req compute.CreateCluster
+	err := copyFields(&req, s.ClusterSpec)
+	if err != nil {
+		return "", err
+	}
+	wait, err := w.Clusters.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return wait.ClusterId, nil
+}
+
+func (s *Cluster) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	var req compute.EditCluster
+	err := copyFields(&req, s.ClusterSpec)
+	if err != nil {
+		return "", err
+	}
+	req.ClusterId = id
+	_, err = w.Clusters.Edit(ctx, req)
+	return id, err
+}
+
+func (s *Cluster) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.Clusters.PermanentDeleteByClusterId(ctx, id)
+}
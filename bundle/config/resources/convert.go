@@ -0,0 +1,15 @@
+package resources
+
+import "encoding/json"
+
+// copyFields copies the fields of src into dst by round tripping through JSON.
+// The SDK has different request types for creating and updating a resource
+// that share most of their fields with the settings embedded in our resource
+// types. This function is used to construct these requests.
+func copyFields(dst, src any) error {
+	buf, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, dst)
+}
@@ -44,3 +44,36 @@ func (j *Job) Exists(ctx context.Context, w *databricks.WorkspaceClient, id stri
 func (j *Job) TerraformResourceName() string {
 	return "databricks_job"
 }
+
+func (j *Job) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	var req jobs.CreateJob
+	err := copyFields(&req, j.JobSettings)
+	if err != nil {
+		return "", err
+	}
+	resp, err := w.Jobs.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(resp.JobId, 10), nil
+}
+
+func (j *Job) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	jobId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	err = w.Jobs.Reset(ctx, jobs.ResetJob{
+		JobId:       jobId,
+		NewSettings: *j.JobSettings,
+	})
+	return id, err
+}
+
+func (j *Job) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	jobId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	return w.Jobs.DeleteByJobId(ctx, jobId)
+}
@@ -39,3 +39,29 @@ func (s *MlflowExperiment) Exists(ctx context.Context, w *databricks.WorkspaceCl
 func (s *MlflowExperiment) TerraformResourceName() string {
 	return "databricks_mlflow_experiment"
 }
+
+func (s *MlflowExperiment) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	resp, err := w.Experiments.CreateExperiment(ctx, ml.CreateExperiment{
+		Name:             s.Name,
+		ArtifactLocation: s.ArtifactLocation,
+		Tags:             s.Tags,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.ExperimentId, nil
+}
+
+// Update renames the experiment. Other properties cannot be changed after creation.
+func (s *MlflowExperiment) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	return id, w.Experiments.UpdateExperiment(ctx, ml.UpdateExperiment{
+		ExperimentId: id,
+		NewName:      s.Name,
+	})
+}
+
+func (s *MlflowExperiment) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.Experiments.DeleteExperiment(ctx, ml.DeleteExperiment{
+		ExperimentId: id,
+	})
+}
@@ -39,3 +39,40 @@ func (s *MlflowModel) Exists(ctx context.Context, w *databricks.WorkspaceClient,
 func (s *MlflowModel) TerraformResourceName() string {
 	return "databricks_mlflow_model"
 }
+
+func (s *MlflowModel) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	resp, err := w.ModelRegistry.CreateModel(ctx, ml.CreateModelRequest{
+		Name:        s.Name,
+		Description: s.Description,
+		Tags:        s.Tags,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.RegisteredModel.Name, nil
+}
+
+// Update renames the model if its name changed and updates its description.
+// The ID of a model is its name, so the returned ID changes on rename.
+func (s *MlflowModel) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	if s.Name != id {
+		_, err := w.ModelRegistry.RenameModel(ctx, ml.RenameModelRequest{
+			Name:    id,
+			NewName: s.Name,
+		})
+		if err != nil {
+			return "", err
+		}
+		id = s.Name
+	}
+	return id, w.ModelRegistry.UpdateModel(ctx, ml.UpdateModelRequest{
+		Name:        id,
+		Description: s.Description,
+	})
+}
+
+func (s *MlflowModel) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.ModelRegistry.DeleteModel(ctx, ml.DeleteModelRequest{
+		Name: id,
+	})
+}
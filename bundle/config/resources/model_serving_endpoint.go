@@ -47,3 +47,24 @@ func (s *ModelServingEndpoint) Exists(ctx context.Context, w *databricks.Workspa
 func (s *ModelServingEndpoint) TerraformResourceName() string {
 	return "databricks_model_serving"
 }
+
+// Create creates the serving endpoint without waiting for it to be ready.
+func (s *ModelServingEndpoint) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	_, err := w.ServingEndpoints.Create(ctx, *s.CreateServingEndpoint)
+	if err != nil {
+		return "", err
+	}
+	return s.Name, nil
+}
+
+// Update updates the served entities and traffic configuration of the endpoint.
+func (s *ModelServingEndpoint) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	req := s.Config
+	req.Name = id
+	_, err := w.ServingEndpoints.UpdateConfig(ctx, req)
+	return id, err
+}
+
+func (s *ModelServingEndpoint) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.ServingEndpoints.DeleteByName(ctx, id)
+}
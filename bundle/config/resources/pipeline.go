@@ -39,3 +39,30 @@ func (p *Pipeline) Exists(ctx context.Context, w *databricks.WorkspaceClient, id
 func (p *Pipeline) TerraformResourceName() string {
 	return "databricks_pipeline"
 }
+
+func (p *Pipeline) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	var req pipelines.CreatePipeline
+	err := copyFields(&req, p.PipelineSpec)
+	if err != nil {
+		return "", err
+	}
+	resp, err := w.Pipelines.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.PipelineId, nil
+}
+
+func (p *Pipeline) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	var req pipelines.EditPipeline
+	err := copyFields(&req, p.PipelineSpec)
+	if err != nil {
+		return "", err
+	}
+	req.PipelineId = id
+	return id, w.Pipelines.Update(ctx, req)
+}
+
+func (p *Pipeline) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.Pipelines.DeleteByPipelineId(ctx, id)
+}
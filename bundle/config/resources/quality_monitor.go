@@ -44,3 +44,26 @@ func (s *QualityMonitor) Exists(ctx context.Context, w *databricks.WorkspaceClie
 func (s *QualityMonitor) TerraformResourceName() string {
 	return "databricks_quality_monitor"
 }
+
+func (s *QualityMonitor) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	resp, err := w.QualityMonitors.Create(ctx, *s.CreateMonitor)
+	if err != nil {
+		return "", err
+	}
+	return resp.TableName, nil
+}
+
+func (s *QualityMonitor) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	var req catalog.UpdateMonitor
+	err := copyFields(&req, s.CreateMonitor)
+	if err != nil {
+		return "", err
+	}
+	req.TableName = id
+	_, err = w.QualityMonitors.Update(ctx, req)
+	return id, err
+}
+
+func (s *QualityMonitor) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.QualityMonitors.DeleteByTableName(ctx, id)
+}
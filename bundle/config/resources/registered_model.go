@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"strings"
 
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/databricks-sdk-go"
@@ -48,3 +49,32 @@ func (s *RegisteredModel) Exists(ctx context.Context, w *databricks.WorkspaceCli
 func (s *RegisteredModel) TerraformResourceName() string {
 	return "databricks_registered_model"
 }
+
+func (s *RegisteredModel) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	resp, err := w.RegisteredModels.Create(ctx, *s.CreateRegisteredModelRequest)
+	if err != nil {
+		return "", err
+	}
+	return resp.FullName, nil
+}
+
+// Update updates the comment of the model and renames it if its name changed.
+// The ID of a registered model is its full name, so the returned ID changes on rename.
+func (s *RegisteredModel) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	req := catalog.UpdateRegisteredModelRequest{
+		FullName: id,
+		Comment:  s.Comment,
+	}
+	if !strings.HasSuffix(id, "."+s.Name) {
+		req.NewName = s.Name
+	}
+	resp, err := w.RegisteredModels.Update(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.FullName, nil
+}
+
+func (s *RegisteredModel) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.RegisteredModels.DeleteByFullName(ctx, id)
+}
@@ -1,6 +1,10 @@
 package resources
 
 import (
+	"context"
+
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/marshal"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 )
@@ -25,3 +29,51 @@ func (s *Schema) UnmarshalJSON(b []byte) error {
 func (s Schema) MarshalJSON() ([]byte, error) {
 	return marshal.Marshal(s)
 }
+
+func (s *Schema) Exists(ctx context.Context, w *databricks.WorkspaceClient, id string) (bool, error) {
+	_, err := w.Schemas.GetByFullName(ctx, id)
+	if err != nil {
+		log.Debugf(ctx, "schema %s does not exist", id)
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Schema) TerraformResourceName() string {
+	return "databricks_schema"
+}
+
+func (s *Schema) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	resp, err := w.Schemas.Create(ctx, *s.CreateSchema)
+	if err != nil {
+		return "", err
+	}
+	return resp.FullName, nil
+}
+
+// Update updates the comment and properties of the schema and renames it if its name changed.
+// The ID of a schema is its full name, so the returned ID changes on rename.
+func (s *Schema) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	req := catalog.UpdateSchema{
+		FullName:   id,
+		Comment:    s.Comment,
+		Properties: s.Properties,
+	}
+	if id != s.CatalogName+"."+s.Name {
+		req.NewName = s.Name
+	}
+	resp, err := w.Schemas.Update(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.FullName, nil
+}
+
+// Delete deletes the schema including its contents. This is equivalent to
+// the force_destroy setting we use for schemas deployed with Terraform.
+func (s *Schema) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.Schemas.Delete(ctx, catalog.DeleteSchemaRequest{
+		FullName: id,
+		Force:    true,
+	})
+}
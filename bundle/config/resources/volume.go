@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/marshal"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+type Volume struct {
+	// List of grants to apply on this volume.
+	Grants []Grant `json:"grants,omitempty"`
+
+	// Full name of the volume (catalog_name.schema_name.volume_name). This value is read from
+	// the terraform state after deployment succeeds.
+	ID string `json:"id,omitempty" bundle:"readonly"`
+
+	*catalog.CreateVolumeRequestContent
+
+	ModifiedStatus ModifiedStatus `json:"modified_status,omitempty" bundle:"internal"`
+}
+
+func (v *Volume) UnmarshalJSON(b []byte) error {
+	return marshal.Unmarshal(b, v)
+}
+
+func (v Volume) MarshalJSON() ([]byte, error) {
+	return marshal.Marshal(v)
+}
+
+func (v *Volume) Exists(ctx context.Context, w *databricks.WorkspaceClient, id string) (bool, error) {
+	_, err := w.Volumes.ReadByName(ctx, id)
+	if err != nil {
+		log.Debugf(ctx, "volume %s does not exist", id)
+		return false, err
+	}
+	return true, nil
+}
+
+func (v *Volume) TerraformResourceName() string {
+	return "databricks_volume"
+}
+
+func (v *Volume) Create(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	req := *v.CreateVolumeRequestContent
+
+	// Volumes are managed unless a storage location is specified.
+	// See the volume converter for Terraform for the equivalent logic.
+	if req.VolumeType == "" {
+		req.VolumeType = catalog.VolumeTypeManaged
+		if req.StorageLocation != "" {
+			req.VolumeType = catalog.VolumeTypeExternal
+		}
+	}
+
+	resp, err := w.Volumes.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.FullName, nil
+}
+
+// Update updates the comment of the volume and renames it if its name changed.
+// The ID of a volume is its full name, so the returned ID changes on rename.
+func (v *Volume) Update(ctx context.Context, w *databricks.WorkspaceClient, id string) (string, error) {
+	req := catalog.UpdateVolumeRequestContent{
+		Name:    id,
+		Comment: v.Comment,
+	}
+	if !strings.HasSuffix(id, "."+v.Name) {
+		req.NewName = v.Name
+	}
+	resp, err := w.Volumes.Update(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.FullName, nil
+}
+
+func (v *Volume) Delete(ctx context.Context, w *databricks.WorkspaceClient, id string) error {
+	return w.Volumes.DeleteByName(ctx, id)
+}
@@ -61,3 +61,18 @@ func TestCustomMarshallerIsImplemented(t *testing.T) {
 		}, "Resource %s does not have a custom unmarshaller", field.Name)
 	}
 }
+
+// This test ensures that all resources implement the [ConfigResource] interface,
+// which is required to deploy them without Terraform.
+func TestAllResourcesImplementConfigResource(t *testing.T) {
+	for _, group := range ResourceGroups() {
+		r, ok := NewConfigResource(group)
+		assert.True(t, ok, "Resource %s does not implement ConfigResource", group)
+		assert.NotNil(t, r)
+	}
+}
+
+func TestNewConfigResourceUnknownGroup(t *testing.T) {
+	_, ok := NewConfigResource("unknown")
+	assert.False(t, ok)
+}
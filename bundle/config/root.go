@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/databricks/cli/bundle/config/resources"
@@ -16,6 +17,7 @@ import (
 	"github.com/databricks/cli/libs/dyn/yamlloader"
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"golang.org/x/exp/maps"
 )
 
 type Root struct {
@@ -255,6 +257,46 @@ func (r *Root) InitializeVariables(vars []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to assign %s to %s: %s", val, name, err)
 		}
+		r.Variables[name].Source = "--var flag"
+	}
+	return nil
+}
+
+// Initializes variables using values from the files passed with the `--var-file` flag.
+// Values in later files take precedence over values in earlier files. Variables that
+// have already been assigned a value through the `--var` flag are left unchanged.
+// The sources of the values refer to the files relative to the bundle root.
+func (r *Root) InitializeVariablesFromFiles(root string, paths []string) error {
+	values := make(map[string]dyn.Value)
+	sources := make(map[string]string)
+	for _, path := range paths {
+		vars, err := LoadVariableFile(path)
+		if err != nil {
+			return err
+		}
+		for name, val := range vars {
+			values[name] = val
+			sources[name] = path
+		}
+	}
+
+	names := maps.Keys(values)
+	slices.Sort(names)
+	for _, name := range names {
+		v, ok := r.Variables[name]
+		if !ok {
+			return fmt.Errorf("variable %s has not been defined, but is assigned a value in %s", name, sources[name])
+		}
+
+		if v.HasValue() {
+			continue
+		}
+
+		err := v.Set(values[name].AsAny())
+		if err != nil {
+			return fmt.Errorf("failed to assign value from %s to %s: %s", sources[name], name, err)
+		}
+		v.Source = "--var-file " + VariableFileSource(root, sources[name])
 	}
 	return nil
 }
@@ -333,6 +375,31 @@ func (r *Root) MergeTargetOverrides(name string) error {
 			if vLookup.Kind() != dyn.KindInvalid {
 				lookupPath := varPath.Append(dyn.Key("lookup"))
 				root, err = dyn.SetByPath(root, lookupPath, vLookup)
+				if err != nil {
+					return root, err
+				}
+			}
+
+			// A secret in the target replaces the top level default and lookup,
+			// and a default or lookup in the target replaces the top level secret.
+			vSecret := variable.Get("secret")
+			if vSecret.Kind() != dyn.KindInvalid {
+				root, err = dropVariableFields(root, varPath, "default", "lookup")
+				if err != nil {
+					return root, err
+				}
+				root, err = dyn.SetByPath(root, varPath.Append(dyn.Key("secret")), vSecret)
+			} else if vDefault.Kind() != dyn.KindInvalid || vLookup.Kind() != dyn.KindInvalid {
+				root, err = dropVariableFields(root, varPath, "secret")
+			}
+			if err != nil {
+				return root, err
+			}
+
+			vRequired := variable.Get("required")
+			if vRequired.Kind() != dyn.KindInvalid {
+				requiredPath := varPath.Append(dyn.Key("required"))
+				root, err = dyn.SetByPath(root, requiredPath, vRequired)
 			}
 
 			return root, err
@@ -342,6 +409,23 @@ func (r *Root) MergeTargetOverrides(name string) error {
 		}
 	}
 
+	// Required variables don't use the top level default. Only a default
+	// defined for the selected target counts as an assignment.
+	root, err = dyn.Map(root, "variables", dyn.Foreach(func(p dyn.Path, variable dyn.Value) (dyn.Value, error) {
+		if required, ok := variable.Get("required").AsBool(); !ok || !required {
+			return variable, nil
+		}
+
+		if target.Get("variables").Get(p[1].Key()).Get("default").Kind() != dyn.KindInvalid {
+			return variable, nil
+		}
+
+		return dropFields(variable, "default")
+	}))
+	if err != nil {
+		return err
+	}
+
 	// Merge `run_as`. This field must be overwritten if set, not merged.
 	if v := target.Get("run_as"); v.Kind() != dyn.KindInvalid {
 		root, err = dyn.Set(root, "run_as", v)
@@ -374,6 +458,14 @@ func (r *Root) MergeTargetOverrides(name string) error {
 		}
 	}
 
+	// Merge `warehouse_id`. This field must be overwritten if set, not merged.
+	if v := target.Get("warehouse_id"); v.Kind() != dyn.KindInvalid {
+		root, err = dyn.SetByPath(root, dyn.NewPath(dyn.Key("bundle"), dyn.Key("warehouse_id")), v)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Merge `git`.
 	if v := target.Get("git"); v.Kind() != dyn.KindInvalid {
 		ref, err := dyn.GetByPath(root, dyn.NewPath(dyn.Key("bundle"), dyn.Key("git")))
@@ -406,43 +498,55 @@ func (r *Root) MergeTargetOverrides(name string) error {
 	return r.updateWithDynamicValue(root)
 }
 
-var variableKeywords = []string{"default", "lookup"}
+// dropVariableFields removes the specified fields from the variable at the given path.
+func dropVariableFields(root dyn.Value, varPath dyn.Path, fields ...string) (dyn.Value, error) {
+	return dyn.MapByPath(root, varPath, func(_ dyn.Path, v dyn.Value) (dyn.Value, error) {
+		return dropFields(v, fields...)
+	})
+}
 
-// isFullVariableOverrideDef checks if the given value is a full syntax varaible override.
-// A full syntax variable override is a map with either 1 of 2 keys.
-// If it's 2 keys, the keys should be "default" and "type".
-// If it's 1 key, the key should be one of the following keys: "default", "lookup".
-func isFullVariableOverrideDef(v dyn.Value) bool {
-	mv, ok := v.AsMap()
+func dropFields(v dyn.Value, fields ...string) (dyn.Value, error) {
+	m, ok := v.AsMap()
 	if !ok {
-		return false
+		return v, nil
 	}
 
-	// If the map has more than 2 keys, it is not a full variable override.
-	if mv.Len() > 2 {
-		return false
+	out := dyn.NewMapping()
+	for _, pair := range m.Pairs() {
+		if k, _ := pair.Key.AsString(); slices.Contains(fields, k) {
+			continue
+		}
+		if err := out.Set(pair.Key, pair.Value); err != nil {
+			return dyn.InvalidValue, err
+		}
 	}
+	return dyn.NewValue(out, v.Locations()), nil
+}
 
-	// If the map has 2 keys, one of them should be "default" and the other is "type"
-	if mv.Len() == 2 {
-		if _, ok := mv.GetByString("type"); !ok {
-			return false
-		}
+var variableKeywords = []string{"default", "lookup", "secret", "required"}
 
-		if _, ok := mv.GetByString("default"); !ok {
-			return false
-		}
+// isFullVariableOverrideDef checks if the given value is a full syntax varaible override.
+// A full syntax variable override is a map with the keys "default" and "type",
+// or a non-empty map with only the following keys: "default", "lookup", "secret", "required".
+func isFullVariableOverrideDef(v dyn.Value) bool {
+	mv, ok := v.AsMap()
+	if !ok || mv.Len() == 0 {
+		return false
+	}
 
-		return true
+	// If the map has a "type" key, the other key should be "default".
+	if _, ok := mv.GetByString("type"); ok {
+		_, ok := mv.GetByString("default")
+		return ok && mv.Len() == 2
 	}
 
-	for _, keyword := range variableKeywords {
-		if _, ok := mv.GetByString(keyword); ok {
-			return true
+	for _, pair := range mv.Pairs() {
+		if k, _ := pair.Key.AsString(); !slices.Contains(variableKeywords, k) {
+			return false
 		}
 	}
 
-	return false
+	return true
 }
 
 // rewriteShorthands performs lightweight rewriting of the configuration
@@ -2,6 +2,9 @@ package config
 
 import (
 	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -90,6 +93,68 @@ func TestInitializeVariablesUndefinedVariables(t *testing.T) {
 	assert.ErrorContains(t, err, "variable bar has not been defined")
 }
 
+func TestInitializeVariablesFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.yml")
+	second := filepath.Join(dir, "second.json")
+	require.NoError(t, os.WriteFile(first, []byte("foo: abc\nbar: def\ncluster:\n  num_workers: 2\n"), 0644))
+	require.NoError(t, os.WriteFile(second, []byte(`{"bar": "ghi"}`), 0644))
+
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"foo": {},
+			"bar": {},
+			"cluster": {
+				Type: variable.VariableTypeComplex,
+			},
+		},
+	}
+
+	require.NoError(t, root.InitializeVariables([]string{"foo=123"}))
+	require.NoError(t, root.InitializeVariablesFromFiles(dir, []string{first, second}))
+
+	// The --var flag takes precedence over files.
+	assert.Equal(t, "123", root.Variables["foo"].Value)
+	assert.Equal(t, "--var flag", root.Variables["foo"].Source)
+
+	// Later files take precedence over earlier files.
+	assert.Equal(t, "ghi", root.Variables["bar"].Value)
+	assert.Equal(t, "--var-file second.json", root.Variables["bar"].Source)
+
+	assert.Equal(t, map[string]any{"num_workers": 2}, root.Variables["cluster"].Value)
+	assert.Equal(t, "--var-file first.yml", root.Variables["cluster"].Source)
+}
+
+func TestInitializeVariablesFromFilesErrors(t *testing.T) {
+	dir := t.TempDir()
+	undefined := filepath.Join(dir, "undefined.yml")
+	complex := filepath.Join(dir, "complex.yml")
+	list := filepath.Join(dir, "list.yml")
+	require.NoError(t, os.WriteFile(undefined, []byte("bar: abc\n"), 0644))
+	require.NoError(t, os.WriteFile(complex, []byte("foo:\n  key: value\n"), 0644))
+	require.NoError(t, os.WriteFile(list, []byte("- foo\n"), 0644))
+
+	newRoot := func() *Root {
+		return &Root{
+			Variables: map[string]*variable.Variable{
+				"foo": {},
+			},
+		}
+	}
+
+	err := newRoot().InitializeVariablesFromFiles(dir, []string{undefined})
+	assert.ErrorContains(t, err, "variable bar has not been defined, but is assigned a value in "+undefined)
+
+	err = newRoot().InitializeVariablesFromFiles(dir, []string{complex})
+	assert.ErrorContains(t, err, "failed to assign value from "+complex+" to foo: variable type is not complex")
+
+	err = newRoot().InitializeVariablesFromFiles(dir, []string{list})
+	assert.ErrorContains(t, err, "expected a mapping of variable names to values, found sequence")
+
+	err = newRoot().InitializeVariablesFromFiles(dir, []string{filepath.Join(dir, "missing.yml")})
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
 func TestRootMergeTargetOverridesWithMode(t *testing.T) {
 	root := &Root{
 		Bundle: Bundle{},
@@ -169,3 +234,70 @@ func TestRootMergeTargetOverridesWithVariables(t *testing.T) {
 	assert.Equal(t, "complex var", root.Variables["complex"].Description)
 
 }
+
+func TestRootMergeTargetOverridesWithSecretVariables(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"token": {
+				Default: "foo",
+			},
+			"password": {
+				Secret: &variable.Secret{Scope: "scope", Key: "key"},
+			},
+		},
+		Targets: map[string]*Target{
+			"development": {
+				Variables: map[string]*variable.TargetVariable{
+					"token": {
+						Secret: &variable.Secret{Scope: "dev", Key: "token"},
+					},
+					"password": {
+						Default: "bar",
+					},
+				},
+			},
+		},
+	}
+	root.initializeDynamicValue()
+	require.NoError(t, root.MergeTargetOverrides("development"))
+	assert.Nil(t, root.Variables["token"].Default)
+	assert.Equal(t, &variable.Secret{Scope: "dev", Key: "token"}, root.Variables["token"].Secret)
+	assert.Equal(t, "bar", root.Variables["password"].Default)
+	assert.Nil(t, root.Variables["password"].Secret)
+}
+
+func TestRootMergeTargetOverridesWithRequiredVariables(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"foo": {
+				Default:  "foo",
+				Required: true,
+			},
+			"bar": {
+				Default:  "bar",
+				Required: true,
+			},
+			"baz": {
+				Default: "baz",
+			},
+		},
+		Targets: map[string]*Target{
+			"production": {
+				Variables: map[string]*variable.TargetVariable{
+					"bar": {
+						Default: "prod",
+					},
+					"baz": {
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+	root.initializeDynamicValue()
+	require.NoError(t, root.MergeTargetOverrides("production"))
+	assert.Nil(t, root.Variables["foo"].Default)
+	assert.Equal(t, "prod", root.Variables["bar"].Default)
+	assert.Nil(t, root.Variables["baz"].Default)
+	assert.True(t, root.Variables["baz"].Required)
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/databricks-sdk-go"
+)
+
+// SecretVariableName returns the name of the secret variable that a variable
+// reference refers to, if any. Both ${var.<name>} and ${variables.<name>.value}
+// refer to the value of a variable. References to secret variables that are not
+// assigned a value explicitly are left in place until resources are deployed.
+func SecretVariableName(root dyn.Value, p dyn.Path) (string, bool) {
+	var name string
+	switch {
+	case len(p) == 2 && p[0] == dyn.Key("var"):
+		name = p[1].Key()
+	case len(p) == 3 && p[0] == dyn.Key("variables") && p[2] == dyn.Key("value"):
+		name = p[1].Key()
+	default:
+		return "", false
+	}
+
+	v := root.Get("variables").Get(name)
+	if !isSet(v.Get("secret")) || isSet(v.Get("value")) {
+		return "", false
+	}
+
+	return name, true
+}
+
+func isSet(v dyn.Value) bool {
+	return v.Kind() != dyn.KindInvalid && v.Kind() != dyn.KindNil
+}
+
+// ReferencedSecretVariables returns the sorted names of the secret variables
+// that are referenced in the resources of the configuration.
+func ReferencedSecretVariables(root dyn.Value) ([]string, error) {
+	var names []string
+	_, err := dynvar.Resolve(root.Get("resources"), func(p dyn.Path) (dyn.Value, error) {
+		if name, ok := SecretVariableName(root, p); ok && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+		return dyn.InvalidValue, dynvar.ErrSkipResolution
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+// ResolveSecretVariables returns the values of the specified secret variables by name.
+func (r *Root) ResolveSecretVariables(ctx context.Context, w *databricks.WorkspaceClient, names []string) (map[string]string, error) {
+	out := make(map[string]string, len(names))
+	for _, name := range names {
+		v, ok := r.Variables[name]
+		if !ok || v.Secret == nil {
+			return nil, fmt.Errorf("variable %s is not a secret variable", name)
+		}
+
+		value, err := v.Secret.Resolve(ctx, w)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve secret %s for variable %s: %w", v.Secret, name, err)
+		}
+		out[name] = value
+	}
+	return out, nil
+}
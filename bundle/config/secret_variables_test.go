@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretVariableName(t *testing.T) {
+	root := dyn.V(map[string]dyn.Value{
+		"variables": dyn.V(map[string]dyn.Value{
+			"token": dyn.V(map[string]dyn.Value{
+				"secret": dyn.V(map[string]dyn.Value{
+					"scope": dyn.V("scope"),
+					"key":   dyn.V("key"),
+				}),
+			}),
+			"assigned": dyn.V(map[string]dyn.Value{
+				"secret": dyn.V(map[string]dyn.Value{
+					"scope": dyn.V("scope"),
+					"key":   dyn.V("key"),
+				}),
+				"value": dyn.V("foo"),
+			}),
+			"plain": dyn.V(map[string]dyn.Value{
+				"value": dyn.V("foo"),
+			}),
+		}),
+	})
+
+	name, ok := SecretVariableName(root, dyn.MustPathFromString("var.token"))
+	assert.True(t, ok)
+	assert.Equal(t, "token", name)
+
+	name, ok = SecretVariableName(root, dyn.MustPathFromString("variables.token.value"))
+	assert.True(t, ok)
+	assert.Equal(t, "token", name)
+
+	_, ok = SecretVariableName(root, dyn.MustPathFromString("variables.token.description"))
+	assert.False(t, ok)
+
+	_, ok = SecretVariableName(root, dyn.MustPathFromString("var.assigned"))
+	assert.False(t, ok)
+
+	_, ok = SecretVariableName(root, dyn.MustPathFromString("var.plain"))
+	assert.False(t, ok)
+
+	_, ok = SecretVariableName(root, dyn.MustPathFromString("var.undefined"))
+	assert.False(t, ok)
+}
+
+func TestReferencedSecretVariables(t *testing.T) {
+	secret := dyn.V(map[string]dyn.Value{
+		"secret": dyn.V(map[string]dyn.Value{
+			"scope": dyn.V("scope"),
+			"key":   dyn.V("key"),
+		}),
+	})
+
+	root := dyn.V(map[string]dyn.Value{
+		"bundle": dyn.V(map[string]dyn.Value{
+			"name": dyn.V("${var.c}"),
+		}),
+		"variables": dyn.V(map[string]dyn.Value{
+			"a": secret,
+			"b": secret,
+			"c": secret,
+		}),
+		"resources": dyn.V(map[string]dyn.Value{
+			"jobs": dyn.V(map[string]dyn.Value{
+				"job": dyn.V(map[string]dyn.Value{
+					"name":        dyn.V("${var.b} ${var.a}"),
+					"description": dyn.V("${variables.b.value} ${var.plain}"),
+				}),
+			}),
+		}),
+	})
+
+	names, err := ReferencedSecretVariables(root)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+}
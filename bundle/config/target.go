@@ -27,6 +27,9 @@ type Target struct {
 	// Overrides the compute used for jobs and other supported assets.
 	ComputeID string `json:"compute_id,omitempty"`
 
+	// SQL warehouse to run SQL files on with `bundle run`.
+	WarehouseID string `json:"warehouse_id,omitempty"`
+
 	Bundle *Bundle `json:"bundle,omitempty"`
 
 	Workspace *Workspace `json:"workspace,omitempty"`
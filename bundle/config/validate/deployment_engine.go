@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+func DeploymentEngine() bundle.Mutator {
+	return &deploymentEngine{}
+}
+
+type deploymentEngine struct{}
+
+func (m *deploymentEngine) Name() string {
+	return "validate:DeploymentEngine"
+}
+
+func (m *deploymentEngine) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	switch b.Config.Bundle.Deployment.Engine {
+	case "", config.EngineTerraform, config.EngineDirect:
+		return nil
+	}
+
+	path := "bundle.deployment.engine"
+	return diag.Diagnostics{{
+		Severity:  diag.Error,
+		Summary:   fmt.Sprintf("invalid deployment engine %q, expected one of %q or %q", b.Config.Bundle.Deployment.Engine, config.EngineTerraform, config.EngineDirect),
+		Locations: b.Config.GetLocations(path),
+		Paths:     []dyn.Path{dyn.MustPathFromString(path)},
+	}}
+}
@@ -0,0 +1,43 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentEngine(t *testing.T) {
+	for _, engine := range []config.Engine{"", config.EngineTerraform, config.EngineDirect} {
+		b := &bundle.Bundle{
+			Config: config.Root{
+				Bundle: config.Bundle{
+					Deployment: config.Deployment{
+						Engine: engine,
+					},
+				},
+			},
+		}
+
+		diags := bundle.Apply(context.Background(), b, DeploymentEngine())
+		require.NoError(t, diags.Error())
+	}
+}
+
+func TestDeploymentEngineInvalid(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Deployment: config.Deployment{
+					Engine: "pulumi",
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, DeploymentEngine())
+	assert.EqualError(t, diags.Error(), `invalid deployment engine "pulumi", expected one of "terraform" or "direct"`)
+}
@@ -0,0 +1,109 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"golang.org/x/exp/maps"
+)
+
+// Variables validates the definition and value of all variables, and that
+// secret variables are only referenced in resources. It is expected to run
+// after variable references have been resolved.
+func Variables() bundle.Mutator {
+	return &variables{}
+}
+
+type variables struct{}
+
+func (m *variables) Name() string {
+	return "validate:Variables"
+}
+
+func (m *variables) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := maps.Keys(b.Config.Variables)
+	slices.Sort(names)
+
+	for _, name := range names {
+		v := b.Config.Variables[name]
+		if v == nil {
+			continue
+		}
+
+		path := dyn.NewPath(dyn.Key("variables"), dyn.Key(name))
+		if err := v.ValidateDefinition(); err != nil {
+			diags = diags.Append(diag.Diagnostic{
+				Severity:  diag.Error,
+				Summary:   fmt.Sprintf("invalid definition of variable %s: %v", name, err),
+				Locations: b.Config.GetLocations(path.String()),
+				Paths:     []dyn.Path{path},
+			})
+			continue
+		}
+
+		if err := v.ValidateValue(); err != nil {
+			// Point to the assignment of the value. Values that are assigned with
+			// the "--var" flag or an environment variable don't have a location.
+			valuePath := path.Append(dyn.Key("value"))
+			locations := b.Config.GetLocations(valuePath.String())
+			if len(locations) == 0 {
+				locations = b.Config.GetLocations(path.String())
+			}
+
+			diags = diags.Append(diag.Diagnostic{
+				Severity:  diag.Error,
+				Summary:   fmt.Sprintf("invalid value for variable %s: %v", name, err),
+				Locations: locations,
+				Paths:     []dyn.Path{valuePath},
+			})
+		}
+	}
+
+	return diags.Extend(validateSecretReferences(b.Config.Value()))
+}
+
+// Sections of the configuration where references to secret variables may remain.
+// References in variables are permitted because they are copied into resources.
+var secretReferenceSections = []string{"resources", "variables"}
+
+// validateSecretReferences returns an error for every reference to a secret
+// variable outside of resources. These are never resolved, because secret
+// variables are only resolved when resources are deployed.
+func validateSecretReferences(root dyn.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	_, err := dyn.Walk(root, func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+		if len(p) == 1 && slices.Contains(secretReferenceSections, p[0].Key()) {
+			return v, dyn.ErrSkip
+		}
+
+		if _, ok := v.AsString(); !ok {
+			return v, nil
+		}
+
+		_, err := dynvar.Resolve(v, func(ref dyn.Path) (dyn.Value, error) {
+			if name, ok := config.SecretVariableName(root, ref); ok {
+				diags = diags.Append(diag.Diagnostic{
+					Severity:  diag.Error,
+					Summary:   fmt.Sprintf("secret variable %s can only be referenced in resources", name),
+					Locations: v.Locations(),
+					Paths:     []dyn.Path{slices.Clone(p)},
+				})
+			}
+			return dyn.InvalidValue, dynvar.ErrSkipResolution
+		})
+		return v, err
+	})
+	if err != nil {
+		diags = diags.Extend(diag.FromErr(err))
+	}
+
+	return diags
+}
@@ -0,0 +1,87 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariablesValid(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Variables: map[string]*variable.Variable{
+				"env": {
+					Value: "dev",
+					Enum:  []variable.VariableValue{"dev", "prod"},
+				},
+				"token": {
+					Secret: &variable.Secret{Scope: "scope", Key: "key"},
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {
+						JobSettings: &jobs.JobSettings{
+							Name: "${var.token}",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, Variables())
+	require.NoError(t, diags.Error())
+}
+
+func TestVariablesInvalidDefinitionAndValue(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Variables: map[string]*variable.Variable{
+				"env": {
+					Value: "test",
+					Enum:  []variable.VariableValue{"dev", "prod"},
+				},
+				"token": {
+					Secret: &variable.Secret{Scope: "scope"},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, Variables())
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, `invalid value for variable env: value "test" is not one of the allowed values: dev, prod`, diags[0].Summary)
+	assert.Equal(t, "variables.env.value", diags[0].Paths[0].String())
+	assert.Equal(t, "invalid definition of variable token: secret must specify both scope and key", diags[1].Summary)
+	assert.Equal(t, "variables.token", diags[1].Paths[0].String())
+}
+
+func TestVariablesSecretReferencedOutsideResources(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${var.token}",
+			},
+			Variables: map[string]*variable.Variable{
+				"token": {
+					Secret: &variable.Secret{Scope: "scope", Key: "key"},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, Variables())
+	require.Len(t, diags, 1)
+	assert.Equal(t, "secret variable token can only be referenced in resources", diags[0].Summary)
+	assert.Equal(t, "bundle.name", diags[0].Paths[0].String())
+}
@@ -0,0 +1,66 @@
+package variable
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// Secret refers to a secret in a Databricks secret scope.
+//
+// The value of a secret variable is not resolved when the configuration is
+// loaded. References to it are left in place and are resolved only when
+// resources are deployed, such that the value is not written to the
+// Terraform configuration or included in the output of `bundle validate`.
+//
+// The value is not kept out of all deployment artifacts, however:
+//   - Terraform records it in the local terraform.tfstate, like any other
+//     attribute of a resource. The attributes derived from it are set to null
+//     in the copy of the state that is uploaded to the workspace.
+//   - The direct deployment engine records the reference rather than the value.
+//     Rotating the secret therefore does not cause resources to be updated
+//     until another change to their configuration does.
+type Secret struct {
+	// Name of the secret scope.
+	Scope string `json:"scope"`
+
+	// Key of the secret in the scope.
+	Key string `json:"key"`
+}
+
+func (s *Secret) validate() error {
+	if s.Scope == "" || s.Key == "" {
+		return fmt.Errorf("secret must specify both scope and key")
+	}
+	return nil
+}
+
+// Resolve returns the value of the secret.
+func (s *Secret) Resolve(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	if err := s.validate(); err != nil {
+		return "", err
+	}
+
+	resp, err := w.Secrets.GetSecret(ctx, workspace.GetSecretRequest{
+		Scope: s.Scope,
+		Key:   s.Key,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The API returns the value of the secret in its base64 representation.
+	value, err := base64.StdEncoding.DecodeString(resp.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode value of secret %s: %w", s, err)
+	}
+
+	return string(value), nil
+}
+
+func (s *Secret) String() string {
+	return fmt.Sprintf("%s/%s", s.Scope, s.Key)
+}
@@ -0,0 +1,99 @@
+package variable
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func (v *Variable) hasConstraints() bool {
+	return len(v.Enum) > 0 || v.Pattern != "" || v.Minimum != nil || v.Maximum != nil
+}
+
+// ValidateDefinition returns an error if the definition of the variable is inconsistent.
+func (v *Variable) ValidateDefinition() error {
+	if v.Secret != nil {
+		if err := v.Secret.validate(); err != nil {
+			return err
+		}
+		if v.IsComplex() {
+			return fmt.Errorf("secret variables cannot be of complex type")
+		}
+		if v.Lookup != nil {
+			return fmt.Errorf("variable cannot define both secret and lookup")
+		}
+		if v.HasDefault() {
+			return fmt.Errorf("variable cannot define both secret and default")
+		}
+	}
+
+	if v.hasConstraints() && v.IsComplex() {
+		return fmt.Errorf("enum, pattern, minimum and maximum are not supported for variables of complex type")
+	}
+
+	if v.Pattern != "" {
+		_, err := regexp.Compile(v.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", v.Pattern, err)
+		}
+	}
+
+	if v.Minimum != nil && v.Maximum != nil && *v.Minimum > *v.Maximum {
+		return fmt.Errorf("minimum %s is greater than maximum %s", formatFloat(*v.Minimum), formatFloat(*v.Maximum))
+	}
+
+	return nil
+}
+
+// ValidateValue returns an error if the value of the variable
+// does not satisfy the constraints of its definition.
+func (v *Variable) ValidateValue() error {
+	if !v.HasValue() || v.IsComplex() {
+		return nil
+	}
+
+	// Values assigned through the command line or the environment are strings,
+	// so all values are compared by their string representation.
+	s := fmt.Sprint(v.Value)
+
+	if len(v.Enum) > 0 {
+		allowed := make([]string, len(v.Enum))
+		for i, e := range v.Enum {
+			allowed[i] = fmt.Sprint(e)
+		}
+		if !slices.Contains(allowed, s) {
+			return fmt.Errorf("value %q is not one of the allowed values: %s", s, strings.Join(allowed, ", "))
+		}
+	}
+
+	if v.Pattern != "" {
+		re, err := regexp.Compile(`^(?:` + v.Pattern + `)$`)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", v.Pattern, err)
+		}
+		if !re.MatchString(s) {
+			return fmt.Errorf("value %q does not match pattern %q", s, v.Pattern)
+		}
+	}
+
+	if v.Minimum != nil || v.Maximum != nil {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("value %q is not a number", s)
+		}
+		if v.Minimum != nil && f < *v.Minimum {
+			return fmt.Errorf("value %s is less than the minimum of %s", s, formatFloat(*v.Minimum))
+		}
+		if v.Maximum != nil && f > *v.Maximum {
+			return fmt.Errorf("value %s is greater than the maximum of %s", s, formatFloat(*v.Maximum))
+		}
+	}
+
+	return nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
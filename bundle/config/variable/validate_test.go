@@ -0,0 +1,143 @@
+package variable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func TestValidateDefinition(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		v    Variable
+		err  string
+	}{
+		{
+			name: "plain",
+			v:    Variable{Default: "foo"},
+		},
+		{
+			name: "secret",
+			v:    Variable{Secret: &Secret{Scope: "scope", Key: "key"}},
+		},
+		{
+			name: "secret without key",
+			v:    Variable{Secret: &Secret{Scope: "scope"}},
+			err:  "secret must specify both scope and key",
+		},
+		{
+			name: "secret with default",
+			v:    Variable{Secret: &Secret{Scope: "scope", Key: "key"}, Default: "foo"},
+			err:  "variable cannot define both secret and default",
+		},
+		{
+			name: "secret with lookup",
+			v:    Variable{Secret: &Secret{Scope: "scope", Key: "key"}, Lookup: &Lookup{Cluster: "foo"}},
+			err:  "variable cannot define both secret and lookup",
+		},
+		{
+			name: "complex secret",
+			v:    Variable{Secret: &Secret{Scope: "scope", Key: "key"}, Type: VariableTypeComplex},
+			err:  "secret variables cannot be of complex type",
+		},
+		{
+			name: "complex with enum",
+			v:    Variable{Type: VariableTypeComplex, Enum: []VariableValue{"a"}},
+			err:  "enum, pattern, minimum and maximum are not supported for variables of complex type",
+		},
+		{
+			name: "invalid pattern",
+			v:    Variable{Pattern: "("},
+			err:  `invalid pattern "("`,
+		},
+		{
+			name: "minimum greater than maximum",
+			v:    Variable{Minimum: float(10), Maximum: float(1.5)},
+			err:  "minimum 10 is greater than maximum 1.5",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.v.ValidateDefinition()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		v    Variable
+		err  string
+	}{
+		{
+			name: "no value",
+			v:    Variable{Enum: []VariableValue{"a"}},
+		},
+		{
+			name: "enum",
+			v:    Variable{Value: "b", Enum: []VariableValue{"a", "b"}},
+		},
+		{
+			name: "enum with numbers",
+			v:    Variable{Value: "2", Enum: []VariableValue{1, 2}},
+		},
+		{
+			name: "not in enum",
+			v:    Variable{Value: "c", Enum: []VariableValue{"a", "b"}},
+			err:  `value "c" is not one of the allowed values: a, b`,
+		},
+		{
+			name: "pattern",
+			v:    Variable{Value: "dev-1", Pattern: `[a-z]+-\d+`},
+		},
+		{
+			name: "pattern must match entire value",
+			v:    Variable{Value: "dev-1x", Pattern: `[a-z]+-\d+`},
+			err:  `value "dev-1x" does not match pattern "[a-z]+-\\d+"`,
+		},
+		{
+			name: "within bounds",
+			v:    Variable{Value: "5", Minimum: float(1), Maximum: float(5)},
+		},
+		{
+			name: "not a number",
+			v:    Variable{Value: "five", Minimum: float(1)},
+			err:  `value "five" is not a number`,
+		},
+		{
+			name: "less than minimum",
+			v:    Variable{Value: 0, Minimum: float(1)},
+			err:  "value 0 is less than the minimum of 1",
+		},
+		{
+			name: "greater than maximum",
+			v:    Variable{Value: "5.5", Maximum: float(5)},
+			err:  "value 5.5 is greater than the maximum of 5",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.v.ValidateValue()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestIsSecret(t *testing.T) {
+	v := Variable{Secret: &Secret{Scope: "scope", Key: "key"}}
+	assert.True(t, v.IsSecret())
+
+	v.Value = "foo"
+	assert.False(t, v.IsSecret())
+}
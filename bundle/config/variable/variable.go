@@ -36,16 +36,42 @@ type Variable struct {
 	// resolved in the following priority order (from highest to lowest)
 	//
 	// 1. Command line flag. For example: `--var="foo=bar"`
-	// 2. Target variable. eg: BUNDLE_VAR_foo=bar
-	// 3. Default value as defined in the applicable environments block
-	// 4. Default value defined in variable definition
-	// 5. Throw error, since if no default value is defined, then the variable
+	// 2. Variable file passed with the `--var-file` flag. Later files take precedence.
+	// 3. Target variable. eg: BUNDLE_VAR_foo=bar
+	// 4. Variable overrides files in .databricks/bundle/<target>/. Values in
+	//    variable-overrides.json take precedence over values in variables.json.
+	// 5. Default value as defined in the applicable environments block
+	// 6. Default value defined in variable definition
+	// 7. Throw error, since if no default value is defined, then the variable
 	//    is required
 	Value VariableValue `json:"value,omitempty" bundle:"readonly"`
 
+	// Describes where the value of the variable was assigned from, for example
+	// the "--var" flag, a variable file or the default value.
+	Source string `json:"source,omitempty" bundle:"readonly"`
+
 	// The value of this field will be used to lookup the resource by name
 	// And assign the value of the variable to ID of the resource found.
 	Lookup *Lookup `json:"lookup,omitempty"`
+
+	// The value of this field refers to a secret that the variable resolves to
+	// when resources are deployed. See [Secret] for details.
+	Secret *Secret `json:"secret,omitempty"`
+
+	// If set, the default value defined for the variable at the top level is not
+	// used. A value must be assigned with the "--var" flag, an environment variable
+	// or in the definition of the variable for the selected target.
+	Required bool `json:"required,omitempty"`
+
+	// Values that are allowed for the variable.
+	Enum []VariableValue `json:"enum,omitempty"`
+
+	// Regular expression that the entire value of the variable must match.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Inclusive bounds for numeric values of the variable.
+	Minimum *float64 `json:"minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty"`
 }
 
 // True if the variable has been assigned a default value. Variables without a
@@ -80,3 +106,9 @@ func (v *Variable) Set(val VariableValue) error {
 func (v *Variable) IsComplex() bool {
 	return v.Type == VariableTypeComplex
 }
+
+// True if the variable resolves to a secret when resources are deployed.
+// Variables that are assigned a value explicitly do not.
+func (v *Variable) IsSecret() bool {
+	return v.Secret != nil && !v.HasValue()
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlloader"
+)
+
+// VariableOverridesPaths returns the paths of the files with variable values for
+// the specified target. The files are read automatically if they exist, such that
+// values can be injected without passing flags, for example in CI.
+//
+// Both variables.json and variable-overrides.json are supported. Values in
+// variable-overrides.json take precedence over values in variables.json.
+func VariableOverridesPaths(root string, target string) []string {
+	dir := filepath.Join(root, ".databricks", "bundle", target)
+	return []string{
+		filepath.Join(dir, "variables.json"),
+		filepath.Join(dir, "variable-overrides.json"),
+	}
+}
+
+// VariableFileSource returns the path of a variable file relative to the bundle
+// root. It is used as the source of the values in the file, such that sources
+// are displayed the same way regardless of how the file was found.
+func VariableFileSource(root string, path string) string {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	rel, err := filepath.Rel(absRoot, absPath)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// LoadVariableFile returns the variable values defined in a YAML or JSON file.
+// The file must contain a mapping of variable names to their values.
+// Values can be of complex type.
+func LoadVariableFile(path string) (map[string]dyn.Value, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// YAML is a superset of JSON, so this loads both.
+	v, err := yamlloader.LoadYAML(path, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	// An empty file doesn't assign any values.
+	if v.Kind() == dyn.KindNil {
+		return nil, nil
+	}
+
+	m, ok := v.AsMap()
+	if !ok {
+		return nil, fmt.Errorf("failed to load %s: expected a mapping of variable names to values, found %s", path, v.Kind())
+	}
+
+	out := make(map[string]dyn.Value, m.Len())
+	for _, pair := range m.Pairs() {
+		name, ok := pair.Key.AsString()
+		if !ok {
+			return nil, fmt.Errorf("failed to load %s: expected variable name to be a string, found %s", path, pair.Key.Kind())
+		}
+		out[name] = pair.Value
+	}
+	return out, nil
+}
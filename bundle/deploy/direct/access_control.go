@@ -0,0 +1,204 @@
+package direct
+
+import (
+	"context"
+	"slices"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+)
+
+// Maps resource groups to the object type used by the permissions API.
+var permissionObjectTypes = map[string]string{
+	"jobs":                    "jobs",
+	"pipelines":               "pipelines",
+	"clusters":                "clusters",
+	"experiments":             "experiments",
+	"models":                  "registered-models",
+	"model_serving_endpoints": "serving-endpoints",
+}
+
+// Maps resource groups to the securable type used by the Unity Catalog grants API.
+var grantSecurableTypes = map[string]catalog.SecurableType{
+	"schemas":           catalog.SecurableTypeSchema,
+	"volumes":           catalog.SecurableTypeVolume,
+	"registered_models": catalog.SecurableTypeFunction,
+}
+
+// applyAccessControl sets the permissions or grants of a resource to the ones in
+// its configuration. It is a no-op if the resource has neither configured now
+// nor in its previous configuration, such that access control that is managed
+// outside of the bundle is left untouched.
+func applyAccessControl(ctx context.Context, w *databricks.WorkspaceClient, group, id string, v dyn.Value, previous any) error {
+	if objectType, ok := permissionObjectTypes[group]; ok {
+		return applyPermissions(ctx, w, group, objectType, id, v, previous)
+	}
+	if securableType, ok := grantSecurableTypes[group]; ok {
+		return applyGrants(ctx, w, securableType, id, v, previous)
+	}
+	return nil
+}
+
+// hadField returns true if the previously deployed configuration of a resource
+// has a non-empty value for the specified field. Resources without a previous
+// configuration were imported and may have had the field set.
+func hadField(previous any, field string) bool {
+	if previous == nil {
+		return true
+	}
+	m, ok := previous.(map[string]any)
+	if !ok {
+		return false
+	}
+	l, ok := m[field].([]any)
+	return ok && len(l) > 0
+}
+
+func applyPermissions(ctx context.Context, w *databricks.WorkspaceClient, group, objectType, id string, v dyn.Value, previous any) error {
+	var permissions []resources.Permission
+	pv := v.Get("permissions")
+	if pv.IsValid() {
+		if err := convert.ToTyped(&permissions, pv); err != nil {
+			return err
+		}
+	}
+
+	if len(permissions) == 0 && !hadField(previous, "permissions") {
+		return nil
+	}
+
+	objectId, err := permissionObjectId(ctx, w, group, id)
+	if err != nil {
+		return err
+	}
+
+	acl := make([]iam.AccessControlRequest, 0, len(permissions))
+	for _, p := range permissions {
+		acl = append(acl, iam.AccessControlRequest{
+			UserName:             p.UserName,
+			GroupName:            p.GroupName,
+			ServicePrincipalName: p.ServicePrincipalName,
+			PermissionLevel:      iam.PermissionLevel(p.Level),
+		})
+	}
+
+	_, err = w.Permissions.Set(ctx, iam.PermissionsRequest{
+		RequestObjectType: objectType,
+		RequestObjectId:   objectId,
+		AccessControlList: acl,
+	})
+	return err
+}
+
+// permissionObjectId returns the ID the permissions API uses for a resource.
+// This is different from the resource ID for resources that are identified by their name.
+func permissionObjectId(ctx context.Context, w *databricks.WorkspaceClient, group, id string) (string, error) {
+	switch group {
+	case "models":
+		resp, err := w.ModelRegistry.GetModel(ctx, ml.GetModelRequest{Name: id})
+		if err != nil {
+			return "", err
+		}
+		return resp.RegisteredModelDatabricks.Id, nil
+	case "model_serving_endpoints":
+		resp, err := w.ServingEndpoints.GetByName(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return resp.Id, nil
+	default:
+		return id, nil
+	}
+}
+
+// applyGrants makes the grants on a securable match the configured grants.
+// Privileges of principals that are not in the configuration are revoked.
+func applyGrants(ctx context.Context, w *databricks.WorkspaceClient, securableType catalog.SecurableType, id string, v dyn.Value, previous any) error {
+	var grants []resources.Grant
+	gv := v.Get("grants")
+	if gv.IsValid() {
+		if err := convert.ToTyped(&grants, gv); err != nil {
+			return err
+		}
+	}
+
+	if len(grants) == 0 && !hadField(previous, "grants") {
+		return nil
+	}
+
+	current, err := w.Grants.Get(ctx, catalog.GetGrantRequest{
+		SecurableType: securableType,
+		FullName:      id,
+	})
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string][]catalog.Privilege)
+	for _, g := range grants {
+		for _, p := range g.Privileges {
+			desired[g.Principal] = append(desired[g.Principal], catalog.Privilege(p))
+		}
+	}
+
+	actual := make(map[string][]catalog.Privilege)
+	for _, a := range current.PrivilegeAssignments {
+		actual[a.Principal] = append(actual[a.Principal], a.Privileges...)
+	}
+
+	changes := grantChanges(desired, actual)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = w.Grants.Update(ctx, catalog.UpdatePermissions{
+		SecurableType: securableType,
+		FullName:      id,
+		Changes:       changes,
+	})
+	return err
+}
+
+// grantChanges returns the changes that turn the actual privileges per principal
+// into the desired privileges per principal.
+func grantChanges(desired, actual map[string][]catalog.Privilege) []catalog.PermissionsChange {
+	principals := make([]string, 0, len(desired)+len(actual))
+	for p := range desired {
+		principals = append(principals, p)
+	}
+	for p := range actual {
+		if _, ok := desired[p]; !ok {
+			principals = append(principals, p)
+		}
+	}
+	slices.Sort(principals)
+
+	var changes []catalog.PermissionsChange
+	for _, principal := range principals {
+		var add, remove []catalog.Privilege
+		for _, p := range desired[principal] {
+			if !slices.Contains(actual[principal], p) && !slices.Contains(add, p) {
+				add = append(add, p)
+			}
+		}
+		for _, p := range actual[principal] {
+			if !slices.Contains(desired[principal], p) && !slices.Contains(remove, p) {
+				remove = append(remove, p)
+			}
+		}
+		if len(add) == 0 && len(remove) == 0 {
+			continue
+		}
+		changes = append(changes, catalog.PermissionsChange{
+			Principal: principal,
+			Add:       add,
+			Remove:    remove,
+		})
+	}
+	return changes
+}
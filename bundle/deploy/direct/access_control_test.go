@@ -0,0 +1,46 @@
+package direct
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantChanges(t *testing.T) {
+	desired := map[string][]catalog.Privilege{
+		"alice": {catalog.PrivilegeUseSchema, catalog.PrivilegeSelect},
+		"bob":   {catalog.PrivilegeSelect},
+	}
+	actual := map[string][]catalog.Privilege{
+		"alice": {catalog.PrivilegeUseSchema, catalog.PrivilegeModify},
+		"bob":   {catalog.PrivilegeSelect},
+		"carol": {catalog.PrivilegeAllPrivileges},
+	}
+
+	assert.Equal(t, []catalog.PermissionsChange{
+		{
+			Principal: "alice",
+			Add:       []catalog.Privilege{catalog.PrivilegeSelect},
+			Remove:    []catalog.Privilege{catalog.PrivilegeModify},
+		},
+		{
+			Principal: "carol",
+			Remove:    []catalog.Privilege{catalog.PrivilegeAllPrivileges},
+		},
+	}, grantChanges(desired, actual))
+}
+
+func TestGrantChangesNoChanges(t *testing.T) {
+	grants := map[string][]catalog.Privilege{
+		"alice": {catalog.PrivilegeUseSchema},
+	}
+	assert.Empty(t, grantChanges(grants, grants))
+}
+
+func TestHadField(t *testing.T) {
+	assert.True(t, hadField(nil, "permissions"))
+	assert.False(t, hadField(map[string]any{}, "permissions"))
+	assert.False(t, hadField(map[string]any{"permissions": []any{}}, "permissions"))
+	assert.True(t, hadField(map[string]any{"permissions": []any{map[string]any{}}}, "permissions"))
+}
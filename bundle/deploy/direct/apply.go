@@ -0,0 +1,168 @@
+package direct
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/cli/libs/log"
+	terraformlib "github.com/databricks/cli/libs/terraform"
+	"github.com/databricks/databricks-sdk-go"
+)
+
+type apply struct {
+	goal terraform.PlanGoal
+}
+
+func (a *apply) Name() string {
+	return "direct.Apply"
+}
+
+func (a *apply) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	state, err := LoadState(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	root := b.Config.Value()
+	changes, err := computeChanges(root, state, a.goal)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(changes) == 0 {
+		log.Debugf(ctx, "No changes in plan. Skipping apply.")
+		return nil
+	}
+
+	var resources map[string]*resource
+	var secrets map[string]string
+	if a.goal != terraform.PlanDestroy {
+		resources, err = collectResources(root)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		names, err := config.ReferencedSecretVariables(root)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		secrets, err = b.Config.ResolveSecretVariables(ctx, b.WorkspaceClient(), names)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Record the changes that were applied, even if a later change fails.
+	// Otherwise resources created before the failure would be created again
+	// on the next deployment.
+	var diags diag.Diagnostics
+	w := b.WorkspaceClient()
+	for _, change := range changes {
+		err = applyChange(ctx, w, root, state, secrets, change, resources[change.Group+"."+change.Key])
+		if err != nil {
+			diags = diag.Errorf("failed to %s %s: %v", change.Action, change.ResourceKey(), err)
+			break
+		}
+	}
+
+	err = state.Save(ctx, b)
+	if err != nil {
+		return diags.Extend(diag.FromErr(err))
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Infof(ctx, "apply completed")
+	return nil
+}
+
+func applyChange(ctx context.Context, w *databricks.WorkspaceClient, root dyn.Value, state *State, secrets map[string]string, change terraform.ResourceChange, r *resource) error {
+	group, key := change.Group, change.Key
+	rs := state.Get(group, key)
+
+	// Resources that are recreated are deleted first.
+	if change.Action == terraformlib.ActionTypeDelete || change.Action == terraformlib.ActionTypeRecreate {
+		cr, ok := config.NewConfigResource(group)
+		if !ok {
+			return fmt.Errorf("unsupported resource type %q", group)
+		}
+		log.Infof(ctx, "Deleting %s with ID %s", change.ResourceKey(), rs.ID)
+		err := cr.Delete(ctx, w, rs.ID)
+		if err != nil {
+			return err
+		}
+		state.Remove(group, key)
+		if change.Action == terraformlib.ActionTypeDelete {
+			return nil
+		}
+	}
+
+	v, err := resolveReferences(root, r.value, state, false)
+	if err != nil {
+		return err
+	}
+
+	// The values of secret variables are only used to call the APIs.
+	// The state records the configuration with references to them in place.
+	// As a consequence, changing the value of a secret doesn't change the
+	// recorded configuration and doesn't cause the resource to be updated.
+	resolved, err := resolveSecrets(root, v, secrets)
+	if err != nil {
+		return err
+	}
+
+	cr, ok := config.NewConfigResource(group)
+	if !ok {
+		return fmt.Errorf("unsupported resource type %q", group)
+	}
+	err = convert.ToTyped(cr, resolved)
+	if err != nil {
+		return err
+	}
+
+	var id string
+	var previous any
+	if rs := state.Get(group, key); rs != nil {
+		log.Infof(ctx, "Updating %s with ID %s", change.ResourceKey(), rs.ID)
+		id, err = cr.Update(ctx, w, rs.ID)
+		previous = rs.Config
+	} else {
+		log.Infof(ctx, "Creating %s", change.ResourceKey())
+		id, err = cr.Create(ctx, w)
+		previous = map[string]any{}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Record the resource before applying access control, such that a failure
+	// to apply permissions does not cause the resource to be created again.
+	state.Set(group, key, &ResourceState{ID: id, DependsOn: r.deps})
+
+	err = applyAccessControl(ctx, w, group, id, resolved, previous)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := normalize(v)
+	if err != nil {
+		return err
+	}
+	state.Set(group, key, &ResourceState{ID: id, Config: cfg, DependsOn: r.deps})
+	return nil
+}
+
+// Apply returns a [bundle.Mutator] that creates, updates and deletes resources
+// by calling the Databricks APIs directly, and records the result in the state
+// file of the direct deployment engine.
+func Apply(goal terraform.PlanGoal) bundle.Mutator {
+	return &apply{goal: goal}
+}
@@ -0,0 +1,97 @@
+package direct
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+)
+
+// findGroup returns the resource group of the resource with the specified key.
+func findGroup(b *bundle.Bundle, key string) (string, error) {
+	var found []string
+	m, _ := b.Config.Value().Get("resources").AsMap()
+	for _, pair := range m.Pairs() {
+		if pair.Value.Get(key).IsValid() {
+			found = append(found, pair.Key.MustString())
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("no such resource: %s", key)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("ambiguous: %s (can resolve to all of %s)", key, found)
+	}
+}
+
+type bind struct {
+	key string
+	id  string
+}
+
+func (m *bind) Name() string {
+	return "direct.Bind"
+}
+
+func (m *bind) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	group, err := findGroup(b, m.key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	state, err := LoadState(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rs := state.Get(group, m.key); rs != nil {
+		return diag.Errorf("resource %s is already bound to ID %s", m.key, rs.ID)
+	}
+
+	// The configuration is not recorded, such that the resource is
+	// updated to match its configuration on the next deployment.
+	state.Set(group, m.key, &ResourceState{ID: m.id})
+	return diag.FromErr(state.Save(ctx, b))
+}
+
+// Bind returns a [bundle.Mutator] that records the resource with the
+// specified key as deployed with the specified ID.
+func Bind(key, id string) bundle.Mutator {
+	return &bind{key: key, id: id}
+}
+
+type unbind struct {
+	key string
+}
+
+func (m *unbind) Name() string {
+	return "direct.Unbind"
+}
+
+func (m *unbind) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	group, err := findGroup(b, m.key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	state, err := LoadState(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if state.Get(group, m.key) == nil {
+		return diag.Errorf("resource %s is not bound", m.key)
+	}
+
+	state.Remove(group, m.key)
+	return diag.FromErr(state.Save(ctx, b))
+}
+
+// Unbind returns a [bundle.Mutator] that removes the resource with the
+// specified key from the state without deleting it.
+func Unbind(key string) bundle.Mutator {
+	return &unbind{key: key}
+}
@@ -0,0 +1,69 @@
+package direct
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/diag"
+	"golang.org/x/sync/errgroup"
+)
+
+type checkRunningResources struct{}
+
+func (l *checkRunningResources) Name() string {
+	return "direct:check-running-resources"
+}
+
+func (l *checkRunningResources) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	if !b.Config.Bundle.Deployment.FailOnActiveRuns {
+		return nil
+	}
+
+	state, err := LoadState(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	w := b.WorkspaceClient()
+	errs, errCtx := errgroup.WithContext(ctx)
+
+	for _, rs := range state.Resources["jobs"] {
+		id := rs.ID
+		errs.Go(func() error {
+			isRunning, err := terraform.IsJobRunning(errCtx, w, id)
+			if err != nil {
+				return err
+			}
+			if isRunning {
+				return fmt.Errorf("job %s is running", id)
+			}
+			return nil
+		})
+	}
+
+	for _, rs := range state.Resources["pipelines"] {
+		id := rs.ID
+		errs.Go(func() error {
+			isRunning, err := terraform.IsPipelineRunning(errCtx, w, id)
+			// If there's an error retrieving the pipeline, we assume it's not running
+			if err != nil {
+				return nil
+			}
+			if isRunning {
+				return fmt.Errorf("pipeline %s is running", id)
+			}
+			return nil
+		})
+	}
+
+	return diag.FromErr(errs.Wait())
+}
+
+// CheckRunningResource returns a [bundle.Mutator] that fails the deployment if
+// any of the deployed jobs or pipelines is running and the bundle is configured
+// to fail on active runs.
+func CheckRunningResource() bundle.Mutator {
+	return &checkRunningResources{}
+}
@@ -0,0 +1,93 @@
+package direct
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type loadMode int
+
+const ErrorOnEmptyState loadMode = 0
+
+type load struct {
+	modes []loadMode
+}
+
+func (l *load) Name() string {
+	return "direct.Load"
+}
+
+func (l *load) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	state, err := LoadState(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := state.Keys()
+	if len(keys) == 0 {
+		for _, mode := range l.modes {
+			if mode == ErrorOnEmptyState {
+				return diag.Errorf("no deployment state. Did you forget to run 'databricks bundle deploy'?")
+			}
+		}
+	}
+
+	// Merge state into configuration. Resources that are in the state but not
+	// in the configuration are added and marked as deleted.
+	err = b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		for _, k := range keys {
+			group, key, _ := strings.Cut(k, ".")
+			rs := state.Get(group, key)
+			p := dyn.NewPath(dyn.Key("resources"), dyn.Key(group), dyn.Key(key))
+
+			if _, err := dyn.GetByPath(root, p); err == nil {
+				root, err = dyn.SetByPath(root, p.Append(dyn.Key("id")), dyn.V(rs.ID))
+				if err != nil {
+					return dyn.InvalidValue, err
+				}
+				continue
+			}
+
+			root, err = setWithParents(root, p, dyn.V(map[string]dyn.Value{
+				"id":              dyn.V(rs.ID),
+				"modified_status": dyn.V(string(resources.ModifiedStatusDeleted)),
+			}))
+			if err != nil {
+				return dyn.InvalidValue, err
+			}
+		}
+		return root, nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to load deployment state: %w", err))
+	}
+
+	return nil
+}
+
+// setWithParents is like [dyn.SetByPath] but creates intermediate maps if they don't exist.
+func setWithParents(root dyn.Value, p dyn.Path, v dyn.Value) (dyn.Value, error) {
+	for i := 1; i < len(p); i++ {
+		if _, err := dyn.GetByPath(root, p[:i]); err == nil {
+			continue
+		}
+		var err error
+		root, err = dyn.SetByPath(root, p[:i], dyn.V(map[string]dyn.Value{}))
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+	}
+	return dyn.SetByPath(root, p, v)
+}
+
+// Load returns a [bundle.Mutator] that sets the IDs of deployed resources
+// in the configuration from the state file of the direct deployment engine.
+func Load(modes ...loadMode) bundle.Mutator {
+	return &load{modes: modes}
+}
@@ -0,0 +1,152 @@
+package direct
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/dyn"
+	terraformlib "github.com/databricks/cli/libs/terraform"
+)
+
+// Fields that cannot be changed on an existing resource. A change to any of
+// these fields requires the resource to be deleted and created again.
+var recreateFields = map[string][]string{
+	"pipelines":               {"catalog", "storage"},
+	"experiments":             {"artifact_location"},
+	"model_serving_endpoints": {"name"},
+	"registered_models":       {"catalog_name", "schema_name"},
+	"quality_monitors":        {"table_name"},
+	"schemas":                 {"catalog_name", "storage_root"},
+	"volumes":                 {"catalog_name", "schema_name", "volume_type", "storage_location"},
+}
+
+func requiresRecreate(group string, fields []terraform.FieldChange) bool {
+	for _, f := range fields {
+		for _, name := range recreateFields[group] {
+			if f.Path == name || strings.HasPrefix(f.Path, name+".") || strings.HasPrefix(f.Path, name+"[") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PlanChanges returns the changes the direct deployment engine makes to
+// bundle resources to reach the specified goal. It compares the configuration
+// with the local state file and does not make any API calls.
+//
+// The changes are returned in the order they are applied: deletions in reverse
+// dependency order first, followed by all other changes in dependency order.
+func PlanChanges(ctx context.Context, b *bundle.Bundle, goal terraform.PlanGoal) ([]terraform.ResourceChange, error) {
+	state, err := LoadState(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return computeChanges(b.Config.Value(), state, goal)
+}
+
+func computeChanges(root dyn.Value, state *State, goal terraform.PlanGoal) ([]terraform.ResourceChange, error) {
+	configured := make(map[string]*resource)
+	if goal != terraform.PlanDestroy {
+		var err error
+		configured, err = collectResources(root)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Resources in the state that are no longer configured are deleted
+	// before resources that they depend on.
+	var removed []string
+	for _, id := range state.Keys() {
+		if _, ok := configured[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	removed, err := dependencyOrder(removed, func(id string) []string {
+		group, key, _ := strings.Cut(id, ".")
+		return state.Get(group, key).DependsOn
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(removed)
+
+	var changes []terraform.ResourceChange
+	for _, id := range removed {
+		group, key, _ := strings.Cut(id, ".")
+		changes = append(changes, terraform.ResourceChange{
+			Group:  group,
+			Key:    key,
+			Action: terraformlib.ActionTypeDelete,
+		})
+	}
+
+	ids := make([]string, 0, len(configured))
+	for id := range configured {
+		ids = append(ids, id)
+	}
+	ordered, err := dependencyOrder(ids, func(id string) []string {
+		return configured[id].deps
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Resources that are recreated get a new ID. References to their ID are
+	// resolved as unknown, such that resources that refer to them are updated.
+	known := state.clone()
+
+	for _, id := range ordered {
+		r := configured[id]
+		rs := state.Get(r.group, r.key)
+		if rs == nil {
+			changes = append(changes, terraform.ResourceChange{
+				Group:  r.group,
+				Key:    r.key,
+				Action: terraformlib.ActionTypeCreate,
+			})
+			continue
+		}
+
+		// References to resources that are created as part of this
+		// deployment are left in place and show up as a change.
+		v, err := resolveReferences(root, r.value, known, true)
+		if err != nil {
+			return nil, err
+		}
+		config, err := normalize(v)
+		if err != nil {
+			return nil, err
+		}
+
+		// Resources without a recorded configuration are always updated.
+		if rs.Config != nil && reflect.DeepEqual(rs.Config, config) {
+			continue
+		}
+
+		var fields []terraform.FieldChange
+		if rs.Config != nil {
+			fields = terraform.DiffFields(rs.Config, config)
+		}
+
+		action := terraformlib.ActionTypeUpdate
+		if requiresRecreate(r.group, fields) {
+			action = terraformlib.ActionTypeRecreate
+			known.Remove(r.group, r.key)
+		}
+
+		changes = append(changes, terraform.ResourceChange{
+			Group:  r.group,
+			Key:    r.key,
+			Action: action,
+			Fields: fields,
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,156 @@
+package direct
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/dyn"
+	terraformlib "github.com/databricks/cli/libs/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func planTestConfig() dyn.Value {
+	return dyn.V(map[string]dyn.Value{
+		"resources": dyn.V(map[string]dyn.Value{
+			"jobs": dyn.V(map[string]dyn.Value{
+				"my_job": dyn.V(map[string]dyn.Value{
+					"name": dyn.V("job"),
+					"tasks": dyn.V([]dyn.Value{
+						dyn.V(map[string]dyn.Value{
+							"pipeline_task": dyn.V(map[string]dyn.Value{
+								"pipeline_id": dyn.V("${resources.pipelines.my_pipeline.id}"),
+							}),
+						}),
+					}),
+				}),
+			}),
+			"pipelines": dyn.V(map[string]dyn.Value{
+				"my_pipeline": dyn.V(map[string]dyn.Value{
+					"name":    dyn.V("pipeline"),
+					"catalog": dyn.V("main"),
+				}),
+			}),
+		}),
+	})
+}
+
+func TestComputeChangesEmptyState(t *testing.T) {
+	changes, err := computeChanges(planTestConfig(), NewState(), terraform.PlanDeploy)
+	require.NoError(t, err)
+
+	// The pipeline is created before the job that refers to it.
+	assert.Equal(t, []terraform.ResourceChange{
+		{Group: "pipelines", Key: "my_pipeline", Action: terraformlib.ActionTypeCreate},
+		{Group: "jobs", Key: "my_job", Action: terraformlib.ActionTypeCreate},
+	}, changes)
+}
+
+func TestComputeChangesNoChanges(t *testing.T) {
+	state := NewState()
+	state.Set("pipelines", "my_pipeline", &ResourceState{
+		ID:     "1234",
+		Config: map[string]any{"name": "pipeline", "catalog": "main"},
+	})
+	state.Set("jobs", "my_job", &ResourceState{
+		ID: "5678",
+		Config: map[string]any{
+			"name": "job",
+			"tasks": []any{
+				map[string]any{"pipeline_task": map[string]any{"pipeline_id": "1234"}},
+			},
+		},
+		DependsOn: []string{"pipelines.my_pipeline"},
+	})
+
+	changes, err := computeChanges(planTestConfig(), state, terraform.PlanDeploy)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestComputeChangesUpdateAndRecreate(t *testing.T) {
+	state := NewState()
+	state.Set("pipelines", "my_pipeline", &ResourceState{
+		ID:     "1234",
+		Config: map[string]any{"name": "pipeline", "catalog": "other"},
+	})
+
+	// Imported resources do not have a recorded configuration.
+	state.Set("jobs", "my_job", &ResourceState{ID: "5678"})
+
+	changes, err := computeChanges(planTestConfig(), state, terraform.PlanDeploy)
+	require.NoError(t, err)
+	assert.Equal(t, []terraform.ResourceChange{
+		{
+			Group:  "pipelines",
+			Key:    "my_pipeline",
+			Action: terraformlib.ActionTypeRecreate,
+			Fields: []terraform.FieldChange{{Path: "catalog", Old: "other", New: "main"}},
+		},
+		{Group: "jobs", Key: "my_job", Action: terraformlib.ActionTypeUpdate},
+	}, changes)
+}
+
+func TestComputeChangesUpdatesReferencesToRecreatedResource(t *testing.T) {
+	state := NewState()
+	state.Set("pipelines", "my_pipeline", &ResourceState{
+		ID:     "1234",
+		Config: map[string]any{"name": "pipeline", "catalog": "other"},
+	})
+	state.Set("jobs", "my_job", &ResourceState{
+		ID: "5678",
+		Config: map[string]any{
+			"name": "job",
+			"tasks": []any{
+				map[string]any{"pipeline_task": map[string]any{"pipeline_id": "1234"}},
+			},
+		},
+		DependsOn: []string{"pipelines.my_pipeline"},
+	})
+
+	// The pipeline gets a new ID, so the job that refers to it must be updated.
+	changes, err := computeChanges(planTestConfig(), state, terraform.PlanDeploy)
+	require.NoError(t, err)
+	assert.Equal(t, []terraform.ResourceChange{
+		{
+			Group:  "pipelines",
+			Key:    "my_pipeline",
+			Action: terraformlib.ActionTypeRecreate,
+			Fields: []terraform.FieldChange{{Path: "catalog", Old: "other", New: "main"}},
+		},
+		{
+			Group:  "jobs",
+			Key:    "my_job",
+			Action: terraformlib.ActionTypeUpdate,
+			Fields: []terraform.FieldChange{{
+				Path: "tasks[0].pipeline_task.pipeline_id",
+				Old:  "1234",
+				New:  "${resources.pipelines.my_pipeline.id}",
+			}},
+		},
+	}, changes)
+}
+
+func TestComputeChangesDeleteInReverseDependencyOrder(t *testing.T) {
+	state := NewState()
+	state.Set("pipelines", "old_pipeline", &ResourceState{ID: "1"})
+	state.Set("jobs", "old_job", &ResourceState{ID: "2", DependsOn: []string{"pipelines.old_pipeline"}})
+
+	changes, err := computeChanges(dyn.V(map[string]dyn.Value{}), state, terraform.PlanDeploy)
+	require.NoError(t, err)
+	assert.Equal(t, []terraform.ResourceChange{
+		{Group: "jobs", Key: "old_job", Action: terraformlib.ActionTypeDelete},
+		{Group: "pipelines", Key: "old_pipeline", Action: terraformlib.ActionTypeDelete},
+	}, changes)
+}
+
+func TestComputeChangesDestroy(t *testing.T) {
+	state := NewState()
+	state.Set("pipelines", "my_pipeline", &ResourceState{ID: "1234"})
+
+	changes, err := computeChanges(planTestConfig(), state, terraform.PlanDestroy)
+	require.NoError(t, err)
+	assert.Equal(t, []terraform.ResourceChange{
+		{Group: "pipelines", Key: "my_pipeline", Action: terraformlib.ActionTypeDelete},
+	}, changes)
+}
@@ -0,0 +1,216 @@
+package direct
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+)
+
+// resource is a resource in the bundle configuration.
+type resource struct {
+	group string
+	key   string
+
+	// Configuration of the resource, including references to other resources.
+	value dyn.Value
+
+	// Other resources this resource refers to, for example "jobs.my_job".
+	deps []string
+}
+
+func (r *resource) id() string {
+	return r.group + "." + r.key
+}
+
+// collectResources returns all resources in the configuration keyed by "group.key".
+func collectResources(root dyn.Value) (map[string]*resource, error) {
+	out := make(map[string]*resource)
+	_, err := dyn.MapByPattern(
+		root,
+		dyn.NewPattern(dyn.Key("resources"), dyn.AnyKey(), dyn.AnyKey()),
+		func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+			r := &resource{
+				group: p[1].Key(),
+				key:   p[2].Key(),
+				value: v,
+			}
+
+			deps, err := collectDependencies(r.id(), v)
+			if err != nil {
+				return dyn.InvalidValue, err
+			}
+
+			r.deps = deps
+			out[r.id()] = r
+			return v, nil
+		},
+	)
+	return out, err
+}
+
+// collectDependencies returns the resources that the specified value refers to.
+func collectDependencies(self string, v dyn.Value) ([]string, error) {
+	seen := make(map[string]bool)
+	_, err := dynvar.Resolve(v, func(p dyn.Path) (dyn.Value, error) {
+		if len(p) >= 3 && p[0] == dyn.Key("resources") {
+			dep := p[1].Key() + "." + p[2].Key()
+			if dep != self {
+				seen[dep] = true
+			}
+		}
+		return dyn.InvalidValue, dynvar.ErrSkipResolution
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make([]string, 0, len(seen))
+	for dep := range seen {
+		deps = append(deps, dep)
+	}
+	sort.Strings(deps)
+	return deps, nil
+}
+
+// dependencyOrder returns the specified nodes such that every node comes after
+// the nodes it depends on. Dependencies outside of the specified nodes are ignored.
+// Nodes without an ordering constraint are sorted by name to keep the order stable.
+func dependencyOrder(nodes []string, deps func(string) []string) ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	sorted := append([]string{}, nodes...)
+	sort.Strings(sorted)
+
+	status := make(map[string]int, len(sorted))
+	for _, n := range sorted {
+		status[n] = unvisited
+	}
+
+	var out []string
+	var visit func(n string, path []string) error
+	visit = func(n string, path []string) error {
+		switch status[n] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("cycle detected in resource references: %s", strings.Join(append(path, n), " -> "))
+		}
+
+		status[n] = visiting
+		for _, dep := range deps(n) {
+			if _, ok := status[dep]; !ok {
+				continue
+			}
+			if err := visit(dep, append(path, n)); err != nil {
+				return err
+			}
+		}
+		status[n] = visited
+		out = append(out, n)
+		return nil
+	}
+
+	for _, n := range sorted {
+		if err := visit(n, nil); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// resolveReferences resolves references to other resources in the configuration
+// of a resource. References to the ID of a resource are resolved from the state.
+// If skipUnknown is set, references to resources that have not been deployed
+// yet are left in place instead of returning an error.
+func resolveReferences(root dyn.Value, v dyn.Value, state *State, skipUnknown bool) (dyn.Value, error) {
+	return dynvar.Resolve(v, func(p dyn.Path) (dyn.Value, error) {
+		// References to secret variables are resolved by [resolveSecrets].
+		if _, ok := config.SecretVariableName(root, p); ok {
+			return dyn.InvalidValue, dynvar.ErrSkipResolution
+		}
+
+		if len(p) != 4 || p[0] != dyn.Key("resources") {
+			return dyn.GetByPath(root, p)
+		}
+
+		group, key := p[1].Key(), p[2].Key()
+		switch {
+		case p[3] == dyn.Key("id"):
+			if rs := state.Get(group, key); rs != nil {
+				return dyn.V(rs.ID), nil
+			}
+			if skipUnknown {
+				return dyn.InvalidValue, dynvar.ErrSkipResolution
+			}
+			return dyn.InvalidValue, fmt.Errorf("cannot resolve ${%s}: resources.%s.%s has not been deployed", p, group, key)
+
+		case group == "volumes" && p[3] == dyn.Key("volume_path"):
+			// The path of a volume is determined by its configuration.
+			// See [mutator.ResolveVolumeArtifactPath] for the equivalent before deployment.
+			prefix := p[:3].String()
+			return dyn.V(fmt.Sprintf(
+				"/Volumes/${%s.catalog_name}/${%s.schema_name}/${%s.name}",
+				prefix, prefix, prefix,
+			)), nil
+		}
+
+		return dyn.GetByPath(root, p)
+	})
+}
+
+// resolveSecrets substitutes the values of secret variables for references
+// to them in the configuration of a resource.
+func resolveSecrets(root dyn.Value, v dyn.Value, secrets map[string]string) (dyn.Value, error) {
+	return dynvar.Resolve(v, func(p dyn.Path) (dyn.Value, error) {
+		name, ok := config.SecretVariableName(root, p)
+		if !ok {
+			return dyn.InvalidValue, dynvar.ErrSkipResolution
+		}
+
+		value, ok := secrets[name]
+		if !ok {
+			return dyn.InvalidValue, fmt.Errorf("value of secret variable %s has not been resolved", name)
+		}
+		return dyn.V(value), nil
+	})
+}
+
+// Fields that are set on resources by the CLI itself and are not deployed.
+var internalFields = []string{"id", "modified_status"}
+
+// normalize returns the configuration of a resource in the form it is recorded
+// in the state, such that it can be compared to the recorded configuration.
+func normalize(v dyn.Value) (any, error) {
+	if m, ok := v.AsMap(); ok {
+		out := dyn.NewMapping()
+		for _, pair := range m.Pairs() {
+			if k, _ := pair.Key.AsString(); slices.Contains(internalFields, k) {
+				continue
+			}
+			if err := out.Set(pair.Key, pair.Value); err != nil {
+				return nil, err
+			}
+		}
+		v = dyn.NewValue(out, v.Locations())
+	}
+
+	// Round trip through JSON to get the same types as when reading the state file.
+	buf, err := json.Marshal(v.AsAny())
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+	err = json.Unmarshal(buf, &out)
+	return out, err
+}
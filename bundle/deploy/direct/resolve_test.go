@@ -0,0 +1,143 @@
+package direct
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectResources(t *testing.T) {
+	root := dyn.V(map[string]dyn.Value{
+		"resources": dyn.V(map[string]dyn.Value{
+			"jobs": dyn.V(map[string]dyn.Value{
+				"my_job": dyn.V(map[string]dyn.Value{
+					"name": dyn.V("${resources.pipelines.my_pipeline.name}"),
+					"tasks": dyn.V([]dyn.Value{
+						dyn.V(map[string]dyn.Value{
+							"pipeline_task": dyn.V(map[string]dyn.Value{
+								"pipeline_id": dyn.V("${resources.pipelines.my_pipeline.id}"),
+							}),
+						}),
+					}),
+				}),
+			}),
+			"pipelines": dyn.V(map[string]dyn.Value{
+				"my_pipeline": dyn.V(map[string]dyn.Value{
+					"name": dyn.V("pipeline"),
+				}),
+			}),
+		}),
+	})
+
+	resources, err := collectResources(root)
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, []string{"pipelines.my_pipeline"}, resources["jobs.my_job"].deps)
+	assert.Empty(t, resources["pipelines.my_pipeline"].deps)
+}
+
+func TestDependencyOrder(t *testing.T) {
+	deps := map[string][]string{
+		"a": {"c"},
+		"b": {"a", "unknown"},
+		"c": nil,
+		"d": nil,
+	}
+
+	out, err := dependencyOrder([]string{"d", "b", "a", "c"}, func(n string) []string {
+		return deps[n]
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a", "b", "d"}, out)
+}
+
+func TestDependencyOrderCycle(t *testing.T) {
+	deps := map[string][]string{
+		"a": {"b"},
+		"b": {"a"},
+	}
+
+	_, err := dependencyOrder([]string{"a", "b"}, func(n string) []string {
+		return deps[n]
+	})
+	assert.EqualError(t, err, "cycle detected in resource references: a -> b -> a")
+}
+
+func TestResolveReferences(t *testing.T) {
+	root := dyn.V(map[string]dyn.Value{
+		"resources": dyn.V(map[string]dyn.Value{
+			"pipelines": dyn.V(map[string]dyn.Value{
+				"my_pipeline": dyn.V(map[string]dyn.Value{
+					"name": dyn.V("pipeline"),
+				}),
+			}),
+		}),
+	})
+
+	v := dyn.V(map[string]dyn.Value{
+		"id":   dyn.V("${resources.pipelines.my_pipeline.id}"),
+		"name": dyn.V("${resources.pipelines.my_pipeline.name}"),
+	})
+
+	state := NewState()
+
+	// Resources that are not deployed yet are left in place if requested.
+	out, err := resolveReferences(root, v, state, true)
+	require.NoError(t, err)
+	assert.Equal(t, "${resources.pipelines.my_pipeline.id}", out.Get("id").MustString())
+	assert.Equal(t, "pipeline", out.Get("name").MustString())
+
+	_, err = resolveReferences(root, v, state, false)
+	assert.ErrorContains(t, err, "resources.pipelines.my_pipeline has not been deployed")
+
+	state.Set("pipelines", "my_pipeline", &ResourceState{ID: "1234"})
+	out, err = resolveReferences(root, v, state, false)
+	require.NoError(t, err)
+	assert.Equal(t, "1234", out.Get("id").MustString())
+}
+
+func TestNormalizeSkipsInternalFields(t *testing.T) {
+	v := dyn.V(map[string]dyn.Value{
+		"id":              dyn.V("1234"),
+		"modified_status": dyn.V("created"),
+		"name":            dyn.V("job"),
+		"max_retries":     dyn.V(3),
+	})
+
+	out, err := normalize(v)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "job", "max_retries": float64(3)}, out)
+}
+
+func TestResolveSecrets(t *testing.T) {
+	root := dyn.V(map[string]dyn.Value{
+		"variables": dyn.V(map[string]dyn.Value{
+			"token": dyn.V(map[string]dyn.Value{
+				"secret": dyn.V(map[string]dyn.Value{
+					"scope": dyn.V("scope"),
+					"key":   dyn.V("key"),
+				}),
+			}),
+		}),
+	})
+
+	v := dyn.V(map[string]dyn.Value{
+		"token": dyn.V("${var.token}"),
+		"id":    dyn.V("${resources.pipelines.my_pipeline.id}"),
+	})
+
+	// References to secrets are left in place when resolving other references.
+	out, err := resolveReferences(root, v, NewState(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "${var.token}", out.Get("token").MustString())
+
+	out, err = resolveSecrets(root, out, map[string]string{"token": "s3cr3t"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", out.Get("token").MustString())
+	assert.Equal(t, "${resources.pipelines.my_pipeline.id}", out.Get("id").MustString())
+
+	_, err = resolveSecrets(root, v, nil)
+	assert.EqualError(t, err, "value of secret variable token has not been resolved")
+}
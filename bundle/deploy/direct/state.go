@@ -0,0 +1,180 @@
+package direct
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/google/uuid"
+)
+
+const StateFileName = "resources.json"
+const StateVersion = 1
+
+// State records the resources deployed by the direct deployment engine.
+// It takes the place of the Terraform state file for bundles that are
+// deployed without Terraform.
+type State struct {
+	// Version is the version of the state file format.
+	Version int `json:"version"`
+
+	// Serial is incremented every time the state is written.
+	// It is used to determine if the remote state is newer than the local state.
+	Serial int64 `json:"serial"`
+
+	// Lineage uniquely identifies the deployment this state belongs to.
+	// It is used to detect if the local and remote state are unrelated.
+	Lineage string `json:"lineage"`
+
+	// Resources holds the deployed resources by resource group and key,
+	// for example "jobs" and "my_job".
+	Resources map[string]map[string]*ResourceState `json:"resources,omitempty"`
+}
+
+type ResourceState struct {
+	// ID of the deployed resource.
+	ID string `json:"id"`
+
+	// Configuration of the resource as it was last deployed, with all references
+	// to other resources resolved. This is nil for resources that were imported
+	// from a Terraform state file or bound to an existing resource.
+	// An update is always performed for these resources on the next deployment.
+	Config any `json:"config,omitempty"`
+
+	// Other resources this resource refers to, for example "jobs.my_job".
+	// Resources are deleted in reverse dependency order.
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+func NewState() *State {
+	return &State{
+		Version:   StateVersion,
+		Lineage:   uuid.New().String(),
+		Resources: make(map[string]map[string]*ResourceState),
+	}
+}
+
+// Get returns the state of the resource with the specified group and key, or nil.
+func (s *State) Get(group, key string) *ResourceState {
+	return s.Resources[group][key]
+}
+
+// Set records the state of the resource with the specified group and key.
+func (s *State) Set(group, key string, rs *ResourceState) {
+	if s.Resources == nil {
+		s.Resources = make(map[string]map[string]*ResourceState)
+	}
+	if s.Resources[group] == nil {
+		s.Resources[group] = make(map[string]*ResourceState)
+	}
+	s.Resources[group][key] = rs
+}
+
+// Remove removes the resource with the specified group and key from the state.
+func (s *State) Remove(group, key string) {
+	delete(s.Resources[group], key)
+	if len(s.Resources[group]) == 0 {
+		delete(s.Resources, group)
+	}
+}
+
+// clone returns a copy of the state that can be modified independently.
+// The states of the resources themselves are shared.
+func (s *State) clone() *State {
+	out := *s
+	out.Resources = make(map[string]map[string]*ResourceState, len(s.Resources))
+	for group, resources := range s.Resources {
+		out.Resources[group] = make(map[string]*ResourceState, len(resources))
+		for key, rs := range resources {
+			out.Resources[group][key] = rs
+		}
+	}
+	return &out
+}
+
+// Keys returns the keys of all resources in the state in the form "group.key", sorted.
+func (s *State) Keys() []string {
+	var keys []string
+	for group, resources := range s.Resources {
+		for key := range resources {
+			keys = append(keys, group+"."+key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsEnabled returns true if the bundle is deployed with the direct deployment engine.
+// It is used as the condition for [bundle.If] to select between deployment engines.
+func IsEnabled(ctx context.Context, b *bundle.Bundle) (bool, error) {
+	return b.Config.Bundle.Deployment.IsDirect(), nil
+}
+
+// Dir returns the local directory the state file is stored in.
+func Dir(ctx context.Context, b *bundle.Bundle) (string, error) {
+	return b.CacheDir(ctx, "direct")
+}
+
+func localStatePath(ctx context.Context, b *bundle.Bundle) (string, error) {
+	dir, err := Dir(ctx, b)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, StateFileName), nil
+}
+
+func parseState(content []byte) (*State, error) {
+	state := &State{}
+	err := json.Unmarshal(content, state)
+	if err != nil {
+		return nil, err
+	}
+	if state.Version != StateVersion {
+		return nil, fmt.Errorf("unsupported deployment state version: %d", state.Version)
+	}
+	if state.Resources == nil {
+		state.Resources = make(map[string]map[string]*ResourceState)
+	}
+	return state, nil
+}
+
+// LoadState reads the local state file. If it does not exist, it returns
+// a new, empty state.
+func LoadState(ctx context.Context, b *bundle.Bundle) (*State, error) {
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewState(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseState(content)
+}
+
+// Save increments the serial of the state and writes it to the local state file.
+func (s *State) Save(ctx context.Context, b *bundle.Bundle) error {
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	s.Serial++
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0600)
+}
@@ -0,0 +1,153 @@
+package direct
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+)
+
+type statePull struct {
+	filerFactory deploy.FilerFactory
+}
+
+func (l *statePull) Name() string {
+	return "direct:state-pull"
+}
+
+func readFile(ctx context.Context, f filer.Filer, name string) ([]byte, error) {
+	r, err := f.Read(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func (l *statePull) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	f, err := l.filerFactory(b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	localStatePath, err := localStatePath(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remoteContent, err := readFile(ctx, f, StateFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		// Case: Remote state file does not exist. Use the local state file if it exists,
+		// otherwise import the resources from the Terraform state of a previous deployment.
+		_, err := os.Stat(localStatePath)
+		if err == nil {
+			log.Infof(ctx, "Remote state file does not exist. Using local state.")
+			return nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return diag.FromErr(err)
+		}
+		return diag.FromErr(migrateTerraformState(ctx, b, f))
+	}
+	if err != nil {
+		return diag.Errorf("failed to read remote state file: %v", err)
+	}
+
+	remoteState, err := parseState(remoteContent)
+	if err != nil {
+		return diag.Errorf("failed to parse remote state file: %v", err)
+	}
+
+	// Expected invariant: remote state file should have a lineage UUID. Error
+	// if that's not the case.
+	if remoteState.Lineage == "" {
+		return diag.Errorf("remote state file does not have a lineage")
+	}
+
+	// Case: Local state file does not exist. In this case we should rely on the remote state file.
+	localContent, err := os.ReadFile(localStatePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Infof(ctx, "Local state file does not exist. Using remote state.")
+		return diag.FromErr(os.WriteFile(localStatePath, remoteContent, 0600))
+	}
+	if err != nil {
+		return diag.Errorf("failed to read local state file: %v", err)
+	}
+
+	localState, err := parseState(localContent)
+	if err != nil {
+		return diag.Errorf("failed to parse local state file: %v", err)
+	}
+
+	// If the lineage does not match, the state files do not correspond to the same deployment.
+	if localState.Lineage != remoteState.Lineage {
+		log.Infof(ctx, "Remote and local state lineages do not match. Using remote state. Invalidating local state.")
+		return diag.FromErr(os.WriteFile(localStatePath, remoteContent, 0600))
+	}
+
+	// If the remote state is newer than the local state, we should use the remote state.
+	if remoteState.Serial > localState.Serial {
+		log.Infof(ctx, "Remote state is newer than local state. Using remote state.")
+		return diag.FromErr(os.WriteFile(localStatePath, remoteContent, 0600))
+	}
+
+	// default: local state is newer or equal to remote state in terms of serial sequence.
+	// It is also of the same lineage. Keep using the local state.
+	return nil
+}
+
+// migrateTerraformState creates the state for the direct deployment engine from
+// the Terraform state of a bundle that was previously deployed with Terraform.
+// It prefers the remote Terraform state and falls back to the local one.
+func migrateTerraformState(ctx context.Context, b *bundle.Bundle, f filer.Filer) error {
+	content, err := readFile(ctx, f, terraform.TerraformStateFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		dir, err := terraform.Dir(ctx, b)
+		if err != nil {
+			return err
+		}
+		content, err = os.ReadFile(filepath.Join(dir, terraform.TerraformStateFileName))
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Infof(ctx, "No deployment state found. Starting with empty state.")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	ids, err := terraform.ParseResourceIDs(content)
+	if err != nil {
+		return err
+	}
+
+	cmdio.LogString(ctx, "Importing resources from Terraform state...")
+	state := NewState()
+	for group, resources := range ids {
+		for key, id := range resources {
+			log.Infof(ctx, "Importing resources.%s.%s with ID %s", group, key, id)
+			state.Set(group, key, &ResourceState{ID: id})
+		}
+	}
+
+	return state.Save(ctx, b)
+}
+
+// StatePull downloads the state file of the direct deployment engine from
+// the workspace. If neither a remote nor a local state file exists, it imports
+// the resources recorded in the Terraform state of a previous deployment.
+func StatePull() bundle.Mutator {
+	return &statePull{deploy.StateFiler}
+}
@@ -0,0 +1,62 @@
+package direct
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+)
+
+type statePush struct {
+	filerFactory deploy.FilerFactory
+}
+
+func (l *statePush) Name() string {
+	return "direct:state-push"
+}
+
+func (l *statePush) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	f, err := l.filerFactory(b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	local, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// The state file is absent if there is nothing to deploy.
+		log.Debugf(ctx, "Local state file does not exist.")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer local.Close()
+
+	// Upload state file from local cache directory to filer.
+	cmdio.LogString(ctx, "Updating deployment state...")
+	log.Infof(ctx, "Writing local state file to remote state directory")
+	err = f.Write(ctx, StateFileName, local, filer.CreateParentDirectories, filer.OverwriteIfExists)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// StatePush uploads the local state file of the direct deployment engine
+// to the workspace.
+func StatePush() bundle.Mutator {
+	return &statePush{deploy.StateFiler}
+}
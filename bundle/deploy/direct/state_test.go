@@ -0,0 +1,77 @@
+package direct
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stateTestBundle(t *testing.T) *bundle.Bundle {
+	return &bundle.Bundle{
+		RootPath: t.TempDir(),
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Target: "default",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"my_job": {JobSettings: &jobs.JobSettings{Name: "job"}},
+				},
+			},
+		},
+	}
+}
+
+func TestStateSaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	b := stateTestBundle(t)
+
+	state, err := LoadState(ctx, b)
+	require.NoError(t, err)
+	assert.Empty(t, state.Keys())
+	assert.NotEmpty(t, state.Lineage)
+
+	state.Set("jobs", "my_job", &ResourceState{ID: "1234"})
+	require.NoError(t, state.Save(ctx, b))
+
+	loaded, err := LoadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), loaded.Serial)
+	assert.Equal(t, state.Lineage, loaded.Lineage)
+	assert.Equal(t, []string{"jobs.my_job"}, loaded.Keys())
+
+	loaded.Remove("jobs", "my_job")
+	assert.Empty(t, loaded.Resources)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	b := stateTestBundle(t)
+
+	state := NewState()
+	state.Set("jobs", "my_job", &ResourceState{ID: "1234"})
+	state.Set("pipelines", "deleted_pipeline", &ResourceState{ID: "5678"})
+	require.NoError(t, state.Save(ctx, b))
+
+	diags := bundle.Apply(ctx, b, Load())
+	require.NoError(t, diags.Error())
+
+	assert.Equal(t, "1234", b.Config.Resources.Jobs["my_job"].ID)
+	assert.Equal(t, "job", b.Config.Resources.Jobs["my_job"].Name)
+	assert.Equal(t, "5678", b.Config.Resources.Pipelines["deleted_pipeline"].ID)
+	assert.Equal(t, resources.ModifiedStatusDeleted, b.Config.Resources.Pipelines["deleted_pipeline"].ModifiedStatus)
+}
+
+func TestLoadErrorOnEmptyState(t *testing.T) {
+	ctx := context.Background()
+	b := stateTestBundle(t)
+
+	diags := bundle.Apply(ctx, b, Load(ErrorOnEmptyState))
+	assert.EqualError(t, diags.Error(), "no deployment state. Did you forget to run 'databricks bundle deploy'?")
+}
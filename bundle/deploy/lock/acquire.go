@@ -3,6 +3,9 @@ package lock
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/diag"
@@ -11,10 +14,15 @@ import (
 	"github.com/databricks/cli/libs/log"
 )
 
-type acquire struct{}
+// Interval between attempts to acquire a lock that is held by another deployment.
+const retryInterval = 10 * time.Second
 
-func Acquire() bundle.Mutator {
-	return &acquire{}
+type acquire struct {
+	goal Goal
+}
+
+func Acquire(goal Goal) bundle.Mutator {
+	return &acquire{goal}
 }
 
 func (m *acquire) Name() string {
@@ -22,6 +30,11 @@ func (m *acquire) Name() string {
 }
 
 func (m *acquire) init(b *bundle.Bundle) error {
+	lease, err := b.Config.Bundle.Deployment.Lock.GetLeaseDuration()
+	if err != nil {
+		return err
+	}
+
 	user := b.Config.Workspace.CurrentUser.UserName
 	dir := b.Config.Workspace.StatePath
 	l, err := locker.CreateLocker(user, dir, b.WorkspaceClient())
@@ -29,10 +42,40 @@ func (m *acquire) init(b *bundle.Bundle) error {
 		return err
 	}
 
+	// The host name is informational only.
+	host, _ := os.Hostname()
+	l.State.Host = host
+	l.State.Command = fmt.Sprintf("bundle %s", m.goal)
+	l.LeaseDuration = lease
+
 	b.Locker = l
 	return nil
 }
 
+// lock acquires the lock, retrying until the timeout expires if it is held
+// by another deployment.
+func (m *acquire) lock(ctx context.Context, b *bundle.Bundle, force bool) error {
+	timeout, err := b.Config.Bundle.Deployment.Lock.GetTimeout()
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := b.Locker.Lock(ctx, force)
+		if !errors.As(err, &locker.LockHeldError{}) || time.Now().Add(retryInterval).After(deadline) {
+			return err
+		}
+
+		log.Infof(ctx, "Waiting for deployment lock: %v", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
 func (m *acquire) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 	// Return early if locking is disabled.
 	if !b.Config.Bundle.Deployment.Lock.IsEnabled() {
@@ -47,7 +90,7 @@ func (m *acquire) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics
 
 	force := b.Config.Bundle.Deployment.Lock.Force
 	log.Infof(ctx, "Acquiring deployment lock (force: %v)", force)
-	err = b.Locker.Lock(ctx, force)
+	err = m.lock(ctx, b, force)
 	if err != nil {
 		log.Errorf(ctx, "Failed to acquire deployment lock: %v", err)
 
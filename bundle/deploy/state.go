@@ -25,6 +25,10 @@ type File struct {
 	// This property must be persisted because notebooks are stripped of their extension.
 	// If the local file is no longer present, we need to know what to remove on the workspace side.
 	IsNotebook bool `json:"is_notebook"`
+
+	// Hash of the file contents when it was deployed.
+	// Files deployed by older CLI versions have no recorded hash.
+	Hash string `json:"hash,omitempty"`
 }
 
 type Filelist []File
@@ -106,9 +110,14 @@ func FromSlice(files []fileset.File) (Filelist, error) {
 		if err != nil {
 			return nil, err
 		}
+		hash, err := file.Hash()
+		if err != nil {
+			return nil, err
+		}
 		f = append(f, File{
 			LocalPath:  file.Relative,
 			IsNotebook: isNotebook,
+			Hash:       hash,
 		})
 	}
 	return f, nil
@@ -132,6 +141,18 @@ func (f Filelist) ToSlice(root vfs.Path) []fileset.File {
 	return files
 }
 
+// Hashes returns a map of local paths to the hash of their contents
+// for files that have a recorded hash.
+func (f Filelist) Hashes() map[string]string {
+	hashes := make(map[string]string)
+	for _, file := range f {
+		if file.Hash != "" {
+			hashes[filepath.ToSlash(file.LocalPath)] = file.Hash
+		}
+	}
+	return hashes
+}
+
 func isLocalStateStale(local io.Reader, remote io.Reader) bool {
 	localState, err := loadState(local)
 	if err != nil {
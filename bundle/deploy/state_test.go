@@ -57,6 +57,15 @@ func TestToSlice(t *testing.T) {
 	}
 }
 
+func TestFilelistHashes(t *testing.T) {
+	f := Filelist{
+		{LocalPath: "test1.py", Hash: "abc"},
+		{LocalPath: "test2.py"},
+	}
+
+	require.Equal(t, map[string]string{"test1.py": "abc"}, f.Hashes())
+}
+
 func TestIsLocalStateStale(t *testing.T) {
 	oldState, err := json.Marshal(DeploymentState{
 		Seq: 1,
@@ -63,10 +63,12 @@ func TestStateUpdate(t *testing.T) {
 	require.Equal(t, state.Files, Filelist{
 		{
 			LocalPath: "test1.py",
+			Hash:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
 		},
 		{
 			LocalPath:  "test2.py",
 			IsNotebook: true,
+			Hash:       "7bd2f011028025775f4e90da812d6a37821cca236e8a0dc215f9799a4017c3c6",
 		},
 	})
 	require.Equal(t, build.GetInfo().Version, state.CliVersion)
@@ -82,10 +84,12 @@ func TestStateUpdate(t *testing.T) {
 	require.Equal(t, state.Files, Filelist{
 		{
 			LocalPath: "test1.py",
+			Hash:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
 		},
 		{
 			LocalPath:  "test2.py",
 			IsNotebook: true,
+			Hash:       "7bd2f011028025775f4e90da812d6a37821cca236e8a0dc215f9799a4017c3c6",
 		},
 	})
 	require.Equal(t, build.GetInfo().Version, state.CliVersion)
@@ -133,10 +137,12 @@ func TestStateUpdateWithExistingState(t *testing.T) {
 	require.Equal(t, state.Files, Filelist{
 		{
 			LocalPath: "test1.py",
+			Hash:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
 		},
 		{
 			LocalPath:  "test2.py",
 			IsNotebook: true,
+			Hash:       "7bd2f011028025775f4e90da812d6a37821cca236e8a0dc215f9799a4017c3c6",
 		},
 	})
 	require.Equal(t, build.GetInfo().Version, state.CliVersion)
@@ -45,10 +45,25 @@ func (m *importResource) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagn
 	if err != nil {
 		return diag.Errorf("terraform init: %v", err)
 	}
+	defer os.RemoveAll(tmpDir)
 	tmpState := filepath.Join(tmpDir, TerraformStateFileName)
 
 	importAddress := fmt.Sprintf("%s.%s", m.opts.ResourceType, m.opts.ResourceKey)
-	err = tf.Import(ctx, importAddress, m.opts.ResourceId, tfexec.StateOut(tmpState))
+	importOpts := []tfexec.ImportOption{tfexec.StateOut(tmpState)}
+	//lint:ignore SA1019 We use legacy -state flag for now to plan the import changes based on temporary state file
+	planOpts := []tfexec.PlanOption{tfexec.State(tmpState), tfexec.Target(importAddress)}
+
+	// Values of secret variables are only written to the temporary directory.
+	varFile, err := writeSecretVarFile(ctx, b, tmpDir)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if varFile != "" {
+		importOpts = append(importOpts, tfexec.VarFile(varFile))
+		planOpts = append(planOpts, tfexec.VarFile(varFile))
+	}
+
+	err = tf.Import(ctx, importAddress, m.opts.ResourceId, importOpts...)
 	if err != nil {
 		return diag.Errorf("terraform import: %v", err)
 	}
@@ -56,14 +71,11 @@ func (m *importResource) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagn
 	buf := bytes.NewBuffer(nil)
 	tf.SetStdout(buf)
 
-	//lint:ignore SA1019 We use legacy -state flag for now to plan the import changes based on temporary state file
-	changed, err := tf.Plan(ctx, tfexec.State(tmpState), tfexec.Target(importAddress))
+	changed, err := tf.Plan(ctx, planOpts...)
 	if err != nil {
 		return diag.Errorf("terraform plan: %v", err)
 	}
 
-	defer os.RemoveAll(tmpDir)
-
 	if changed && !m.opts.AutoApprove {
 		output := buf.String()
 		// Remove output starting from Warning until end of output
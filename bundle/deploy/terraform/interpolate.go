@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/cli/libs/dyn/dynvar"
@@ -27,6 +28,16 @@ func (m *interpolateMutator) Apply(ctx context.Context, b *bundle.Bundle) diag.D
 
 		// Resolve variable references in all values.
 		return dynvar.Resolve(root, func(path dyn.Path) (dyn.Value, error) {
+			// Rewrite references to secret variables into references to the
+			// Terraform input variables they are declared as. References of
+			// the form ${var.<name>} already have the right form.
+			if name, ok := config.SecretVariableName(root, path); ok {
+				if path[0] == dyn.Key("var") {
+					return dyn.InvalidValue, dynvar.ErrSkipResolution
+				}
+				return dyn.V(fmt.Sprintf("${var.%s}", name)), nil
+			}
+
 			// Expect paths of the form:
 			//   - resources.<resource_type>.<resource_name>.<field>...
 			if !path.HasPrefix(prefix) || len(path) < 4 {
@@ -58,6 +69,10 @@ func (m *interpolateMutator) Apply(ctx context.Context, b *bundle.Bundle) diag.D
 				path = dyn.NewPath(dyn.Key("databricks_quality_monitor")).Append(path[2:]...)
 			case dyn.Key("schemas"):
 				path = dyn.NewPath(dyn.Key("databricks_schema")).Append(path[2:]...)
+			case dyn.Key("clusters"):
+				path = dyn.NewPath(dyn.Key("databricks_cluster")).Append(path[2:]...)
+			case dyn.Key("volumes"):
+				path = dyn.NewPath(dyn.Key("databricks_volume")).Append(path[2:]...)
 			default:
 				// Trigger "key not found" for unknown resource types.
 				return dyn.GetByPath(root, path)
@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/config/variable"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +32,8 @@ func TestInterpolate(t *testing.T) {
 								"other_model_serving":    "${resources.model_serving_endpoints.other_model_serving.id}",
 								"other_registered_model": "${resources.registered_models.other_registered_model.id}",
 								"other_schema":           "${resources.schemas.other_schema.id}",
+								"other_cluster":          "${resources.clusters.other_cluster.id}",
+								"other_volume":           "${resources.volumes.other_volume.volume_path}",
 							},
 							Tasks: []jobs.Task{
 								{
@@ -67,6 +70,8 @@ func TestInterpolate(t *testing.T) {
 	assert.Equal(t, "${databricks_model_serving.other_model_serving.id}", j.Tags["other_model_serving"])
 	assert.Equal(t, "${databricks_registered_model.other_registered_model.id}", j.Tags["other_registered_model"])
 	assert.Equal(t, "${databricks_schema.other_schema.id}", j.Tags["other_schema"])
+	assert.Equal(t, "${databricks_cluster.other_cluster.id}", j.Tags["other_cluster"])
+	assert.Equal(t, "${databricks_volume.other_volume.volume_path}", j.Tags["other_volume"])
 
 	m := b.Config.Resources.Models["my_model"]
 	assert.Equal(t, "my_model", m.Model.Name)
@@ -92,3 +97,34 @@ func TestInterpolateUnknownResourceType(t *testing.T) {
 	diags := bundle.Apply(context.Background(), b, Interpolate())
 	assert.ErrorContains(t, diags.Error(), `reference does not exist: ${resources.unknown.other_unknown.id}`)
 }
+
+func TestInterpolateSecretVariables(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Variables: map[string]*variable.Variable{
+				"token": {
+					Secret: &variable.Secret{Scope: "scope", Key: "key"},
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"my_job": {
+						JobSettings: &jobs.JobSettings{
+							Tags: map[string]string{
+								"token":  "${var.token}",
+								"token2": "${variables.token.value}",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, Interpolate())
+	require.NoError(t, diags.Error())
+
+	j := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, "${var.token}", j.Tags["token"])
+	assert.Equal(t, "${var.token}", j.Tags["token2"])
+}
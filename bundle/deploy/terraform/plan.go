@@ -2,7 +2,10 @@ package terraform
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"path/filepath"
 
 	"github.com/databricks/cli/bundle"
@@ -46,7 +49,22 @@ func (p *plan) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 	planPath := filepath.Join(tfDir, "plan")
 	destroy := p.goal == PlanDestroy
 
-	notEmpty, err := tf.Plan(ctx, tfexec.Destroy(destroy), tfexec.Out(planPath))
+	opts := []tfexec.PlanOption{tfexec.Destroy(destroy), tfexec.Out(planPath)}
+
+	// Values of secret variables are only written to a file for the duration of the plan.
+	// The plan itself includes them in plaintext, so it is removed by [RemovePlan].
+	// Note that Terraform also records them in terraform.tfstate, like any other
+	// attribute of a resource, and that the state is stored in the workspace.
+	varFile, err := writeSecretVarFile(ctx, b, tfDir)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if varFile != "" {
+		defer os.Remove(varFile)
+		opts = append(opts, tfexec.VarFile(varFile))
+	}
+
+	notEmpty, err := tf.Plan(ctx, opts...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -68,3 +86,31 @@ func Plan(goal PlanGoal) bundle.Mutator {
 		goal: goal,
 	}
 }
+
+type removePlan struct{}
+
+func (r *removePlan) Name() string {
+	return "terraform.RemovePlan"
+}
+
+func (r *removePlan) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	if b.Plan == nil || b.Plan.Path == "" {
+		return nil
+	}
+
+	err := os.Remove(b.Plan.Path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return diag.Warningf("failed to remove terraform plan: %v", err)
+	}
+
+	log.Debugf(ctx, "Removed plan at %s", b.Plan.Path)
+	b.Plan = nil
+	return nil
+}
+
+// RemovePlan returns a [bundle.Mutator] that removes the plan computed by [Plan].
+// The plan includes the values of secret variables in plaintext, so it must
+// not outlive the command that computed it.
+func RemovePlan() bundle.Mutator {
+	return &removePlan{}
+}
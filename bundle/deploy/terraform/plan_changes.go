@@ -0,0 +1,363 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	terraformlib "github.com/databricks/cli/libs/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// Maps Terraform resource types to the resource group they are
+// configured under in the bundle configuration.
+var terraformResourceGroups = map[string]string{
+	"databricks_job":               "jobs",
+	"databricks_pipeline":          "pipelines",
+	"databricks_mlflow_model":      "models",
+	"databricks_mlflow_experiment": "experiments",
+	"databricks_model_serving":     "model_serving_endpoints",
+	"databricks_registered_model":  "registered_models",
+	"databricks_quality_monitor":   "quality_monitors",
+	"databricks_schema":            "schemas",
+	"databricks_cluster":           "clusters",
+	"databricks_volume":            "volumes",
+}
+
+// Permissions and grants are separate Terraform resources whose name is the
+// name of the resource they apply to, prefixed with the resource kind.
+// See the converters in the tfdyn package for how these names are constructed.
+var terraformSubresourcePrefixes = map[string]map[string]string{
+	"databricks_permissions": {
+		"job_":               "jobs",
+		"pipeline_":          "pipelines",
+		"mlflow_model_":      "models",
+		"mlflow_experiment_": "experiments",
+		"model_serving_":     "model_serving_endpoints",
+		"cluster_":           "clusters",
+	},
+	"databricks_grants": {
+		"registered_model_": "registered_models",
+		"schema_":           "schemas",
+		"volume_":           "volumes",
+	},
+}
+
+// Terraform uses singular names for repeating blocks where the bundle
+// configuration uses the plural name. These are the inverse of the renames
+// performed by the converters in the tfdyn package.
+var terraformFieldRenames = map[string]map[string]string{
+	"jobs": {
+		"task":        "tasks",
+		"job_cluster": "job_clusters",
+		"parameter":   "parameters",
+		"environment": "environments",
+		"library":     "libraries",
+	},
+	"pipelines": {
+		"library":      "libraries",
+		"cluster":      "clusters",
+		"notification": "notifications",
+	},
+}
+
+// FieldChange describes a change to a single field of a resource.
+type FieldChange struct {
+	// Path to the field relative to the resource, e.g. "tasks[0].task_key".
+	Path string `json:"path"`
+
+	// Value of the field before and after the change.
+	// A nil value means the field is not set.
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+
+	// If true, the new value is only known after the change is applied.
+	Unknown bool `json:"unknown,omitempty"`
+}
+
+// ResourceChange describes the planned change to a single bundle resource.
+type ResourceChange struct {
+	// Resource group and key of the resource in the bundle configuration,
+	// e.g. "jobs" and "my_job".
+	Group string `json:"group"`
+	Key   string `json:"key"`
+
+	Action terraformlib.ActionType `json:"action"`
+
+	// Field level changes. Only populated for updates and recreates.
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// ResourceKey returns the key of the resource as used in references,
+// e.g. "resources.jobs.my_job".
+func (c ResourceChange) ResourceKey() string {
+	return fmt.Sprintf("resources.%s.%s", c.Group, c.Key)
+}
+
+// PlanChanges reads the plan computed by the [Plan] mutator and returns
+// the changes it makes to bundle resources.
+func PlanChanges(ctx context.Context, b *bundle.Bundle) ([]ResourceChange, error) {
+	tf := b.Terraform
+	if tf == nil {
+		return nil, fmt.Errorf("terraform not initialized")
+	}
+
+	if b.Plan == nil {
+		return nil, fmt.Errorf("terraform plan not computed")
+	}
+
+	plan, err := tf.ShowPlanFile(ctx, b.Plan.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseResourceChanges(plan.ResourceChanges), nil
+}
+
+// ParseResourceChanges converts Terraform resource changes into changes to
+// bundle resources. Changes to permissions and grants are attributed to
+// the resource they apply to. Resources without changes are omitted.
+func ParseResourceChanges(changes []*tfjson.ResourceChange) []ResourceChange {
+	var out []ResourceChange
+
+	// Index into out by resource, and whether the entry was inferred from
+	// a change to its permissions or grants only.
+	index := make(map[string]int)
+	inferred := make(map[string]bool)
+
+	for _, rc := range changes {
+		if rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		action, ok := toActionType(rc.Change.Actions)
+		if !ok {
+			continue
+		}
+
+		group, key, field, ok := toResourceKey(rc.Type, rc.Name)
+		if !ok {
+			continue
+		}
+
+		id := group + "." + key
+		i, exists := index[id]
+
+		// Changes to permissions and grants are listed as field changes
+		// of the resource they apply to.
+		if field != "" {
+			fields := diffValues(field, rc.Change.Before, rc.Change.After, rc.Change.AfterUnknown, nil)
+			if !exists {
+				index[id] = len(out)
+				inferred[id] = true
+				out = append(out, ResourceChange{Group: group, Key: key, Action: terraformlib.ActionTypeUpdate, Fields: fields})
+			} else if hasFieldChanges(out[i].Action) {
+				out[i].Fields = append(out[i].Fields, fields...)
+			}
+			continue
+		}
+
+		var fields []FieldChange
+		if hasFieldChanges(action) {
+			fields = diffValues("", rc.Change.Before, rc.Change.After, rc.Change.AfterUnknown, nil)
+			for j := range fields {
+				fields[j].Path = renameField(group, fields[j].Path)
+			}
+		}
+
+		if !exists {
+			index[id] = len(out)
+			out = append(out, ResourceChange{Group: group, Key: key, Action: action, Fields: fields})
+			continue
+		}
+
+		// The resource's own action takes precedence over the one
+		// inferred from its permissions or grants.
+		if inferred[id] {
+			if hasFieldChanges(action) {
+				fields = append(fields, out[i].Fields...)
+			}
+			out[i].Action = action
+			out[i].Fields = fields
+			inferred[id] = false
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Group != out[j].Group {
+			return out[i].Group < out[j].Group
+		}
+		return out[i].Key < out[j].Key
+	})
+
+	return out
+}
+
+// Field level changes are only meaningful if the resource continues to exist
+// both before and after the change.
+func hasFieldChanges(action terraformlib.ActionType) bool {
+	return action == terraformlib.ActionTypeUpdate || action == terraformlib.ActionTypeRecreate
+}
+
+func toActionType(actions tfjson.Actions) (terraformlib.ActionType, bool) {
+	switch {
+	case actions.Create():
+		return terraformlib.ActionTypeCreate, true
+	case actions.Update():
+		return terraformlib.ActionTypeUpdate, true
+	case actions.Delete():
+		return terraformlib.ActionTypeDelete, true
+	case actions.Replace():
+		return terraformlib.ActionTypeRecreate, true
+	default:
+		return "", false
+	}
+}
+
+// toResourceKey returns the resource group and key in the bundle configuration
+// for the specified Terraform resource. If the Terraform resource is a
+// permissions or grants resource, it also returns the field it corresponds to.
+func toResourceKey(typ, name string) (string, string, string, bool) {
+	if group, ok := terraformResourceGroups[typ]; ok {
+		return group, name, "", true
+	}
+
+	prefixes, ok := terraformSubresourcePrefixes[typ]
+	if !ok {
+		return "", "", "", false
+	}
+
+	// Match the longest prefix first to disambiguate overlapping prefixes.
+	keys := make([]string, 0, len(prefixes))
+	for prefix := range prefixes {
+		keys = append(keys, prefix)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+
+	for _, prefix := range keys {
+		if strings.HasPrefix(name, prefix) {
+			field := strings.TrimPrefix(typ, "databricks_")
+			return prefixes[prefix], strings.TrimPrefix(name, prefix), field, true
+		}
+	}
+
+	return "", "", "", false
+}
+
+// renameField renames the path components of a Terraform field path to the
+// names used in the bundle configuration.
+func renameField(group, path string) string {
+	renames, ok := terraformFieldRenames[group]
+	if !ok {
+		return path
+	}
+
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		name, suffix, _ := strings.Cut(part, "[")
+		if rename, ok := renames[name]; ok {
+			if suffix != "" {
+				rename += "[" + suffix
+			}
+			parts[i] = rename
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// DiffFields returns a [FieldChange] for every leaf value that differs
+// between before and after. Both values are expected to be the result of
+// unmarshalling JSON into an untyped value.
+func DiffFields(before, after any) []FieldChange {
+	return diffValues("", before, after, nil, nil)
+}
+
+// diffValues appends a [FieldChange] for every leaf value that differs
+// between before and after. The unknown argument mirrors the structure of
+// after and is true for values that are only known after apply.
+func diffValues(path string, before, after, unknown any, out []FieldChange) []FieldChange {
+	if u, ok := unknown.(bool); ok && u {
+		return append(out, FieldChange{Path: path, Old: before, Unknown: true})
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	unknownMap, unknownIsMap := unknown.(map[string]any)
+	if (beforeIsMap || before == nil) && (afterIsMap || after == nil) && (beforeIsMap || afterIsMap || unknownIsMap) {
+		keys := make(map[string]bool)
+		for _, m := range []map[string]any{beforeMap, afterMap, unknownMap} {
+			for k := range m {
+				keys[k] = true
+			}
+		}
+		for _, k := range sortedKeys(keys) {
+			out = diffValues(joinPath(path, k), beforeMap[k], afterMap[k], unknownMap[k], out)
+		}
+		return out
+	}
+
+	beforeSlice, beforeIsSlice := before.([]any)
+	afterSlice, afterIsSlice := after.([]any)
+	if (beforeIsSlice || before == nil) && (afterIsSlice || after == nil) && (beforeIsSlice || afterIsSlice) {
+		unknownSlice, _ := unknown.([]any)
+		n := max(len(beforeSlice), len(afterSlice))
+		for i := 0; i < n; i++ {
+			var b, a, u any
+			if i < len(beforeSlice) {
+				b = beforeSlice[i]
+			}
+			if i < len(afterSlice) {
+				a = afterSlice[i]
+			}
+			if i < len(unknownSlice) {
+				u = unknownSlice[i]
+			}
+			out = diffValues(fmt.Sprintf("%s[%d]", path, i), b, a, u, out)
+		}
+		return out
+	}
+
+	if isEmpty(before) && isEmpty(after) {
+		return out
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		out = append(out, FieldChange{Path: path, Old: before, New: after})
+	}
+
+	return out
+}
+
+func isEmpty(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch vv := v.(type) {
+	case map[string]any:
+		return len(vv) == 0
+	case []any:
+		return len(vv) == 0
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
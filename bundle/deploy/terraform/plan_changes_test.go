@@ -0,0 +1,159 @@
+package terraform
+
+import (
+	"testing"
+
+	terraformlib "github.com/databricks/cli/libs/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResourceChangesActions(t *testing.T) {
+	changes := []*tfjson.ResourceChange{
+		{
+			Type:   "databricks_pipeline",
+			Name:   "my_pipeline",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+		},
+		{
+			Type:   "databricks_job",
+			Name:   "my_job",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+		},
+		{
+			Type:   "databricks_schema",
+			Name:   "my_schema",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+		},
+		{
+			Type:   "databricks_pipeline",
+			Name:   "other_pipeline",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}},
+		},
+		{
+			Type:   "databricks_unknown",
+			Name:   "foo",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+		},
+	}
+
+	res := ParseResourceChanges(changes)
+	assert.Equal(t, []ResourceChange{
+		{Group: "pipelines", Key: "my_pipeline", Action: terraformlib.ActionTypeCreate},
+		{Group: "pipelines", Key: "other_pipeline", Action: terraformlib.ActionTypeRecreate},
+		{Group: "schemas", Key: "my_schema", Action: terraformlib.ActionTypeDelete},
+	}, res)
+	assert.Equal(t, "resources.pipelines.my_pipeline", res[0].ResourceKey())
+}
+
+func TestParseResourceChangesFields(t *testing.T) {
+	changes := []*tfjson.ResourceChange{
+		{
+			Type: "databricks_job",
+			Name: "my_job",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before: map[string]any{
+					"name": "old name",
+					"task": []any{
+						map[string]any{"task_key": "a", "notebook_task": map[string]any{"notebook_path": "/a"}},
+					},
+					"tags": map[string]any{},
+				},
+				After: map[string]any{
+					"name": "new name",
+					"task": []any{
+						map[string]any{"task_key": "a", "notebook_task": map[string]any{"notebook_path": "/b"}},
+						map[string]any{"task_key": "b"},
+					},
+				},
+				AfterUnknown: map[string]any{
+					"url": true,
+				},
+			},
+		},
+	}
+
+	res := ParseResourceChanges(changes)
+	assert.Equal(t, []ResourceChange{
+		{
+			Group:  "jobs",
+			Key:    "my_job",
+			Action: terraformlib.ActionTypeUpdate,
+			Fields: []FieldChange{
+				{Path: "name", Old: "old name", New: "new name"},
+				{Path: "tasks[0].notebook_task.notebook_path", Old: "/a", New: "/b"},
+				{Path: "tasks[1].task_key", New: "b"},
+				{Path: "url", Unknown: true},
+			},
+		},
+	}, res)
+}
+
+func TestParseResourceChangesPermissions(t *testing.T) {
+	changes := []*tfjson.ResourceChange{
+		{
+			Type: "databricks_permissions",
+			Name: "job_my_job",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before: map[string]any{
+					"access_control": []any{map[string]any{"permission_level": "CAN_VIEW"}},
+				},
+				After: map[string]any{
+					"access_control": []any{map[string]any{"permission_level": "CAN_MANAGE"}},
+				},
+			},
+		},
+		{
+			Type: "databricks_permissions",
+			Name: "pipeline_new_pipeline",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionCreate},
+				After: map[string]any{
+					"access_control": []any{map[string]any{"permission_level": "CAN_VIEW"}},
+				},
+			},
+		},
+		{
+			Type:   "databricks_pipeline",
+			Name:   "new_pipeline",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+		},
+		{
+			Type: "databricks_grants",
+			Name: "schema_my_schema",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionDelete},
+				Before: map[string]any{
+					"grant": []any{map[string]any{"principal": "users"}},
+				},
+			},
+		},
+	}
+
+	res := ParseResourceChanges(changes)
+	assert.Equal(t, []ResourceChange{
+		{
+			Group:  "jobs",
+			Key:    "my_job",
+			Action: terraformlib.ActionTypeUpdate,
+			Fields: []FieldChange{
+				{Path: "permissions.access_control[0].permission_level", Old: "CAN_VIEW", New: "CAN_MANAGE"},
+			},
+		},
+		{
+			Group:  "pipelines",
+			Key:    "new_pipeline",
+			Action: terraformlib.ActionTypeCreate,
+		},
+		{
+			Group:  "schemas",
+			Key:    "my_schema",
+			Action: terraformlib.ActionTypeUpdate,
+			Fields: []FieldChange{
+				{Path: "grants.grant[0].principal", Old: "users"},
+			},
+		},
+	}, res)
+}
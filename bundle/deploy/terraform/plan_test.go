@@ -0,0 +1,40 @@
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemovePlan(t *testing.T) {
+	planPath := filepath.Join(t.TempDir(), "plan")
+	require.NoError(t, os.WriteFile(planPath, []byte("secret"), 0600))
+
+	b := &bundle.Bundle{
+		Plan: &terraform.Plan{Path: planPath},
+	}
+
+	diags := bundle.Apply(context.Background(), b, RemovePlan())
+	require.NoError(t, diags.Error())
+	assert.NoFileExists(t, planPath)
+	assert.Nil(t, b.Plan)
+
+	// Removing the plan again is a no-op.
+	diags = bundle.Apply(context.Background(), b, RemovePlan())
+	assert.Empty(t, diags)
+}
+
+func TestRemovePlanNotExist(t *testing.T) {
+	b := &bundle.Bundle{
+		Plan: &terraform.Plan{Path: filepath.Join(t.TempDir(), "plan")},
+	}
+
+	diags := bundle.Apply(context.Background(), b, RemovePlan())
+	assert.Empty(t, diags)
+}
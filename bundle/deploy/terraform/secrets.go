@@ -0,0 +1,46 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+)
+
+const secretVarFileName = "secrets.tfvars.json"
+
+// writeSecretVarFile resolves the secret variables referenced in resources and
+// writes their values to a variable file in the specified directory, such that
+// they can be passed to Terraform without being written to its configuration.
+// It returns the path to the file, or an empty string if there are no secret
+// variables. The caller is responsible for removing the file.
+func writeSecretVarFile(ctx context.Context, b *bundle.Bundle, dir string) (string, error) {
+	names, err := config.ReferencedSecretVariables(b.Config.Value())
+	if err != nil {
+		return "", err
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	values, err := b.Config.ResolveSecretVariables(ctx, b.WorkspaceClient(), names)
+	if err != nil {
+		return "", err
+	}
+
+	buf, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, secretVarFileName)
+	err = os.WriteFile(path, buf, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -1,6 +1,7 @@
 package terraform
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io/fs"
@@ -35,7 +36,7 @@ func (l *statePush) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostic
 	}
 
 	// Expect the state file to live under dir.
-	local, err := os.Open(filepath.Join(dir, TerraformStateFileName))
+	local, err := os.ReadFile(filepath.Join(dir, TerraformStateFileName))
 	if errors.Is(err, fs.ErrNotExist) {
 		// The state file can be absent if terraform apply is skipped because
 		// there are no changes to apply in the plan.
@@ -45,12 +46,17 @@ func (l *statePush) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostic
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer local.Close()
+
+	// The values of secret variables must not be uploaded to the workspace.
+	remote, err := redactSensitiveAttributes(local)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Upload state file from local cache directory to filer.
 	cmdio.LogString(ctx, "Updating deployment state...")
 	log.Infof(ctx, "Writing local state file to remote state directory")
-	err = f.Write(ctx, TerraformStateFileName, local, filer.CreateParentDirectories, filer.OverwriteIfExists)
+	err = f.Write(ctx, TerraformStateFileName, bytes.NewReader(remote), filer.CreateParentDirectories, filer.OverwriteIfExists)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,97 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// redactSensitiveAttributes returns a copy of the Terraform state in which the
+// sensitive attributes of resources are set to null.
+//
+// Terraform records the paths of attributes whose values are derived from
+// sensitive input variables, such as secret variables, in the
+// "sensitive_attributes" field of every resource instance. The values of these
+// attributes are kept in the local state only. After the state is pulled from
+// the workspace, Terraform reads them from the workspace when it refreshes
+// the state, or updates the resource if they cannot be read.
+func redactSensitiveAttributes(buf []byte) ([]byte, error) {
+	var state map[string]any
+	err := json.Unmarshal(buf, &state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Terraform state: %w", err)
+	}
+
+	redacted := false
+	resources, _ := state["resources"].([]any)
+	for _, r := range resources {
+		resource, _ := r.(map[string]any)
+		instances, _ := resource["instances"].([]any)
+		for _, i := range instances {
+			instance, _ := i.(map[string]any)
+			paths, _ := instance["sensitive_attributes"].([]any)
+			for _, p := range paths {
+				steps, _ := p.([]any)
+				if redactAttribute(instance["attributes"], steps) {
+					redacted = true
+				}
+			}
+		}
+	}
+
+	// Leave the state as is if there is nothing to redact.
+	if !redacted {
+		return buf, nil
+	}
+
+	return json.MarshalIndent(state, "", "  ")
+}
+
+// redactAttribute sets the value at the path to null. The steps of the path are
+// encoded as {"type": "get_attr", "value": "name"} for attributes and as
+// {"type": "index", "value": {"type": "string", "value": "key"}} for elements
+// of lists and maps. It returns false if the value doesn't exist.
+func redactAttribute(v any, steps []any) bool {
+	if len(steps) == 0 {
+		return false
+	}
+
+	step, _ := steps[0].(map[string]any)
+	var key any
+	switch step["type"] {
+	case "get_attr":
+		key = step["value"]
+	case "index":
+		index, _ := step["value"].(map[string]any)
+		key = index["value"]
+	default:
+		return false
+	}
+
+	last := len(steps) == 1
+	switch v := v.(type) {
+	case map[string]any:
+		k, ok := key.(string)
+		if !ok {
+			return false
+		}
+		if _, ok := v[k]; !ok {
+			return false
+		}
+		if last {
+			v[k] = nil
+			return true
+		}
+		return redactAttribute(v[k], steps[1:])
+	case []any:
+		f, ok := key.(float64)
+		if !ok || f < 0 || int(f) >= len(v) {
+			return false
+		}
+		if last {
+			v[int(f)] = nil
+			return true
+		}
+		return redactAttribute(v[int(f)], steps[1:])
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package terraform
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSensitiveAttributes(t *testing.T) {
+	state := `{
+		"serial": 4,
+		"resources": [
+			{
+				"type": "databricks_job",
+				"name": "my_job",
+				"instances": [
+					{
+						"attributes": {
+							"id": "1234",
+							"name": "job",
+							"parameter": [
+								{"name": "token", "default": "s3cr3t"},
+								{"name": "other", "default": "value"}
+							],
+							"tags": {"token": "s3cr3t", "team": "data"}
+						},
+						"sensitive_attributes": [
+							[
+								{"type": "get_attr", "value": "parameter"},
+								{"type": "index", "value": {"value": 0, "type": "number"}},
+								{"type": "get_attr", "value": "default"}
+							],
+							[
+								{"type": "get_attr", "value": "tags"},
+								{"type": "index", "value": {"value": "token", "type": "string"}}
+							]
+						]
+					}
+				]
+			}
+		]
+	}`
+
+	buf, err := redactSensitiveAttributes([]byte(state))
+	require.NoError(t, err)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf, &out))
+	attributes := out["resources"].([]any)[0].(map[string]any)["instances"].([]any)[0].(map[string]any)["attributes"]
+	assert.Equal(t, map[string]any{
+		"id":   "1234",
+		"name": "job",
+		"parameter": []any{
+			map[string]any{"name": "token", "default": nil},
+			map[string]any{"name": "other", "default": "value"},
+		},
+		"tags": map[string]any{"token": nil, "team": "data"},
+	}, attributes)
+	assert.Equal(t, float64(4), out["serial"])
+}
+
+func TestRedactSensitiveAttributesWithoutSensitiveAttributes(t *testing.T) {
+	state := []byte(`{"serial": 4, "resources": [{"instances": [{"attributes": {"id": "1234"}}]}]}`)
+	buf, err := redactSensitiveAttributes(state)
+	require.NoError(t, err)
+	assert.Equal(t, state, buf)
+}
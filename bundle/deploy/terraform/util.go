@@ -52,3 +52,32 @@ func ParseResourcesState(ctx context.Context, b *bundle.Bundle) (*resourcesState
 	err = json.Unmarshal(rawState, &state)
 	return &state, err
 }
+
+// ParseResourceIDs returns the IDs of the bundle resources recorded in the
+// specified Terraform state file content, keyed by resource group and key.
+// Permissions and grants are not included.
+func ParseResourceIDs(content []byte) (map[string]map[string]string, error) {
+	var state resourcesState
+	err := json.Unmarshal(content, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]map[string]string)
+	for _, resource := range state.Resources {
+		if resource.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+		group, ok := terraformResourceGroups[resource.Type]
+		if !ok {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			if out[group] == nil {
+				out[group] = make(map[string]string)
+			}
+			out[group][resource.Name] = instance.Attributes.ID
+		}
+	}
+	return out, nil
+}
@@ -104,3 +104,42 @@ func TestParseResourcesStateWithExistingStateFile(t *testing.T) {
 	}
 	assert.Equal(t, expected, state)
 }
+
+func TestParseResourceIDs(t *testing.T) {
+	data := []byte(`{
+		"version": 4,
+		"resources": [
+			{
+				"mode": "managed",
+				"type": "databricks_job",
+				"name": "my_job",
+				"instances": [{"attributes": {"id": "123"}}]
+			},
+			{
+				"mode": "managed",
+				"type": "databricks_permissions",
+				"name": "job_my_job",
+				"instances": [{"attributes": {"id": "/jobs/123"}}]
+			},
+			{
+				"mode": "managed",
+				"type": "databricks_volume",
+				"name": "my_volume",
+				"instances": [{"attributes": {"id": "main.default.my_volume"}}]
+			},
+			{
+				"mode": "data",
+				"type": "databricks_job",
+				"name": "other_job",
+				"instances": [{"attributes": {"id": "456"}}]
+			}
+		]
+	}`)
+
+	ids, err := ParseResourceIDs(data)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"jobs":    {"my_job": "123"},
+		"volumes": {"my_volume": "main.default.my_volume"},
+	}, ids)
+}
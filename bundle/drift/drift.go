@@ -0,0 +1,373 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"golang.org/x/sync/errgroup"
+)
+
+// Fields of a resource that are not compared. Permissions and grants are
+// managed through separate APIs and the others are set by the CLI itself.
+var ignoredFields = []string{"id", "modified_status", "permissions", "grants"}
+
+// Lists whose elements are identified by a key rather than by their position.
+// The API does not necessarily return these in the order they are configured.
+var listKeys = map[string]string{
+	"tasks":        "task_key",
+	"job_clusters": "job_cluster_key",
+	"environments": "environment_key",
+	"parameters":   "name",
+}
+
+// Maximum number of resources fetched concurrently.
+const maxConcurrentFetches = 10
+
+// FieldDrift describes a field whose value in the workspace differs from
+// the value in the bundle configuration.
+type FieldDrift struct {
+	// Path to the field relative to the resource, e.g. "tasks[0].task_key".
+	Path string `json:"path"`
+
+	// Value of the field in the bundle configuration and in the workspace.
+	// A nil value means the field is not set.
+	Expected any `json:"expected"`
+	Actual   any `json:"actual"`
+
+	// Location of the field in the bundle configuration, relative to the
+	// bundle root, e.g. "resources/job.yml:12:9".
+	Location string `json:"location,omitempty"`
+
+	path     dyn.Path
+	location dyn.Location
+}
+
+// ResourceDrift describes the drift of a single bundle resource.
+type ResourceDrift struct {
+	// Resource group and key of the resource in the bundle configuration,
+	// e.g. "jobs" and "my_job".
+	Group string `json:"group"`
+	Key   string `json:"key"`
+
+	// ID of the deployed resource.
+	ID string `json:"id"`
+
+	// Missing is set if the resource was deleted from the workspace.
+	Missing bool `json:"missing,omitempty"`
+
+	// Fields that differ from the bundle configuration.
+	Fields []FieldDrift `json:"fields,omitempty"`
+}
+
+// ResourceKey returns the key of the resource as used in references,
+// e.g. "resources.jobs.my_job".
+func (d ResourceDrift) ResourceKey() string {
+	return fmt.Sprintf("resources.%s.%s", d.Group, d.Key)
+}
+
+type deployedResource struct {
+	group string
+	key   string
+	id    string
+	value dyn.Value
+}
+
+// deployedResources returns the resources in the configuration that have been
+// deployed. It expects the IDs from the deployment state to be loaded into the
+// configuration.
+func deployedResources(root dyn.Value) []deployedResource {
+	var out []deployedResource
+	groups, _ := root.Get("resources").AsMap()
+	for _, group := range groups.Pairs() {
+		items, _ := group.Value.AsMap()
+		for _, item := range items.Pairs() {
+			id, _ := item.Value.Get("id").AsString()
+			status, _ := item.Value.Get("modified_status").AsString()
+			if id == "" || status == resources.ModifiedStatusDeleted {
+				continue
+			}
+			out = append(out, deployedResource{
+				group: group.Key.MustString(),
+				key:   item.Key.MustString(),
+				id:    id,
+				value: item.Value,
+			})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].group != out[j].group {
+			return out[i].group < out[j].group
+		}
+		return out[i].key < out[j].key
+	})
+	return out
+}
+
+// Detect fetches every deployed resource of the bundle from the workspace and
+// returns the resources whose settings differ from the bundle configuration.
+// Only fields that are set in the configuration are compared, with the
+// exception of keyed lists such as job tasks, where elements that were
+// added in the workspace are reported as well.
+func Detect(ctx context.Context, b *bundle.Bundle) ([]ResourceDrift, error) {
+	w := b.WorkspaceClient()
+	deployed := deployedResources(b.Config.Value())
+	results := make([]*ResourceDrift, len(deployed))
+
+	errs, errCtx := errgroup.WithContext(ctx)
+	errs.SetLimit(maxConcurrentFetches)
+	for i, r := range deployed {
+		fetch, ok := fetchers[r.group]
+		if !ok {
+			log.Debugf(ctx, "Skipping drift detection for unsupported resource type %s", r.group)
+			continue
+		}
+
+		errs.Go(func() error {
+			remote, err := fetch(errCtx, w, r.id)
+			if apierr.IsMissing(err) {
+				results[i] = &ResourceDrift{Group: r.group, Key: r.key, ID: r.id, Missing: true}
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to fetch resources.%s.%s: %w", r.group, r.key, err)
+			}
+
+			actual, err := toUntyped(remote)
+			if err != nil {
+				return err
+			}
+
+			fields := compareResource(r.value, actual)
+			if len(fields) == 0 {
+				return nil
+			}
+
+			for j := range fields {
+				fields[j].Location = formatLocation(b.RootPath, fields[j].location)
+			}
+			results[i] = &ResourceDrift{Group: r.group, Key: r.key, ID: r.id, Fields: fields}
+			return nil
+		})
+	}
+
+	if err := errs.Wait(); err != nil {
+		return nil, err
+	}
+
+	var out []ResourceDrift
+	for _, r := range results {
+		if r != nil {
+			out = append(out, *r)
+		}
+	}
+	return out, nil
+}
+
+func formatLocation(root string, l dyn.Location) string {
+	if l.File == "" {
+		return ""
+	}
+	if rel, err := filepath.Rel(root, l.File); err == nil {
+		l.File = rel
+	}
+	return l.String()
+}
+
+// toUntyped returns the value as it would be decoded from JSON.
+func toUntyped(v any) (any, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	err = json.Unmarshal(buf, &out)
+	return out, err
+}
+
+// compareResource returns the fields of the configured resource whose value
+// differs from the actual value in the workspace.
+func compareResource(expected dyn.Value, actual any) []FieldDrift {
+	var out []FieldDrift
+	m, ok := expected.AsMap()
+	if !ok {
+		return nil
+	}
+
+	actualMap, _ := actual.(map[string]any)
+	for _, pair := range m.Pairs() {
+		k := pair.Key.MustString()
+		if slices.Contains(ignoredFields, k) {
+			continue
+		}
+		out = compareValues(dyn.NewPath(dyn.Key(k)), pair.Value, actualMap[k], out)
+	}
+
+	// Report fields in a stable order, independent of the order in the configuration.
+	slices.SortStableFunc(out, func(a, b FieldDrift) int {
+		return comparePaths(a.path, b.path)
+	})
+	return out
+}
+
+// comparePaths orders paths by their components, so that "tasks[2]" comes before "tasks[10]".
+func comparePaths(a, b dyn.Path) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ka, kb := a[i].Key(), b[i].Key()
+		switch {
+		case ka != "" && kb != "":
+			if c := strings.Compare(ka, kb); c != 0 {
+				return c
+			}
+		case ka == "" && kb == "":
+			if c := a[i].Index() - b[i].Index(); c != 0 {
+				return c
+			}
+		case ka != "":
+			return -1
+		default:
+			return 1
+		}
+	}
+	return len(a) - len(b)
+}
+
+func compareValues(path dyn.Path, expected dyn.Value, actual any, out []FieldDrift) []FieldDrift {
+	switch expected.Kind() {
+	case dyn.KindMap:
+		actualMap, _ := actual.(map[string]any)
+		for _, pair := range expected.MustMap().Pairs() {
+			k := pair.Key.MustString()
+			out = compareValues(path.Append(dyn.Key(k)), pair.Value, actualMap[k], out)
+		}
+		return out
+
+	case dyn.KindSequence:
+		return compareSequences(path, expected, actual, out)
+	}
+
+	// References that are only resolved during deployment cannot be compared.
+	if s, ok := expected.AsString(); ok && strings.Contains(s, "${") {
+		return out
+	}
+
+	e, err := toUntyped(expected.AsAny())
+	if err != nil {
+		return out
+	}
+
+	// The API omits fields that are set to their zero value.
+	if actual == nil && isZero(e) {
+		return out
+	}
+
+	if !reflect.DeepEqual(e, actual) {
+		out = append(out, FieldDrift{
+			Path:     path.String(),
+			Expected: e,
+			Actual:   actual,
+			path:     path,
+			location: expected.Location(),
+		})
+	}
+	return out
+}
+
+func compareSequences(path dyn.Path, expected dyn.Value, actual any, out []FieldDrift) []FieldDrift {
+	elements := expected.MustSequence()
+	actualList, _ := actual.([]any)
+
+	// Match elements of keyed lists by their key.
+	if key, ok := listKeys[path[len(path)-1].Key()]; ok && hasKeys(elements, key) {
+		byKey := make(map[string]any)
+		for _, a := range actualList {
+			if m, ok := a.(map[string]any); ok {
+				if k, ok := m[key].(string); ok {
+					byKey[k] = a
+				}
+			}
+		}
+
+		seen := make(map[string]bool)
+		for i, e := range elements {
+			k, _ := e.Get(key).AsString()
+			seen[k] = true
+			out = compareValues(path.Append(dyn.Index(i)), e, byKey[k], out)
+		}
+
+		// Elements that were added in the workspace. They are ordered after the configured elements.
+		for i, a := range actualList {
+			m, _ := a.(map[string]any)
+			k, _ := m[key].(string)
+			if seen[k] {
+				continue
+			}
+			out = append(out, FieldDrift{
+				Path:     fmt.Sprintf("%s[%s=%s]", path, key, k),
+				Actual:   a,
+				path:     path.Append(dyn.Index(len(elements) + i)),
+				location: expected.Location(),
+			})
+		}
+		return out
+	}
+
+	for i, e := range elements {
+		var a any
+		if i < len(actualList) {
+			a = actualList[i]
+		}
+		out = compareValues(path.Append(dyn.Index(i)), e, a, out)
+	}
+
+	for i := len(elements); i < len(actualList); i++ {
+		out = append(out, FieldDrift{
+			Path:     path.Append(dyn.Index(i)).String(),
+			Actual:   actualList[i],
+			path:     path.Append(dyn.Index(i)),
+			location: expected.Location(),
+		})
+	}
+	return out
+}
+
+// hasKeys returns true if every element is a map with a string value for the key.
+// Lists with the same name may hold plain values elsewhere, e.g. the "parameters"
+// of a Python task, which are compared by their position instead.
+func hasKeys(elements []dyn.Value, key string) bool {
+	for _, e := range elements {
+		if _, ok := e.Get(key).AsString(); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func isZero(v any) bool {
+	switch vv := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !vv
+	case float64:
+		return vv == 0
+	case string:
+		return vv == ""
+	case map[string]any:
+		return len(vv) == 0
+	case []any:
+		return len(vv) == 0
+	}
+	return false
+}
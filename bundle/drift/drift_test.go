@@ -0,0 +1,176 @@
+package drift
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+)
+
+func loc(line int) []dyn.Location {
+	return []dyn.Location{{File: "/bundle/resources/job.yml", Line: line, Column: 7}}
+}
+
+// exported returns the fields with their exported fields only, for comparison in tests.
+func exported(fields []FieldDrift) []FieldDrift {
+	for i := range fields {
+		fields[i].Location = formatLocation("/bundle", fields[i].location)
+		fields[i].location = dyn.Location{}
+		fields[i].path = nil
+	}
+	return fields
+}
+
+func TestCompareResourceNoDrift(t *testing.T) {
+	expected := dyn.V(map[string]dyn.Value{
+		"id":          dyn.V("1234"),
+		"name":        dyn.V("job"),
+		"max_retries": dyn.V(0),
+		"permissions": dyn.V([]dyn.Value{dyn.V(map[string]dyn.Value{"level": dyn.V("CAN_VIEW")})}),
+		"tasks": dyn.V([]dyn.Value{
+			dyn.V(map[string]dyn.Value{
+				"task_key": dyn.V("a"),
+				"pipeline_task": dyn.V(map[string]dyn.Value{
+					"pipeline_id": dyn.V("${resources.pipelines.my_pipeline.id}"),
+				}),
+			}),
+			dyn.V(map[string]dyn.Value{"task_key": dyn.V("b")}),
+		}),
+	})
+
+	// Tasks are returned in a different order and computed fields are ignored.
+	actual := map[string]any{
+		"name":   "job",
+		"format": "MULTI_TASK",
+		"tasks": []any{
+			map[string]any{"task_key": "b"},
+			map[string]any{"task_key": "a", "pipeline_task": map[string]any{"pipeline_id": "5678"}},
+		},
+	}
+
+	assert.Empty(t, compareResource(expected, actual))
+}
+
+func TestCompareResourceDrift(t *testing.T) {
+	expected := dyn.V(map[string]dyn.Value{
+		"name": dyn.NewValue("job", loc(2)),
+		"tags": dyn.V(map[string]dyn.Value{
+			"team": dyn.NewValue("data", loc(4)),
+		}),
+		"tasks": dyn.NewValue([]dyn.Value{
+			dyn.V(map[string]dyn.Value{
+				"task_key":    dyn.V("a"),
+				"max_retries": dyn.NewValue(3, loc(7)),
+			}),
+		}, loc(5)),
+	})
+
+	actual := map[string]any{
+		"name": "renamed",
+		"tasks": []any{
+			map[string]any{"task_key": "a", "max_retries": float64(1)},
+			map[string]any{"task_key": "added"},
+		},
+	}
+
+	fields := exported(compareResource(expected, actual))
+	assert.Equal(t, []FieldDrift{
+		{Path: "name", Expected: "job", Actual: "renamed", Location: "resources/job.yml:2:7"},
+		{Path: "tags.team", Expected: "data", Actual: nil, Location: "resources/job.yml:4:7"},
+		{Path: "tasks[0].max_retries", Expected: float64(3), Actual: float64(1), Location: "resources/job.yml:7:7"},
+		{Path: "tasks[task_key=added]", Actual: map[string]any{"task_key": "added"}, Location: "resources/job.yml:5:7"},
+	}, fields)
+}
+
+func TestCompareResourceUnkeyedList(t *testing.T) {
+	expected := dyn.V(map[string]dyn.Value{
+		"libraries": dyn.V([]dyn.Value{
+			dyn.V(map[string]dyn.Value{"notebook": dyn.V(map[string]dyn.Value{"path": dyn.V("/a")})}),
+		}),
+	})
+
+	actual := map[string]any{
+		"libraries": []any{
+			map[string]any{"notebook": map[string]any{"path": "/a"}},
+			map[string]any{"notebook": map[string]any{"path": "/b"}},
+		},
+	}
+
+	assert.Equal(t, []FieldDrift{
+		{Path: "libraries[1]", Actual: map[string]any{"notebook": map[string]any{"path": "/b"}}},
+	}, exported(compareResource(expected, actual)))
+}
+
+func TestCompareResourceParameters(t *testing.T) {
+	expected := dyn.V(map[string]dyn.Value{
+		"parameters": dyn.V([]dyn.Value{
+			dyn.V(map[string]dyn.Value{"name": dyn.V("a"), "default": dyn.V("1")}),
+			dyn.V(map[string]dyn.Value{"name": dyn.V("b"), "default": dyn.V("2")}),
+		}),
+		"tasks": dyn.V([]dyn.Value{
+			dyn.V(map[string]dyn.Value{
+				"task_key": dyn.V("a"),
+				"spark_python_task": dyn.V(map[string]dyn.Value{
+					"python_file": dyn.V("main.py"),
+					"parameters":  dyn.V([]dyn.Value{dyn.V("--foo"), dyn.V("bar")}),
+				}),
+			}),
+		}),
+	})
+
+	// Job parameters are matched by name, task parameters by their position.
+	actual := map[string]any{
+		"parameters": []any{
+			map[string]any{"name": "b", "default": "2"},
+			map[string]any{"name": "a", "default": "1"},
+		},
+		"tasks": []any{
+			map[string]any{
+				"task_key": "a",
+				"spark_python_task": map[string]any{
+					"python_file": "main.py",
+					"parameters":  []any{"--foo", "bar"},
+				},
+			},
+		},
+	}
+
+	assert.Empty(t, compareResource(expected, actual))
+}
+
+func TestCompareResourceOrdersByIndex(t *testing.T) {
+	var tasks []dyn.Value
+	var actualTasks []any
+	for i := 0; i < 11; i++ {
+		key := fmt.Sprintf("task_%d", i)
+		tasks = append(tasks, dyn.V(map[string]dyn.Value{"task_key": dyn.V(key), "max_retries": dyn.V(1)}))
+		actualTasks = append(actualTasks, map[string]any{"task_key": key, "max_retries": float64(2)})
+	}
+
+	fields := compareResource(dyn.V(map[string]dyn.Value{"tasks": dyn.V(tasks)}), map[string]any{"tasks": actualTasks})
+	assert.Len(t, fields, 11)
+	assert.Equal(t, "tasks[2].max_retries", fields[2].Path)
+	assert.Equal(t, "tasks[10].max_retries", fields[10].Path)
+}
+
+func TestDeployedResources(t *testing.T) {
+	root := dyn.V(map[string]dyn.Value{
+		"resources": dyn.V(map[string]dyn.Value{
+			"jobs": dyn.V(map[string]dyn.Value{
+				"deployed": dyn.V(map[string]dyn.Value{"id": dyn.V("1")}),
+				"new":      dyn.V(map[string]dyn.Value{"name": dyn.V("new")}),
+				"deleted": dyn.V(map[string]dyn.Value{
+					"id":              dyn.V("2"),
+					"modified_status": dyn.V("deleted"),
+				}),
+			}),
+		}),
+	})
+
+	deployed := deployedResources(root)
+	assert.Len(t, deployed, 1)
+	assert.Equal(t, "jobs", deployed[0].group)
+	assert.Equal(t, "deployed", deployed[0].key)
+	assert.Equal(t, "1", deployed[0].id)
+}
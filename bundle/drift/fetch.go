@@ -0,0 +1,68 @@
+package drift
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+)
+
+// fetchFunc returns the current settings of the resource with the specified ID.
+// The returned value is marshalled to JSON and compared field by field with
+// the bundle configuration of the resource.
+type fetchFunc func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error)
+
+// Maps resource groups to the function that fetches a resource in that group.
+var fetchers = map[string]fetchFunc{
+	"jobs": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		jobId, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := w.Jobs.GetByJobId(ctx, jobId)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Settings, nil
+	},
+	"pipelines": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		resp, err := w.Pipelines.GetByPipelineId(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Spec, nil
+	},
+	"clusters": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.Clusters.GetByClusterId(ctx, id)
+	},
+	"experiments": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		resp, err := w.Experiments.GetExperiment(ctx, ml.GetExperimentRequest{ExperimentId: id})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Experiment, nil
+	},
+	"models": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		resp, err := w.ModelRegistry.GetModel(ctx, ml.GetModelRequest{Name: id})
+		if err != nil {
+			return nil, err
+		}
+		return resp.RegisteredModelDatabricks, nil
+	},
+	"model_serving_endpoints": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.ServingEndpoints.GetByName(ctx, id)
+	},
+	"registered_models": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.RegisteredModels.GetByFullName(ctx, id)
+	},
+	"quality_monitors": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.QualityMonitors.GetByTableName(ctx, id)
+	},
+	"schemas": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.Schemas.GetByFullName(ctx, id)
+	},
+	"volumes": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.Volumes.ReadByName(ctx, id)
+	},
+}
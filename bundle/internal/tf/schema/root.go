@@ -14,9 +14,16 @@ func NewProviders() *Providers {
 type Root struct {
 	Terraform map[string]any `json:"terraform"`
 
-	Provider *Providers   `json:"provider,omitempty"`
-	Data     *DataSources `json:"data,omitempty"`
-	Resource *Resources   `json:"resource,omitempty"`
+	Provider *Providers          `json:"provider,omitempty"`
+	Variable map[string]Variable `json:"variable,omitempty"`
+	Data     *DataSources        `json:"data,omitempty"`
+	Resource *Resources          `json:"resource,omitempty"`
+}
+
+// Variable declares an input variable of the Terraform configuration.
+type Variable struct {
+	Type      string `json:"type,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
 }
 
 const ProviderHost = "registry.terraform.io"
@@ -7,17 +7,21 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
 	"github.com/databricks/cli/libs/filer"
 	"github.com/databricks/cli/libs/log"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
 
+	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -36,8 +40,19 @@ func UploadWithClient(client filer.Filer) bundle.Mutator {
 	}
 }
 
+// ResolveRemotePaths rewrites references to local libraries to the location
+// they are uploaded to by [Upload], without uploading them.
+func ResolveRemotePaths() bundle.Mutator {
+	return &upload{
+		skipUpload: true,
+	}
+}
+
 type upload struct {
 	client filer.Filer
+
+	// If set, only rewrite the configuration without uploading libraries.
+	skipUpload bool
 }
 
 type configLocation struct {
@@ -137,7 +152,7 @@ func (u *upload) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 
 	// If the client is not initialized, initialize it
 	// We use client field in mutator to allow for mocking client in testing
-	if u.client == nil {
+	if u.client == nil && !u.skipUpload {
 		filer, err := GetFilerForLibraries(b.WorkspaceClient(), uploadPath)
 		if err != nil {
 			return diag.FromErr(err)
@@ -153,28 +168,29 @@ func (u *upload) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 		return diag.FromErr(err)
 	}
 
-	errs, errCtx := errgroup.WithContext(ctx)
-	errs.SetLimit(maxFilesRequestsInFlight)
-
-	for source := range libs {
-		errs.Go(func() error {
-			return UploadFile(errCtx, source, u.client)
-		})
-	}
+	if !u.skipUpload {
+		volumePath, err := MissingBundleVolume(ctx, b, uploadPath)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	if err := errs.Wait(); err != nil {
-		return diag.FromErr(err)
+		sources := maps.Keys(libs)
+		slices.Sort(sources)
+		if volumePath != "" {
+			log.Infof(ctx, "Volume %s does not exist yet, libraries are uploaded after it is deployed", volumePath)
+			b.DeferredLibraries = sources
+		} else {
+			err = uploadFiles(ctx, sources, u.client)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	// Update all the config paths to point to the uploaded location
 	for source, locations := range libs {
 		err = b.Config.Mutate(func(v dyn.Value) (dyn.Value, error) {
-			remotePath := path.Join(uploadPath, filepath.Base(source))
-
-			// If the remote path does not start with /Workspace or /Volumes, prepend /Workspace
-			if !strings.HasPrefix(remotePath, "/Workspace") && !strings.HasPrefix(remotePath, "/Volumes") {
-				remotePath = "/Workspace" + remotePath
-			}
+			remotePath := RemotePath(uploadPath, source)
 			for _, location := range locations {
 				v, err = dyn.SetByPath(v, location.configPath, dyn.NewValue(remotePath, []dyn.Location{location.location}))
 				if err != nil {
@@ -193,7 +209,23 @@ func (u *upload) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 	return diags
 }
 
+func uploadFiles(ctx context.Context, sources []string, client filer.Filer) error {
+	errs, errCtx := errgroup.WithContext(ctx)
+	errs.SetLimit(maxFilesRequestsInFlight)
+
+	for _, source := range sources {
+		errs.Go(func() error {
+			return UploadFile(errCtx, source, client)
+		})
+	}
+
+	return errs.Wait()
+}
+
 func (u *upload) Name() string {
+	if u.skipUpload {
+		return "libraries.ResolveRemotePaths"
+	}
 	return "libraries.Upload"
 }
 
@@ -204,6 +236,69 @@ func GetFilerForLibraries(w *databricks.WorkspaceClient, uploadPath string) (fil
 	return filer.NewWorkspaceFilesClient(w, uploadPath)
 }
 
+// MissingBundleVolume returns the path of the volume that contains the upload path
+// if that volume is defined in the bundle and doesn't exist yet. It returns an empty
+// string otherwise. Volumes defined in the bundle are created when resources are
+// deployed, which happens after libraries are uploaded.
+func MissingBundleVolume(ctx context.Context, b *bundle.Bundle, uploadPath string) (string, error) {
+	if !isVolumesPath(uploadPath) {
+		return "", nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(uploadPath, "/Volumes/"), "/", 4)
+	if len(parts) < 3 {
+		return "", nil
+	}
+
+	catalogName, schemaName, volumeName := parts[0], parts[1], parts[2]
+	volumePath := fmt.Sprintf("/Volumes/%s/%s/%s", catalogName, schemaName, volumeName)
+
+	root := b.Config.Value()
+	for key, v := range b.Config.Resources.Volumes {
+		if v == nil || v.CreateVolumeRequestContent == nil {
+			continue
+		}
+		if resolveVolumeField(root, key, "catalog_name", v.CatalogName) != catalogName ||
+			resolveVolumeField(root, key, "schema_name", v.SchemaName) != schemaName ||
+			resolveVolumeField(root, key, "name", v.Name) != volumeName {
+			continue
+		}
+
+		err := b.WorkspaceClient().Files.GetDirectoryMetadataByDirectoryPath(ctx, volumePath)
+		if errors.Is(err, apierr.ErrNotFound) {
+			return volumePath, nil
+		}
+		return "", err
+	}
+
+	return "", nil
+}
+
+// resolveVolumeField returns the value of a field of a volume defined in the bundle.
+// The field may refer to other resources, e.g. "${resources.schemas.my_schema.name}".
+// These references are resolved from the configuration, because the names of
+// catalogs, schemas and volumes are known before they are deployed.
+func resolveVolumeField(root dyn.Value, key, field, value string) string {
+	if !dynvar.ContainsVariableReference(value) {
+		return value
+	}
+
+	v, err := dyn.GetByPath(root, dyn.NewPath(dyn.Key("resources"), dyn.Key("volumes"), dyn.Key(key), dyn.Key(field)))
+	if err != nil {
+		return ""
+	}
+
+	v, err = dynvar.Resolve(v, func(p dyn.Path) (dyn.Value, error) {
+		return dyn.GetByPath(root, p)
+	})
+	if err != nil {
+		return ""
+	}
+
+	s, _ := v.AsString()
+	return s
+}
+
 func isVolumesPath(path string) bool {
 	return strings.HasPrefix(path, "/Volumes/")
 }
@@ -228,6 +323,19 @@ func UploadFile(ctx context.Context, file string, client filer.Filer) error {
 	return nil
 }
 
+// RemotePath returns the path that the local file is uploaded to by [Upload]
+// given the upload base path returned by [GetUploadBasePath].
+func RemotePath(uploadPath, source string) string {
+	remotePath := path.Join(uploadPath, filepath.Base(source))
+
+	// If the remote path does not start with /Workspace or /Volumes, prepend /Workspace
+	if !strings.HasPrefix(remotePath, "/Workspace") && !strings.HasPrefix(remotePath, "/Volumes") {
+		remotePath = "/Workspace" + remotePath
+	}
+
+	return remotePath
+}
+
 func GetUploadBasePath(b *bundle.Bundle) (string, error) {
 	artifactPath := b.Config.Workspace.ArtifactPath
 	if artifactPath == "" {
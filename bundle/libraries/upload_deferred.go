@@ -0,0 +1,45 @@
+package libraries
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+)
+
+type uploadDeferred struct{}
+
+// UploadDeferred uploads the libraries that [Upload] skipped because they are
+// uploaded to a volume that is defined in the bundle and didn't exist yet.
+// It must run after resources are deployed.
+func UploadDeferred() bundle.Mutator {
+	return &uploadDeferred{}
+}
+
+func (m *uploadDeferred) Name() string {
+	return "libraries.UploadDeferred"
+}
+
+func (m *uploadDeferred) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	if len(b.DeferredLibraries) == 0 {
+		return nil
+	}
+
+	uploadPath, err := GetUploadBasePath(b)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := GetFilerForLibraries(b.WorkspaceClient(), uploadPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = uploadFiles(ctx, b.DeferredLibraries, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b.DeferredLibraries = nil
+	return nil
+}
@@ -10,9 +10,15 @@ import (
 	"github.com/databricks/cli/bundle/config/resources"
 	mockfiler "github.com/databricks/cli/internal/mocks/libs/filer"
 	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	sdkconfig "github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -329,3 +335,180 @@ func TestUploadMultipleLibraries(t *testing.T) {
 	require.Contains(t, b.Config.Resources.Jobs["job"].JobSettings.Environments[0].Spec.Dependencies, "/Workspace/foo/bar/artifacts/.internal/source4.whl")
 	require.Contains(t, b.Config.Resources.Jobs["job"].JobSettings.Environments[0].Spec.Dependencies, "/Workspace/Users/foo@bar.com/mywheel.whl")
 }
+
+func TestResolveRemotePathsDoesNotUpload(t *testing.T) {
+	tmpDir := t.TempDir()
+	whlFolder := filepath.Join(tmpDir, "whl")
+	testutil.Touch(t, whlFolder, "source.whl")
+
+	b := &bundle.Bundle{
+		SyncRootPath: tmpDir,
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "/foo/bar/artifacts",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{
+											Whl: filepath.Join("whl", "source.whl"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diags := bundle.Apply(context.Background(), b, ResolveRemotePaths())
+	require.NoError(t, diags.Error())
+
+	require.Equal(t, "/Workspace/foo/bar/artifacts/.internal/source.whl", b.Config.Resources.Jobs["job"].JobSettings.Tasks[0].Libraries[0].Whl)
+}
+
+func TestMissingBundleVolume(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Volumes: map[string]*resources.Volume{
+					"my_volume": {
+						CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+							CatalogName: "main",
+							SchemaName:  "my_schema",
+							Name:        "my_volume",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockFilesAPI().EXPECT().GetDirectoryMetadataByDirectoryPath(mock.Anything, "/Volumes/main/my_schema/my_volume").Return(&apierr.APIError{
+		StatusCode: 404,
+	}).Once()
+	m.GetMockFilesAPI().EXPECT().GetDirectoryMetadataByDirectoryPath(mock.Anything, "/Volumes/main/my_schema/my_volume").Return(nil).Once()
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	volumePath, err := MissingBundleVolume(context.Background(), b, "/Volumes/main/my_schema/my_volume/artifacts/.internal")
+	require.NoError(t, err)
+	assert.Equal(t, "/Volumes/main/my_schema/my_volume", volumePath)
+
+	volumePath, err = MissingBundleVolume(context.Background(), b, "/Volumes/main/my_schema/my_volume/artifacts/.internal")
+	require.NoError(t, err)
+	assert.Equal(t, "", volumePath)
+
+	// Volumes that are not defined in the bundle are not checked for existence.
+	volumePath, err = MissingBundleVolume(context.Background(), b, "/Volumes/main/my_schema/other_volume/artifacts/.internal")
+	require.NoError(t, err)
+	assert.Equal(t, "", volumePath)
+}
+
+func TestMissingBundleVolumeWithReferences(t *testing.T) {
+	b := &bundle.Bundle{}
+	err := b.Config.Mutate(func(v dyn.Value) (dyn.Value, error) {
+		return dyn.V(map[string]dyn.Value{
+			"resources": dyn.V(map[string]dyn.Value{
+				"schemas": dyn.V(map[string]dyn.Value{
+					"my_schema": dyn.V(map[string]dyn.Value{
+						"catalog_name": dyn.V("main"),
+						"name":         dyn.V("dev_my_schema"),
+					}),
+				}),
+				"volumes": dyn.V(map[string]dyn.Value{
+					"my_volume": dyn.V(map[string]dyn.Value{
+						"catalog_name": dyn.V("${resources.schemas.my_schema.catalog_name}"),
+						"schema_name":  dyn.V("${resources.schemas.my_schema.name}"),
+						"name":         dyn.V("my_volume"),
+					}),
+				}),
+			}),
+		}), nil
+	})
+	require.NoError(t, err)
+
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockFilesAPI().EXPECT().GetDirectoryMetadataByDirectoryPath(mock.Anything, "/Volumes/main/dev_my_schema/my_volume").Return(&apierr.APIError{
+		StatusCode: 404,
+	})
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	volumePath, err := MissingBundleVolume(context.Background(), b, "/Volumes/main/dev_my_schema/my_volume/artifacts/.internal")
+	require.NoError(t, err)
+	assert.Equal(t, "/Volumes/main/dev_my_schema/my_volume", volumePath)
+}
+
+func TestUploadDefersUploadToMissingBundleVolume(t *testing.T) {
+	tmpDir := t.TempDir()
+	whlFolder := filepath.Join(tmpDir, "whl")
+	testutil.Touch(t, whlFolder, "source.whl")
+
+	b := &bundle.Bundle{
+		SyncRootPath: tmpDir,
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: "/Volumes/main/my_schema/my_volume",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{
+											Whl: filepath.Join("whl", "source.whl"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Volumes: map[string]*resources.Volume{
+					"my_volume": {
+						CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+							CatalogName: "main",
+							SchemaName:  "my_schema",
+							Name:        "my_volume",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockFilesAPI().EXPECT().GetDirectoryMetadataByDirectoryPath(mock.Anything, "/Volumes/main/my_schema/my_volume").Return(&apierr.APIError{
+		StatusCode: 404,
+	})
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	// Nothing is written before the volume is deployed.
+	mockFiler := mockfiler.NewMockFiler(t)
+	diags := bundle.Apply(context.Background(), b, UploadWithClient(mockFiler))
+	require.NoError(t, diags.Error())
+	assert.Equal(t, "/Volumes/main/my_schema/my_volume/.internal/source.whl", b.Config.Resources.Jobs["job"].JobSettings.Tasks[0].Libraries[0].Whl)
+	assert.Equal(t, []string{filepath.Join(whlFolder, "source.whl")}, b.DeferredLibraries)
+}
+
+func TestGetFilerForLibrariesInOtherVolume(t *testing.T) {
+	b := &bundle.Bundle{}
+
+	// No calls are expected on the mock; volumes not defined in the
+	// bundle are not checked for existence.
+	m := mocks.NewMockWorkspaceClient(t)
+	m.WorkspaceClient.Config = &sdkconfig.Config{Host: "https://mock.databricks.workspace.com"}
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	f, err := GetFilerForLibraries(b.WorkspaceClient(), "/Volumes/main/my_schema/my_volume/artifacts/.internal")
+	require.NoError(t, err)
+	assert.NotNil(t, f)
+}
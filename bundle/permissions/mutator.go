@@ -39,6 +39,10 @@ var levelsMap = map[string](map[string]string){
 		CAN_VIEW:   "CAN_VIEW",
 		CAN_RUN:    "CAN_QUERY",
 	},
+	"clusters": {
+		CAN_MANAGE: "CAN_MANAGE",
+		CAN_RUN:    "CAN_ATTACH_TO",
+	},
 }
 
 type bundlePermissions struct{}
@@ -58,6 +62,7 @@ func (m *bundlePermissions) Apply(ctx context.Context, b *bundle.Bundle) diag.Di
 	applyForMlModels(ctx, b)
 	applyForMlExperiments(ctx, b)
 	applyForModelServiceEndpoints(ctx, b)
+	applyForClusters(ctx, b)
 
 	return nil
 }
@@ -132,6 +137,18 @@ func applyForModelServiceEndpoints(ctx context.Context, b *bundle.Bundle) {
 	}
 }
 
+func applyForClusters(ctx context.Context, b *bundle.Bundle) {
+	for key, cluster := range b.Config.Resources.Clusters {
+		cluster.Permissions = append(cluster.Permissions, convert(
+			ctx,
+			b.Config.Permissions,
+			cluster.Permissions,
+			key,
+			levelsMap["clusters"],
+		)...)
+	}
+}
+
 func (m *bundlePermissions) Name() string {
 	return "ApplyBundlePermissions"
 }
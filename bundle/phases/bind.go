@@ -2,6 +2,7 @@ package phases
 
 import (
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 )
@@ -10,14 +11,22 @@ func Bind(opts *terraform.BindOptions) bundle.Mutator {
 	return newPhase(
 		"bind",
 		[]bundle.Mutator{
-			lock.Acquire(),
+			lock.Acquire(lock.GoalBind),
 			bundle.Defer(
-				bundle.Seq(
-					terraform.StatePull(),
-					terraform.Interpolate(),
-					terraform.Write(),
-					terraform.Import(opts),
-					terraform.StatePush(),
+				bundle.If(
+					direct.IsEnabled,
+					bundle.Seq(
+						direct.StatePull(),
+						direct.Bind(opts.ResourceKey, opts.ResourceId),
+						direct.StatePush(),
+					),
+					bundle.Seq(
+						terraform.StatePull(),
+						terraform.Interpolate(),
+						terraform.Write(),
+						terraform.Import(opts),
+						terraform.StatePush(),
+					),
 				),
 				lock.Release(lock.GoalBind),
 			),
@@ -29,14 +38,22 @@ func Unbind(resourceType string, resourceKey string) bundle.Mutator {
 	return newPhase(
 		"unbind",
 		[]bundle.Mutator{
-			lock.Acquire(),
+			lock.Acquire(lock.GoalUnbind),
 			bundle.Defer(
-				bundle.Seq(
-					terraform.StatePull(),
-					terraform.Interpolate(),
-					terraform.Write(),
-					terraform.Unbind(resourceType, resourceKey),
-					terraform.StatePush(),
+				bundle.If(
+					direct.IsEnabled,
+					bundle.Seq(
+						direct.StatePull(),
+						direct.Unbind(resourceKey),
+						direct.StatePush(),
+					),
+					bundle.Seq(
+						terraform.StatePull(),
+						terraform.Interpolate(),
+						terraform.Write(),
+						terraform.Unbind(resourceType, resourceKey),
+						terraform.StatePush(),
+					),
 				),
 				lock.Release(lock.GoalUnbind),
 			),
@@ -9,6 +9,7 @@ import (
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/deploy"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/files"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/metadata"
@@ -50,42 +51,78 @@ func parseTerraformActions(changes []*tfjson.ResourceChange, toInclude func(typ
 	return res
 }
 
-func approvalForDeploy(ctx context.Context, b *bundle.Bundle) (bool, error) {
+// destructiveActions returns the resources that are deleted or recreated
+// by the computed plan of the configured deployment engine.
+func destructiveActions(ctx context.Context, b *bundle.Bundle, goal terraform.PlanGoal) ([]terraformlib.Action, error) {
+	if b.Config.Bundle.Deployment.IsDirect() {
+		changes, err := direct.PlanChanges(ctx, b, goal)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make([]terraformlib.Action, 0)
+		for _, c := range changes {
+			if c.Action != terraformlib.ActionTypeDelete && c.Action != terraformlib.ActionTypeRecreate {
+				continue
+			}
+
+			r, ok := config.NewConfigResource(c.Group)
+			if !ok {
+				return nil, fmt.Errorf("unsupported resource type %q", c.Group)
+			}
+
+			res = append(res, terraformlib.Action{
+				Action:       c.Action,
+				ResourceType: r.TerraformResourceName(),
+				ResourceName: c.Key,
+			})
+		}
+		return res, nil
+	}
+
 	tf := b.Terraform
 	if tf == nil {
-		return false, fmt.Errorf("terraform not initialized")
+		return nil, fmt.Errorf("terraform not initialized")
 	}
 
 	// read plan file
 	plan, err := tf.ShowPlanFile(ctx, b.Plan.Path)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	schemaActions := parseTerraformActions(plan.ResourceChanges, func(typ string, actions tfjson.Actions) bool {
-		// Filter in only UC schema resources.
-		if typ != "databricks_schema" {
-			return false
-		}
-
-		// We only display prompts for destructive actions like deleting or
-		// recreating a schema.
+	return parseTerraformActions(plan.ResourceChanges, func(typ string, actions tfjson.Actions) bool {
 		return actions.Delete() || actions.Replace()
-	})
+	}), nil
+}
 
-	dltActions := parseTerraformActions(plan.ResourceChanges, func(typ string, actions tfjson.Actions) bool {
-		// Filter in only DLT pipeline resources.
-		if typ != "databricks_pipeline" {
-			return false
+func filterActions(actions []terraformlib.Action, typ string) []terraformlib.Action {
+	res := make([]terraformlib.Action, 0)
+	for _, a := range actions {
+		if a.ResourceType == typ {
+			res = append(res, a)
 		}
+	}
+	return res
+}
+
+func approvalForDeploy(ctx context.Context, b *bundle.Bundle) (bool, error) {
+	actions, err := destructiveActions(ctx, b, terraform.PlanDeploy)
+	if err != nil {
+		return false, err
+	}
+
+	// We only display prompts for destructive actions like deleting or
+	// recreating a UC schema or volume.
+	schemaActions := filterActions(actions, "databricks_schema")
+	volumeActions := filterActions(actions, "databricks_volume")
 
-		// Recreating DLT pipeline leads to metadata loss and for a transient period
-		// the underling tables will be unavailable.
-		return actions.Replace() || actions.Delete()
-	})
+	// Recreating DLT pipeline leads to metadata loss and for a transient period
+	// the underling tables will be unavailable.
+	dltActions := filterActions(actions, "databricks_pipeline")
 
 	// We don't need to display any prompts in this case.
-	if len(dltActions) == 0 && len(schemaActions) == 0 {
+	if len(dltActions) == 0 && len(schemaActions) == 0 && len(volumeActions) == 0 {
 		return true, nil
 	}
 
@@ -97,6 +134,14 @@ func approvalForDeploy(ctx context.Context, b *bundle.Bundle) (bool, error) {
 		}
 	}
 
+	// One or more UC volume resources will be deleted or recreated.
+	if len(volumeActions) != 0 {
+		cmdio.LogString(ctx, "The following UC volumes will be deleted or recreated. Any underlying data may be lost:")
+		for _, action := range volumeActions {
+			cmdio.Log(ctx, action)
+		}
+	}
+
 	// One or more DLT pipelines is being recreated.
 	if len(dltActions) != 0 {
 		msg := `
@@ -134,11 +179,25 @@ func Deploy() bundle.Mutator {
 	deployCore := bundle.Defer(
 		bundle.Seq(
 			bundle.LogString("Deploying resources..."),
-			terraform.Apply(),
+			bundle.If(
+				direct.IsEnabled,
+				direct.Apply(terraform.PlanDeploy),
+				terraform.Apply(),
+			),
+			libraries.UploadDeferred(),
 		),
 		bundle.Seq(
-			terraform.StatePush(),
-			terraform.Load(),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(
+					direct.StatePush(),
+					direct.Load(),
+				),
+				bundle.Seq(
+					terraform.StatePush(),
+					terraform.Load(),
+				),
+			),
 			metadata.Compute(),
 			metadata.Upload(),
 			bundle.LogString("Deployment complete!"),
@@ -147,10 +206,14 @@ func Deploy() bundle.Mutator {
 
 	deployMutator := bundle.Seq(
 		scripts.Execute(config.ScriptPreDeploy),
-		lock.Acquire(),
+		lock.Acquire(lock.GoalDeploy),
 		bundle.Defer(
 			bundle.Seq(
-				terraform.StatePull(),
+				bundle.If(
+					direct.IsEnabled,
+					direct.StatePull(),
+					terraform.StatePull(),
+				),
 				deploy.StatePull(),
 				mutator.ValidateGitDetails(),
 				artifacts.CleanUp(),
@@ -161,17 +224,26 @@ func Deploy() bundle.Mutator {
 				deploy.StateUpdate(),
 				deploy.StatePush(),
 				permissions.ApplyWorkspaceRootPermissions(),
-				terraform.Interpolate(),
-				terraform.Write(),
-				terraform.CheckRunningResource(),
-				terraform.Plan(terraform.PlanGoal("deploy")),
+				bundle.If(
+					direct.IsEnabled,
+					direct.CheckRunningResource(),
+					bundle.Seq(
+						terraform.Interpolate(),
+						terraform.Write(),
+						terraform.CheckRunningResource(),
+						terraform.Plan(terraform.PlanGoal("deploy")),
+					),
+				),
 				bundle.If(
 					approvalForDeploy,
 					deployCore,
 					bundle.LogString("Deployment cancelled!"),
 				),
 			),
-			lock.Release(lock.GoalDeploy),
+			bundle.Seq(
+				terraform.RemovePlan(),
+				lock.Release(lock.GoalDeploy),
+			),
 		),
 		scripts.Execute(config.ScriptPostDeploy),
 	)
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/files"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/terraform"
@@ -14,7 +15,6 @@ import (
 	"github.com/databricks/cli/libs/cmdio"
 
 	"github.com/databricks/cli/libs/log"
-	terraformlib "github.com/databricks/cli/libs/terraform"
 	"github.com/databricks/databricks-sdk-go/apierr"
 )
 
@@ -32,28 +32,11 @@ func assertRootPathExists(ctx context.Context, b *bundle.Bundle) (bool, error) {
 }
 
 func approvalForDestroy(ctx context.Context, b *bundle.Bundle) (bool, error) {
-	tf := b.Terraform
-	if tf == nil {
-		return false, fmt.Errorf("terraform not initialized")
-	}
-
-	// read plan file
-	plan, err := tf.ShowPlanFile(ctx, b.Plan.Path)
+	deleteActions, err := destructiveActions(ctx, b, terraform.PlanDestroy)
 	if err != nil {
 		return false, err
 	}
 
-	deleteActions := make([]terraformlib.Action, 0)
-	for _, rc := range plan.ResourceChanges {
-		if rc.Change.Actions.Delete() {
-			deleteActions = append(deleteActions, terraformlib.Action{
-				Action:       terraformlib.ActionTypeDelete,
-				ResourceType: rc.Type,
-				ResourceName: rc.Name,
-			})
-		}
-	}
-
 	if len(deleteActions) > 0 {
 		cmdio.LogString(ctx, "The following resources will be deleted:")
 		for _, a := range deleteActions {
@@ -82,26 +65,39 @@ func approvalForDestroy(ctx context.Context, b *bundle.Bundle) (bool, error) {
 func Destroy() bundle.Mutator {
 	// Core destructive mutators for destroy. These require informed user consent.
 	destroyCore := bundle.Seq(
-		terraform.Apply(),
+		bundle.If(
+			direct.IsEnabled,
+			direct.Apply(terraform.PlanDestroy),
+			terraform.Apply(),
+		),
 		files.Delete(),
 		bundle.LogString("Destroy complete!"),
 	)
 
 	destroyMutator := bundle.Seq(
-		lock.Acquire(),
+		lock.Acquire(lock.GoalDestroy),
 		bundle.Defer(
 			bundle.Seq(
-				terraform.StatePull(),
-				terraform.Interpolate(),
-				terraform.Write(),
-				terraform.Plan(terraform.PlanGoal("destroy")),
+				bundle.If(
+					direct.IsEnabled,
+					direct.StatePull(),
+					bundle.Seq(
+						terraform.StatePull(),
+						terraform.Interpolate(),
+						terraform.Write(),
+						terraform.Plan(terraform.PlanGoal("destroy")),
+					),
+				),
 				bundle.If(
 					approvalForDestroy,
 					destroyCore,
 					bundle.LogString("Destroy cancelled!"),
 				),
 			),
-			lock.Release(lock.GoalDestroy),
+			bundle.Seq(
+				terraform.RemovePlan(),
+				lock.Release(lock.GoalDestroy),
+			),
 		),
 	)
 
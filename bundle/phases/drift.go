@@ -0,0 +1,35 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/libraries"
+)
+
+// The drift phase loads the IDs of the deployed resources into the
+// configuration such that they can be compared with the resources in the
+// workspace. Like the plan phase, it does not upload files or artifacts,
+// acquire the deployment lock, or modify the deployment state.
+func Drift() bundle.Mutator {
+	return newPhase(
+		"drift",
+		[]bundle.Mutator{
+			libraries.ExpandGlobReferences(),
+			libraries.ResolveRemotePaths(),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(
+					direct.StatePull(),
+					direct.Load(direct.ErrorOnEmptyState),
+				),
+				bundle.Seq(
+					terraform.StatePull(),
+					terraform.Interpolate(),
+					terraform.Write(),
+					terraform.Load(terraform.ErrorOnEmptyState),
+				),
+			),
+		},
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/databricks/cli/bundle/config/mutator"
 	pythonmutator "github.com/databricks/cli/bundle/config/mutator/python"
 	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/metadata"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/bundle/permissions"
@@ -55,10 +56,12 @@ func Initialize() bundle.Mutator {
 				"workspace",
 				"variables",
 			),
+			validate.Variables(),
 			mutator.SetRunAs(),
 			mutator.OverrideCompute(),
 			mutator.ProcessTargetMode(),
 			mutator.ApplyPresets(),
+			mutator.ResolveVolumeArtifactPath(),
 			mutator.DefaultQueueing(),
 			mutator.ExpandPipelineGlobPaths(),
 
@@ -71,7 +74,12 @@ func Initialize() bundle.Mutator {
 			permissions.FilterCurrentUser(),
 			metadata.AnnotateJobs(),
 			metadata.AnnotatePipelines(),
-			terraform.Initialize(),
+			validate.DeploymentEngine(),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(),
+				terraform.Initialize(),
+			),
 			scripts.Execute(config.ScriptPostInit),
 		},
 	)
@@ -0,0 +1,22 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/lock"
+)
+
+// The launch phase uploads the files of the bundle so that a single file
+// can be run from its synchronized location in the workspace.
+func Launch() bundle.Mutator {
+	return newPhase(
+		"launch",
+		[]bundle.Mutator{
+			lock.Acquire(lock.GoalLaunch),
+			bundle.Defer(
+				files.Upload(),
+				lock.Release(lock.GoalLaunch),
+			),
+		},
+	)
+}
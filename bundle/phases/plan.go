@@ -0,0 +1,35 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/libraries"
+)
+
+// The plan phase computes the changes that the deploy phase would make to
+// the resources in the workspace. It does not upload files or artifacts,
+// acquire the deployment lock, or modify the deployment state.
+func Plan() bundle.Mutator {
+	return newPhase(
+		"plan",
+		[]bundle.Mutator{
+			bundle.If(
+				direct.IsEnabled,
+				direct.StatePull(),
+				terraform.StatePull(),
+			),
+			libraries.ExpandGlobReferences(),
+			libraries.ResolveRemotePaths(),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(),
+				bundle.Seq(
+					terraform.Interpolate(),
+					terraform.Write(),
+					terraform.Plan(terraform.PlanDeploy),
+				),
+			),
+		},
+	)
+}
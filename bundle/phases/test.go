@@ -0,0 +1,29 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/lock"
+	"github.com/databricks/cli/bundle/libraries"
+	"github.com/databricks/cli/bundle/testrunner"
+)
+
+// The test phase uploads the artifacts and files of the bundle together with
+// the notebook that runs its tests. The tests are run by [testrunner.Run].
+func Test() bundle.Mutator {
+	return newPhase(
+		"test",
+		[]bundle.Mutator{
+			lock.Acquire(lock.GoalTest),
+			bundle.Defer(
+				bundle.Seq(
+					libraries.ExpandGlobReferences(),
+					libraries.Upload(),
+					testrunner.GenerateNotebook(),
+					files.Upload(),
+				),
+				lock.Release(lock.GoalTest),
+			),
+		},
+	)
+}
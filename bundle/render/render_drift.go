@@ -0,0 +1,50 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/databricks/cli/bundle/drift"
+	"github.com/fatih/color"
+)
+
+const driftTemplate = `{{- range .Drift }}
+{{- if .Missing }}
+{{ "- missing " | red }} {{ .ResourceKey | bold }} (ID: {{ .ID }})
+{{- else }}
+{{ "~ drifted " | yellow }} {{ .ResourceKey | bold }} (ID: {{ .ID }})
+{{- range .Fields }}
+      {{ .Path }}: {{ value .Expected }} => {{ value .Actual }}
+{{- if .Location }}
+        {{ "at " | italic }}{{ .Location | cyan }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Drift }}
+
+{{ end -}}
+{{ .Trailer }}
+`
+
+func buildDriftTrailer(d []drift.ResourceDrift) string {
+	if len(d) == 0 {
+		return color.GreenString("No drift detected. The deployed resources match the bundle configuration.")
+	}
+	return fmt.Sprintf("Drift detected in %d resource(s).", len(d))
+}
+
+// RenderDrift renders the differences between the deployed resources and
+// the bundle configuration in a human-readable format.
+func RenderDrift(out io.Writer, d []drift.ResourceDrift) error {
+	funcs := template.FuncMap{
+		"value": renderPlanValue,
+	}
+
+	t := template.Must(template.New("drift").Funcs(renderFuncMap).Funcs(funcs).Parse(driftTemplate))
+	return t.Execute(out, map[string]any{
+		"Drift":   d,
+		"Trailer": buildDriftTrailer(d),
+	})
+}
@@ -0,0 +1,47 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/bundle/drift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderDriftNoDrift(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := RenderDrift(out, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "No drift detected. The deployed resources match the bundle configuration.\n", out.String())
+}
+
+func TestRenderDrift(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := RenderDrift(out, []drift.ResourceDrift{
+		{
+			Group: "jobs",
+			Key:   "my_job",
+			ID:    "1234",
+			Fields: []drift.FieldDrift{
+				{Path: "name", Expected: "job", Actual: "renamed", Location: "resources/job.yml:2:7"},
+				{Path: "tasks[1]", Actual: map[string]any{"task_key": "added"}},
+			},
+		},
+		{
+			Group:   "pipelines",
+			Key:     "my_pipeline",
+			ID:      "5678",
+			Missing: true,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "\n"+
+		"~ drifted  resources.jobs.my_job (ID: 1234)\n"+
+		"      name: \"job\" => \"renamed\"\n"+
+		"        at resources/job.yml:2:7\n"+
+		"      tasks[1]: (not set) => {\"task_key\":\"added\"}\n"+
+		"- missing  resources.pipelines.my_pipeline (ID: 5678)\n"+
+		"\n"+
+		"Drift detected in 2 resource(s).\n", out.String())
+}
@@ -0,0 +1,93 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	terraformlib "github.com/databricks/cli/libs/terraform"
+	"github.com/fatih/color"
+)
+
+const planTemplate = `{{- range .Changes }}
+{{ action .Action }} {{ .ResourceKey | bold }}
+{{- range .Fields }}
+      {{ .Path }}: {{ old . }} => {{ new . }}
+{{- end }}
+{{- end }}
+{{- if .Changes }}
+
+{{ end -}}
+{{ .Trailer }}
+`
+
+var planActionSymbols = map[terraformlib.ActionType]string{
+	terraformlib.ActionTypeCreate:   color.GreenString("+ create  "),
+	terraformlib.ActionTypeUpdate:   color.YellowString("~ update  "),
+	terraformlib.ActionTypeDelete:   color.RedString("- delete  "),
+	terraformlib.ActionTypeRecreate: color.RedString("± recreate"),
+}
+
+func renderPlanValue(v any) string {
+	if v == nil {
+		return "(not set)"
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(buf)
+}
+
+func buildPlanTrailer(changes []terraform.ResourceChange) string {
+	if len(changes) == 0 {
+		return color.GreenString("No changes. The deployed resources match the bundle configuration.")
+	}
+
+	counts := make(map[terraformlib.ActionType]int)
+	for _, c := range changes {
+		counts[c.Action]++
+	}
+
+	parts := []string{}
+	for _, action := range []terraformlib.ActionType{
+		terraformlib.ActionTypeCreate,
+		terraformlib.ActionTypeUpdate,
+		terraformlib.ActionTypeDelete,
+		terraformlib.ActionTypeRecreate,
+	} {
+		parts = append(parts, fmt.Sprintf("%d to %s", counts[action], action))
+	}
+	return fmt.Sprintf("Plan: %s", strings.Join(parts, ", "))
+}
+
+// RenderPlan renders the planned changes to bundle resources in a
+// human-readable format.
+func RenderPlan(out io.Writer, changes []terraform.ResourceChange) error {
+	funcs := template.FuncMap{
+		"action": func(a terraformlib.ActionType) string {
+			if s, ok := planActionSymbols[a]; ok {
+				return s
+			}
+			return string(a)
+		},
+		"old": func(f terraform.FieldChange) string {
+			return renderPlanValue(f.Old)
+		},
+		"new": func(f terraform.FieldChange) string {
+			if f.Unknown {
+				return "(known after deploy)"
+			}
+			return renderPlanValue(f.New)
+		},
+	}
+
+	t := template.Must(template.New("plan").Funcs(renderFuncMap).Funcs(funcs).Parse(planTemplate))
+	return t.Execute(out, map[string]any{
+		"Changes": changes,
+		"Trailer": buildPlanTrailer(changes),
+	})
+}
@@ -0,0 +1,54 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	terraformlib "github.com/databricks/cli/libs/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPlanNoChanges(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := RenderPlan(out, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "No changes. The deployed resources match the bundle configuration.\n", out.String())
+}
+
+func TestRenderPlan(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := RenderPlan(out, []terraform.ResourceChange{
+		{
+			Group:  "jobs",
+			Key:    "my_job",
+			Action: terraformlib.ActionTypeUpdate,
+			Fields: []terraform.FieldChange{
+				{Path: "name", Old: "old", New: "new"},
+				{Path: "tasks[0].max_retries", Old: 1.0},
+				{Path: "url", Unknown: true},
+			},
+		},
+		{
+			Group:  "pipelines",
+			Key:    "my_pipeline",
+			Action: terraformlib.ActionTypeCreate,
+		},
+		{
+			Group:  "schemas",
+			Key:    "my_schema",
+			Action: terraformlib.ActionTypeRecreate,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "\n"+
+		"~ update   resources.jobs.my_job\n"+
+		"      name: \"old\" => \"new\"\n"+
+		"      tasks[0].max_retries: 1 => (not set)\n"+
+		"      url: (not set) => (known after deploy)\n"+
+		"+ create   resources.pipelines.my_pipeline\n"+
+		"± recreate resources.schemas.my_schema\n"+
+		"\n"+
+		"Plan: 1 to create, 1 to update, 0 to delete, 1 to recreate\n", out.String())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/fatih/color"
+	"golang.org/x/exp/maps"
 )
 
 var renderFuncMap = template.FuncMap{
@@ -73,6 +75,12 @@ Workspace:
   Path: {{ .Path | bold }}
 {{- end }}
 {{- end }}
+{{- if .Variables }}
+Variables:
+{{- range .Variables }}
+  {{ .Name }}: {{ .Source | italic }}
+{{- end }}
+{{- end }}
 
 {{ end -}}
 
@@ -114,14 +122,30 @@ func renderSummaryTemplate(out io.Writer, b *bundle.Bundle, diags diag.Diagnosti
 		}
 	}
 
+	// List where the value of each variable came from, such that it is
+	// clear which of the possible sources took precedence.
+	type variableSource struct {
+		Name   string
+		Source string
+	}
+	var variables []variableSource
+	names := maps.Keys(b.Config.Variables)
+	slices.Sort(names)
+	for _, name := range names {
+		if v := b.Config.Variables[name]; v != nil && v.Source != "" {
+			variables = append(variables, variableSource{Name: name, Source: v.Source})
+		}
+	}
+
 	t := template.Must(template.New("summary").Funcs(renderFuncMap).Parse(summaryTemplate))
 	err := t.Execute(out, map[string]any{
-		"Name":    b.Config.Bundle.Name,
-		"Target":  b.Config.Bundle.Target,
-		"User":    currentUser.UserName,
-		"Path":    b.Config.Workspace.RootPath,
-		"Host":    b.Config.Workspace.Host,
-		"Trailer": buildTrailer(diags),
+		"Name":      b.Config.Bundle.Name,
+		"Target":    b.Config.Bundle.Target,
+		"User":      currentUser.UserName,
+		"Path":      b.Config.Workspace.RootPath,
+		"Host":      b.Config.Workspace.Host,
+		"Variables": variables,
+		"Trailer":   buildTrailer(diags),
 	})
 
 	return err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/variable"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/dyn"
 	assert "github.com/databricks/cli/libs/dyn/dynassert"
@@ -157,6 +158,38 @@ func TestRenderTextOutput(t *testing.T) {
 				"\n" +
 				"Validation OK!\n",
 		},
+		{
+			name: "bundle with variables",
+			bundle: &bundle.Bundle{
+				Config: config.Root{
+					Bundle: config.Bundle{
+						Name:   "test-bundle",
+						Target: "test-target",
+					},
+					Variables: map[string]*variable.Variable{
+						"foo": {
+							Value:  "bar",
+							Source: "--var flag",
+						},
+						"cluster": {
+							Type:   variable.VariableTypeComplex,
+							Value:  map[string]any{"num_workers": 2},
+							Source: "--var-file ci.yml",
+						},
+						"unset": {},
+					},
+				},
+			},
+			diags: nil,
+			opts:  RenderOptions{RenderSummaryTable: true},
+			expected: "Name: test-bundle\n" +
+				"Target: test-target\n" +
+				"Variables:\n" +
+				"  cluster: --var-file ci.yml\n" +
+				"  foo: --var flag\n" +
+				"\n" +
+				"Validation OK!\n",
+		},
 		{
 			name:   "nil bundle without summary with 1 error and 1 warning",
 			bundle: nil,
@@ -13,6 +13,7 @@ import (
 	"github.com/databricks/cli/bundle/run/progress"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -47,8 +48,9 @@ func isSuccess(task jobs.RunTask) bool {
 		task.State.ResultState == jobs.RunResultStateSuccess
 }
 
-func (r *jobRunner) logFailedTasks(ctx context.Context, runId int64) {
-	w := r.bundle.WorkspaceClient()
+// logFailedTasks logs the error of every failed task of the run. If follower is
+// set, the last lines of the driver logs of failed tasks are printed as well.
+func logFailedTasks(ctx context.Context, w *databricks.WorkspaceClient, runId int64, follower *taskLogFollower) {
 	red := color.New(color.FgRed).SprintFunc()
 	green := color.New(color.FgGreen).SprintFunc()
 	yellow := color.New(color.FgYellow).SprintFunc()
@@ -78,6 +80,9 @@ func (r *jobRunner) logFailedTasks(ctx context.Context, runId int64) {
 			}
 			log.Errorf(ctx, "Task %s failed!\nError:\n%s\nTrace:\n%s",
 				red(task.TaskKey), taskInfo.Error, taskInfo.ErrorTrace)
+			if follower != nil {
+				follower.Tail(ctx, task)
+			}
 		} else {
 			log.Infof(ctx, "task %s is in state %s",
 				yellow(task.TaskKey), task.State.LifeCycleState)
@@ -185,9 +190,20 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 	}
 	logProgress := logProgressCallback(ctx, progressLogger)
 
-	waiter, err := w.Jobs.RunNow(ctx, *req)
+	if opts.Job.follow && opts.NoWait {
+		return nil, fmt.Errorf("--follow cannot be used with --no-wait")
+	}
+
+	// prints the last lines of the driver logs of failed tasks, and the
+	// driver logs of running tasks if --follow is specified.
+	follower := newTaskLogFollower(w, progressLogger, opts.Job.tailLines)
+	if opts.Job.follow {
+		warnLogDelivery(ctx, r.job.JobSettings)
+	}
+
+	waiter, err := r.startRun(ctx, w, opts, req)
 	if err != nil {
-		return nil, fmt.Errorf("cannot start job")
+		return nil, err
 	}
 
 	if opts.NoWait {
@@ -202,9 +218,16 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 		pullRunId(r)
 		logDebug(r)
 		logProgress(r)
+		if opts.Job.follow {
+			follower.Follow(ctx, r)
+		}
 	}).GetWithTimeout(jobRunTimeout)
+	if opts.Job.follow && run != nil {
+		// Print the lines delivered after the last poll.
+		follower.Follow(ctx, run)
+	}
 	if err != nil && runId != nil {
-		r.logFailedTasks(ctx, *runId)
+		logFailedTasks(ctx, w, *runId, follower)
 	}
 	if err != nil {
 		return nil, err
@@ -223,6 +246,7 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 	// The task completed with an error.
 	case jobs.RunResultStateFailed:
 		log.Infof(ctx, "Run has failed!")
+		logFailedTasks(ctx, w, *runId, follower)
 		return nil, fmt.Errorf("run failed: %s", run.State.StateMessage)
 
 	// The task completed successfully.
@@ -0,0 +1,306 @@
+package run
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/databricks/cli/bundle/run/progress"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// Driver log files written by cluster log delivery and the names of their streams.
+var driverLogStreams = []struct {
+	stream string
+	file   string
+}{
+	{"stdout", "stdout"},
+	{"stderr", "stderr"},
+	{"log4j", "log4j-active.log"},
+}
+
+// Maximum number of bytes returned by a single DBFS read request.
+const dbfsReadLength = 1024 * 1024
+
+// driverLogFile tracks the part of a driver log file that has been printed.
+type driverLogFile struct {
+	stream string
+	path   string
+	offset int64
+
+	// Skip the contents that exist when the file is first read.
+	// Used for clusters that were running before the task started.
+	skipExisting bool
+
+	// Incomplete last line of the previous read.
+	partial string
+}
+
+// taskLogFollower prints the driver logs of the tasks of a job run while they run.
+//
+// The Jobs API doesn't expose driver logs of running tasks, so they are read from
+// the DBFS location the cluster delivers its logs to. Clusters without a DBFS log
+// destination (see `cluster_log_conf`) cannot be followed. Log delivery happens
+// every few minutes, so the output lags behind the task.
+type taskLogFollower struct {
+	w      *databricks.WorkspaceClient
+	logger *cmdio.Logger
+
+	// Number of lines of the driver logs to print for failed tasks.
+	tailLines int
+
+	// DBFS directory with the driver logs per cluster ID.
+	// The value is empty if the cluster doesn't deliver its logs to DBFS.
+	logDirs map[string]string
+
+	// Driver log files per task run ID.
+	// The value is nil if the logs of the task cannot be followed.
+	files map[int64][]*driverLogFile
+}
+
+func newTaskLogFollower(w *databricks.WorkspaceClient, logger *cmdio.Logger, tailLines int) *taskLogFollower {
+	return &taskLogFollower{
+		w:         w,
+		logger:    logger,
+		tailLines: tailLines,
+		logDirs:   make(map[string]string),
+		files:     make(map[int64][]*driverLogFile),
+	}
+}
+
+// Follow prints the lines of the driver logs of the tasks of the run that have
+// been delivered since the previous call. It is called on every poll of the run.
+func (f *taskLogFollower) Follow(ctx context.Context, run *jobs.Run) {
+	for _, task := range run.Tasks {
+		for _, file := range f.taskFiles(ctx, task) {
+			lines, err := f.readNewLines(ctx, file)
+			if err != nil {
+				log.Debugf(ctx, "Failed to read %s: %s", file.path, err)
+				continue
+			}
+			for _, line := range lines {
+				f.logger.Log(progress.NewTaskLogEvent(task.TaskKey, file.stream, line))
+			}
+		}
+	}
+}
+
+// Tail prints the last lines of the stdout and stderr of the driver of the task.
+func (f *taskLogFollower) Tail(ctx context.Context, task jobs.RunTask) {
+	if f.tailLines <= 0 {
+		return
+	}
+
+	for _, file := range f.taskFiles(ctx, task) {
+		if file.stream == "log4j" {
+			continue
+		}
+
+		lines, err := f.readLastLines(ctx, file.path, f.tailLines)
+		if err != nil {
+			log.Debugf(ctx, "Failed to read %s: %s", file.path, err)
+			continue
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Last %d lines of the driver %s of task %s:", len(lines), file.stream, task.TaskKey))
+		for _, line := range lines {
+			f.logger.Log(progress.NewTaskLogEvent(task.TaskKey, file.stream, line))
+		}
+	}
+}
+
+// taskFiles returns the driver log files of the task, or nil if they cannot be read.
+func (f *taskLogFollower) taskFiles(ctx context.Context, task jobs.RunTask) []*driverLogFile {
+	if files, ok := f.files[task.RunId]; ok {
+		return files
+	}
+
+	// The cluster ID is known once the cluster of the task has been created.
+	// It is never known for tasks that run on serverless compute.
+	if task.ClusterInstance == nil || task.ClusterInstance.ClusterId == "" {
+		return nil
+	}
+
+	clusterId := task.ClusterInstance.ClusterId
+	dir, err := f.clusterLogDir(ctx, clusterId)
+	if err != nil {
+		// Try again on the next poll.
+		log.Debugf(ctx, "Failed to get the log destination of cluster %s: %s", clusterId, err)
+		return nil
+	}
+
+	if dir == "" {
+		cmdio.LogString(ctx, fmt.Sprintf("Warning: driver logs of task %s are not available because cluster %s doesn't deliver its logs to DBFS", task.TaskKey, clusterId))
+		f.files[task.RunId] = nil
+		return nil
+	}
+
+	var files []*driverLogFile
+	for _, s := range driverLogStreams {
+		files = append(files, &driverLogFile{
+			stream:       s.stream,
+			path:         path.Join(dir, s.file),
+			skipExisting: task.ExistingClusterId != "",
+		})
+	}
+	f.files[task.RunId] = files
+	return files
+}
+
+// clusterLogDir returns the DBFS directory the driver logs of the cluster are delivered to.
+func (f *taskLogFollower) clusterLogDir(ctx context.Context, clusterId string) (string, error) {
+	if dir, ok := f.logDirs[clusterId]; ok {
+		return dir, nil
+	}
+
+	cluster, err := f.w.Clusters.GetByClusterId(ctx, clusterId)
+	if err != nil {
+		return "", err
+	}
+
+	dir := ""
+	if deliversLogsToDbfs(cluster.ClusterLogConf) {
+		dir = path.Join(strings.TrimPrefix(cluster.ClusterLogConf.Dbfs.Destination, "dbfs:"), clusterId, "driver")
+	}
+
+	f.logDirs[clusterId] = dir
+	return dir, nil
+}
+
+func deliversLogsToDbfs(conf *compute.ClusterLogConf) bool {
+	return conf != nil && conf.Dbfs != nil && conf.Dbfs.Destination != ""
+}
+
+// warnLogDelivery prints a warning for every task of the job whose driver logs
+// cannot be followed because its compute doesn't deliver logs to DBFS.
+// Tasks that run on existing clusters are checked when the run starts.
+func warnLogDelivery(ctx context.Context, job *jobs.JobSettings) {
+	if job == nil {
+		return
+	}
+
+	clusters := make(map[string]*compute.ClusterSpec)
+	for i := range job.JobClusters {
+		clusters[job.JobClusters[i].JobClusterKey] = &job.JobClusters[i].NewCluster
+	}
+
+	for _, task := range job.Tasks {
+		var spec *compute.ClusterSpec
+		switch {
+		case task.ExistingClusterId != "":
+			continue
+		case task.NewCluster != nil:
+			spec = task.NewCluster
+		case task.JobClusterKey != "":
+			spec = clusters[task.JobClusterKey]
+		case task.SqlTask != nil, task.PipelineTask != nil, task.RunJobTask != nil,
+			task.ConditionTask != nil, task.ForEachTask != nil:
+			// These tasks don't have driver logs of their own.
+			continue
+		default:
+			cmdio.LogString(ctx, fmt.Sprintf("Warning: driver logs of task %s cannot be followed because it runs on serverless compute", task.TaskKey))
+			continue
+		}
+
+		if spec == nil || !deliversLogsToDbfs(spec.ClusterLogConf) {
+			cmdio.LogString(ctx, fmt.Sprintf("Warning: driver logs of task %s cannot be followed because its cluster doesn't deliver its logs to DBFS (cluster_log_conf)", task.TaskKey))
+		}
+	}
+}
+
+// readNewLines returns the complete lines that were appended to the file since the previous call.
+func (f *taskLogFollower) readNewLines(ctx context.Context, file *driverLogFile) ([]string, error) {
+	info, err := f.w.Dbfs.GetStatusByPath(ctx, file.path)
+	if apierr.IsMissing(err) {
+		// The file has not been delivered yet.
+		file.skipExisting = false
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if file.skipExisting {
+		file.offset = info.FileSize
+		file.skipExisting = false
+		return nil, nil
+	}
+
+	// The file was rotated.
+	if info.FileSize < file.offset {
+		file.offset = 0
+		file.partial = ""
+	}
+
+	data, err := f.read(ctx, file.path, file.offset, info.FileSize)
+	if err != nil {
+		return nil, err
+	}
+	file.offset += int64(len(data))
+
+	lines := strings.Split(file.partial+string(data), "\n")
+	file.partial = lines[len(lines)-1]
+	return lines[:len(lines)-1], nil
+}
+
+// readLastLines returns the last n lines of the file.
+func (f *taskLogFollower) readLastLines(ctx context.Context, name string, n int) ([]string, error) {
+	info, err := f.w.Dbfs.GetStatusByPath(ctx, name)
+	if apierr.IsMissing(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := f.read(ctx, name, max(0, info.FileSize-dbfsReadLength), info.FileSize)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return nil, nil
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
+
+// read returns the contents of the file between the offsets.
+func (f *taskLogFollower) read(ctx context.Context, name string, offset, end int64) ([]byte, error) {
+	var out []byte
+	for offset < end {
+		resp, err := f.w.Dbfs.Read(ctx, files.ReadDbfsRequest{
+			Path:   name,
+			Offset: offset,
+			Length: min(end-offset, dbfsReadLength),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.BytesRead == 0 {
+			break
+		}
+
+		data, err := base64.StdEncoding.DecodeString(resp.Data)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data...)
+		offset += resp.BytesRead
+	}
+	return out, nil
+}
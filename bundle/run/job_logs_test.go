@@ -0,0 +1,204 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestTaskLogFollower(t *testing.T, tailLines int) (context.Context, *taskLogFollower, *mocks.MockWorkspaceClient, *bytes.Buffer) {
+	var buf bytes.Buffer
+	logger := cmdio.NewLogger(flags.ModeAppend)
+	logger.Writer = &buf
+	ctx := cmdio.NewContext(context.Background(), logger)
+
+	m := mocks.NewMockWorkspaceClient(t)
+	return ctx, newTaskLogFollower(m.WorkspaceClient, logger, tailLines), m, &buf
+}
+
+func expectDbfsFile(m *mocks.MockWorkspaceClient, path string, offset int64, content string) {
+	api := m.GetMockDbfsAPI()
+	api.EXPECT().GetStatusByPath(mock.Anything, path).Return(&files.FileInfo{
+		Path:     path,
+		FileSize: offset + int64(len(content)),
+	}, nil).Once()
+	if content == "" {
+		return
+	}
+	api.EXPECT().Read(mock.Anything, files.ReadDbfsRequest{
+		Path:   path,
+		Offset: offset,
+		Length: int64(len(content)),
+	}).Return(&files.ReadResponse{
+		BytesRead: int64(len(content)),
+		Data:      base64.StdEncoding.EncodeToString([]byte(content)),
+	}, nil).Once()
+}
+
+func expectMissingDbfsFile(m *mocks.MockWorkspaceClient, path string) {
+	m.GetMockDbfsAPI().EXPECT().GetStatusByPath(mock.Anything, path).Return(nil, apierr.ErrResourceDoesNotExist).Once()
+}
+
+func TestTaskLogFollowerFollow(t *testing.T) {
+	ctx, f, m, buf := newTestTaskLogFollower(t, 0)
+
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "c1").Return(&compute.ClusterDetails{
+		ClusterId: "c1",
+		ClusterLogConf: &compute.ClusterLogConf{
+			Dbfs: &compute.DbfsStorageInfo{Destination: "dbfs:/logs"},
+		},
+	}, nil).Once()
+
+	run := &jobs.Run{
+		Tasks: []jobs.RunTask{
+			{
+				RunId:   1,
+				TaskKey: "pending",
+			},
+			{
+				RunId:           2,
+				TaskKey:         "ingest",
+				ClusterInstance: &jobs.ClusterInstance{ClusterId: "c1"},
+			},
+		},
+	}
+
+	// The first poll reads complete lines and keeps the incomplete last line.
+	expectDbfsFile(m, "/logs/c1/driver/stdout", 0, "first\nsec")
+	expectMissingDbfsFile(m, "/logs/c1/driver/stderr")
+	expectDbfsFile(m, "/logs/c1/driver/log4j-active.log", 0, "INFO started\n")
+	f.Follow(ctx, run)
+	assert.Equal(t, "[ingest stdout] first\n[ingest log4j] INFO started\n", buf.String())
+
+	// The second poll completes the line and detects the rotation of the log4j log.
+	buf.Reset()
+	expectDbfsFile(m, "/logs/c1/driver/stdout", 9, "ond\n")
+	expectDbfsFile(m, "/logs/c1/driver/stderr", 0, "oops\n")
+	expectDbfsFile(m, "/logs/c1/driver/log4j-active.log", 0, "new\n")
+	f.Follow(ctx, run)
+	assert.Equal(t, "[ingest stdout] second\n[ingest stderr] oops\n[ingest log4j] new\n", buf.String())
+}
+
+func TestTaskLogFollowerExistingCluster(t *testing.T) {
+	ctx, f, m, buf := newTestTaskLogFollower(t, 0)
+
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "c1").Return(&compute.ClusterDetails{
+		ClusterId: "c1",
+		ClusterLogConf: &compute.ClusterLogConf{
+			Dbfs: &compute.DbfsStorageInfo{Destination: "dbfs:/logs"},
+		},
+	}, nil).Once()
+
+	run := &jobs.Run{
+		Tasks: []jobs.RunTask{
+			{
+				RunId:             1,
+				TaskKey:           "ingest",
+				ExistingClusterId: "c1",
+				ClusterInstance:   &jobs.ClusterInstance{ClusterId: "c1"},
+			},
+		},
+	}
+
+	// Output of earlier runs on the cluster is skipped.
+	expectDbfsFile(m, "/logs/c1/driver/stdout", 100, "")
+	expectMissingDbfsFile(m, "/logs/c1/driver/stderr")
+	expectMissingDbfsFile(m, "/logs/c1/driver/log4j-active.log")
+	f.Follow(ctx, run)
+	assert.Empty(t, buf.String())
+
+	expectDbfsFile(m, "/logs/c1/driver/stdout", 100, "hello\n")
+	expectMissingDbfsFile(m, "/logs/c1/driver/stderr")
+	expectMissingDbfsFile(m, "/logs/c1/driver/log4j-active.log")
+	f.Follow(ctx, run)
+	assert.Equal(t, "[ingest stdout] hello\n", buf.String())
+}
+
+func TestTaskLogFollowerWithoutLogDelivery(t *testing.T) {
+	ctx, f, m, buf := newTestTaskLogFollower(t, 0)
+
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "c1").Return(&compute.ClusterDetails{
+		ClusterId: "c1",
+	}, nil).Once()
+
+	run := &jobs.Run{
+		Tasks: []jobs.RunTask{
+			{
+				RunId:           1,
+				TaskKey:         "ingest",
+				ClusterInstance: &jobs.ClusterInstance{ClusterId: "c1"},
+			},
+		},
+	}
+
+	// The message is printed only once.
+	f.Follow(ctx, run)
+	f.Follow(ctx, run)
+	assert.Equal(t, "Warning: driver logs of task ingest are not available because cluster c1 doesn't deliver its logs to DBFS\n", buf.String())
+}
+
+func TestTaskLogFollowerTail(t *testing.T) {
+	ctx, f, m, buf := newTestTaskLogFollower(t, 2)
+
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "c1").Return(&compute.ClusterDetails{
+		ClusterId: "c1",
+		ClusterLogConf: &compute.ClusterLogConf{
+			Dbfs: &compute.DbfsStorageInfo{Destination: "dbfs:/logs"},
+		},
+	}, nil).Once()
+
+	task := jobs.RunTask{
+		RunId:           1,
+		TaskKey:         "ingest",
+		ClusterInstance: &jobs.ClusterInstance{ClusterId: "c1"},
+	}
+
+	expectDbfsFile(m, "/logs/c1/driver/stdout", 0, "one\ntwo\nthree\n")
+	expectMissingDbfsFile(m, "/logs/c1/driver/stderr")
+	f.Tail(ctx, task)
+	assert.Equal(t, "Last 2 lines of the driver stdout of task ingest:\n[ingest stdout] two\n[ingest stdout] three\n", buf.String())
+}
+
+func TestWarnLogDelivery(t *testing.T) {
+	var buf bytes.Buffer
+	logger := cmdio.NewLogger(flags.ModeAppend)
+	logger.Writer = &buf
+	ctx := cmdio.NewContext(context.Background(), logger)
+
+	delivered := &compute.ClusterLogConf{
+		Dbfs: &compute.DbfsStorageInfo{Destination: "dbfs:/logs"},
+	}
+
+	warnLogDelivery(ctx, &jobs.JobSettings{
+		JobClusters: []jobs.JobCluster{
+			{JobClusterKey: "delivered", NewCluster: compute.ClusterSpec{ClusterLogConf: delivered}},
+			{JobClusterKey: "not_delivered"},
+		},
+		Tasks: []jobs.Task{
+			{TaskKey: "job_cluster", JobClusterKey: "delivered"},
+			{TaskKey: "job_cluster_without_logs", JobClusterKey: "not_delivered"},
+			{TaskKey: "new_cluster", NewCluster: &compute.ClusterSpec{ClusterLogConf: delivered}},
+			{TaskKey: "new_cluster_without_logs", NewCluster: &compute.ClusterSpec{}},
+			{TaskKey: "existing_cluster", ExistingClusterId: "c1"},
+			{TaskKey: "serverless", NotebookTask: &jobs.NotebookTask{NotebookPath: "/nb"}},
+			{TaskKey: "sql", SqlTask: &jobs.SqlTask{WarehouseId: "w1"}},
+		},
+	})
+
+	assert.Equal(t, ""+
+		"Warning: driver logs of task job_cluster_without_logs cannot be followed because its cluster doesn't deliver its logs to DBFS (cluster_log_conf)\n"+
+		"Warning: driver logs of task new_cluster_without_logs cannot be followed because its cluster doesn't deliver its logs to DBFS (cluster_log_conf)\n"+
+		"Warning: driver logs of task serverless cannot be followed because it runs on serverless compute\n",
+		buf.String())
+}
@@ -25,10 +25,30 @@ type JobOptions struct {
 	// If a job uses job parameters, it cannot use task parameters.
 	// Also see https://docs.databricks.com/en/workflows/jobs/settings.html#add-parameters-for-all-job-tasks.
 	jobParams map[string]string
+
+	// Print the driver logs of tasks while the job runs.
+	follow bool
+
+	// Number of lines of the driver logs to print for failed tasks.
+	tailLines int
+
+	// Keys of the tasks to run. If empty, all tasks run.
+	only                []string
+	includeDependencies bool
+
+	// Repair the run with this ID, or the latest run of the job, instead of starting a new run.
+	repairRunId  int64
+	repairLatest bool
 }
 
 func (o *JobOptions) DefineJobOptions(fs *flag.FlagSet) {
 	fs.StringToStringVar(&o.jobParams, "params", nil, "comma separated k=v pairs for job parameters")
+	fs.BoolVar(&o.follow, "follow", false, "Print the driver logs of running tasks as they are delivered. Requires clusters that deliver their logs to DBFS.")
+	fs.IntVar(&o.tailLines, "tail-lines", 50, "Number of lines of the driver logs to print for failed tasks.")
+	fs.StringSliceVar(&o.only, "only", nil, "Comma separated keys of the tasks to run instead of all tasks of the job.")
+	fs.BoolVar(&o.includeDependencies, "include-dependencies", false, "Also run the tasks that the tasks specified with --only depend on.")
+	fs.Int64Var(&o.repairRunId, "repair", 0, "ID of a run of the job to repair. Reruns its failed tasks, or the tasks specified with --only.")
+	fs.BoolVar(&o.repairLatest, "repair-latest", false, "Repair the latest completed run of the job.")
 }
 
 func (o *JobOptions) DefineTaskOptions(fs *flag.FlagSet) {
@@ -72,6 +92,13 @@ func (o *JobOptions) Validate(job *resources.Job) error {
 		return fmt.Errorf("the job to run does not define job parameters; specifying job parameters is not allowed")
 	}
 
+	if o.repairRunId != 0 && o.repairLatest {
+		return fmt.Errorf("--repair and --repair-latest cannot be used together")
+	}
+	if o.includeDependencies && len(o.only) == 0 {
+		return fmt.Errorf("--include-dependencies requires --only")
+	}
+
 	return nil
 }
 
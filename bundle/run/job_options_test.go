@@ -242,3 +242,35 @@ func TestJobOptionsValidateIfJobHasNoJobParameters(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestJobOptionsValidateRepairAndOnly(t *testing.T) {
+	job := &resources.Job{
+		JobSettings: &jobs.JobSettings{},
+	}
+
+	{
+		fs, opts := setupJobOptions(t)
+		err := fs.Parse([]string{`--repair=123`, `--repair-latest`})
+		require.NoError(t, err)
+		err = opts.Validate(job)
+		assert.EqualError(t, err, "--repair and --repair-latest cannot be used together")
+	}
+
+	{
+		fs, opts := setupJobOptions(t)
+		err := fs.Parse([]string{`--include-dependencies`})
+		require.NoError(t, err)
+		err = opts.Validate(job)
+		assert.EqualError(t, err, "--include-dependencies requires --only")
+	}
+
+	{
+		fs, opts := setupJobOptions(t)
+		err := fs.Parse([]string{`--repair=123`, `--only=a,b`, `--include-dependencies`})
+		require.NoError(t, err)
+		err = opts.Validate(job)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(123), opts.repairRunId)
+		assert.Equal(t, []string{"a", "b"}, opts.only)
+	}
+}
@@ -0,0 +1,220 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/client"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// startRun starts a run of the job, a run of a subset of its tasks, or a repair
+// of an earlier run, depending on the options. The returned waiter waits for the
+// resulting run to complete.
+func (r *jobRunner) startRun(ctx context.Context, w *databricks.WorkspaceClient, opts *Options, req *jobs.RunNow) (*jobs.WaitGetRunJobTerminatedOrSkipped[struct{}], error) {
+	tasks, err := r.selectTasks(opts.Job.only, opts.Job.includeDependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Job.repairRunId != 0 || opts.Job.repairLatest {
+		return r.repairRun(ctx, w, opts, req, tasks)
+	}
+
+	if len(tasks) == 0 {
+		waiter, err := w.Jobs.RunNow(ctx, *req)
+		if err != nil {
+			return nil, fmt.Errorf("cannot start job")
+		}
+		return &jobs.WaitGetRunJobTerminatedOrSkipped[struct{}]{RunId: waiter.RunId, Poll: waiter.Poll}, nil
+	}
+
+	runId, err := runNowOnly(ctx, w, req, tasks)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start job: %w", err)
+	}
+
+	return &jobs.WaitGetRunJobTerminatedOrSkipped[struct{}]{
+		RunId: runId,
+		Poll: func(timeout time.Duration, callback func(*jobs.Run)) (*jobs.Run, error) {
+			return w.Jobs.WaitGetRunJobTerminatedOrSkipped(ctx, runId, timeout, callback)
+		},
+	}, nil
+}
+
+// selectTasks returns the keys of the tasks to run in the order they are defined
+// in the job, optionally including the tasks they depend on. It returns nil if
+// no tasks are specified, which means that all tasks run.
+func (r *jobRunner) selectTasks(only []string, includeDependencies bool) ([]string, error) {
+	if len(only) == 0 {
+		return nil, nil
+	}
+
+	var tasks []jobs.Task
+	if r.job.JobSettings != nil {
+		tasks = r.job.Tasks
+	}
+
+	byKey := make(map[string]jobs.Task)
+	for _, task := range tasks {
+		byKey[task.TaskKey] = task
+	}
+
+	selected := make(map[string]bool)
+	var visit func(key string)
+	visit = func(key string) {
+		if selected[key] {
+			return
+		}
+		selected[key] = true
+		if !includeDependencies {
+			return
+		}
+		for _, dep := range byKey[key].DependsOn {
+			visit(dep.TaskKey)
+		}
+	}
+
+	for _, key := range only {
+		if _, ok := byKey[key]; !ok {
+			return nil, fmt.Errorf("job %s does not have a task with key %s", r.Key(), key)
+		}
+		visit(key)
+	}
+
+	var out []string
+	for _, task := range tasks {
+		if selected[task.TaskKey] {
+			out = append(out, task.TaskKey)
+		}
+	}
+	return out, nil
+}
+
+// runNowOnlyRequest returns the body of a run-now request that only runs the specified tasks.
+func runNowOnlyRequest(req *jobs.RunNow, tasks []string) (map[string]any, error) {
+	buf, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body map[string]any
+	err = json.Unmarshal(buf, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	body["only"] = tasks
+	return body, nil
+}
+
+// runNowOnly starts a run of the job that only runs the specified tasks.
+// The SDK doesn't support the `only` field of the run-now request, so the
+// request is made directly.
+func runNowOnly(ctx context.Context, w *databricks.WorkspaceClient, req *jobs.RunNow, tasks []string) (int64, error) {
+	body, err := runNowOnlyRequest(req, tasks)
+	if err != nil {
+		return 0, err
+	}
+
+	apiClient, err := client.New(w.Config)
+	if err != nil {
+		return 0, err
+	}
+
+	var resp jobs.RunNowResponse
+	err = apiClient.Do(ctx, http.MethodPost, "/api/2.1/jobs/run-now", nil, body, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.RunId, nil
+}
+
+// repairRun reruns the specified tasks of an earlier run of the job, or all of
+// its failed tasks if no tasks are specified.
+func (r *jobRunner) repairRun(ctx context.Context, w *databricks.WorkspaceClient, opts *Options, req *jobs.RunNow, tasks []string) (*jobs.WaitGetRunJobTerminatedOrSkipped[struct{}], error) {
+	runId := opts.Job.repairRunId
+	if opts.Job.repairLatest {
+		runs, err := listing.ToSliceN(ctx, w.Jobs.ListRuns(ctx, jobs.ListRunsRequest{
+			JobId:         req.JobId,
+			CompletedOnly: true,
+			Limit:         1,
+		}), 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) == 0 {
+			return nil, fmt.Errorf("job %s does not have a completed run to repair", r.Key())
+		}
+		runId = runs[0].RunId
+	}
+
+	run, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
+		RunId:          runId,
+		IncludeHistory: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if run.JobId != req.JobId {
+		return nil, fmt.Errorf("run %d is not a run of job %s", runId, r.Key())
+	}
+
+	repair := toRepairPayload(run, req, tasks)
+	waiter, err := w.Jobs.RepairRun(ctx, repair)
+	if err != nil {
+		return nil, fmt.Errorf("cannot repair run %d: %w", runId, err)
+	}
+
+	if len(tasks) == 0 {
+		cmdio.LogString(ctx, fmt.Sprintf("Repairing the failed tasks of run %d", runId))
+	} else {
+		cmdio.LogString(ctx, fmt.Sprintf("Repairing tasks %v of run %d", tasks, runId))
+	}
+	return &jobs.WaitGetRunJobTerminatedOrSkipped[struct{}]{RunId: waiter.RunId, Poll: waiter.Poll}, nil
+}
+
+// toRepairPayload returns the request to repair the run with the parameters of
+// the run-now request. Tasks that depend on the rerun tasks are rerun as well.
+func toRepairPayload(run *jobs.Run, req *jobs.RunNow, tasks []string) jobs.RepairRun {
+	return jobs.RepairRun{
+		RunId:               run.RunId,
+		LatestRepairId:      latestRepairId(run),
+		RerunAllFailedTasks: len(tasks) == 0,
+		RerunTasks:          tasks,
+		RerunDependentTasks: true,
+
+		DbtCommands:       req.DbtCommands,
+		JarParams:         req.JarParams,
+		NotebookParams:    req.NotebookParams,
+		PipelineParams:    req.PipelineParams,
+		PythonNamedParams: req.PythonNamedParams,
+		PythonParams:      req.PythonParams,
+		SparkSubmitParams: req.SparkSubmitParams,
+		SqlParams:         req.SqlParams,
+
+		JobParameters: req.JobParameters,
+	}
+}
+
+// latestRepairId returns the ID of the latest repair of the run, or 0 if it has not been repaired.
+// A repair of a run that has been repaired before must reference the latest repair.
+func latestRepairId(run *jobs.Run) int64 {
+	var ids []int64
+	for _, item := range run.RepairHistory {
+		if item.Type == jobs.RepairHistoryItemTypeRepair {
+			ids = append(ids, item.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return 0
+	}
+	return slices.Max(ids)
+}
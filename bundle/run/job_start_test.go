@@ -0,0 +1,159 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newJobRunnerWithTasks(t *testing.T) (*jobRunner, *mocks.MockWorkspaceClient) {
+	job := &resources.Job{
+		ID: "123",
+		JobSettings: &jobs.JobSettings{
+			Tasks: []jobs.Task{
+				{TaskKey: "extract"},
+				{TaskKey: "transform", DependsOn: []jobs.TaskDependency{{TaskKey: "extract"}}},
+				{TaskKey: "load", DependsOn: []jobs.TaskDependency{{TaskKey: "transform"}}},
+				{TaskKey: "report"},
+			},
+		},
+	}
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"test_job": job,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	return &jobRunner{key: "jobs.test_job", bundle: b, job: job}, m
+}
+
+func TestJobRunnerSelectTasks(t *testing.T) {
+	runner, _ := newJobRunnerWithTasks(t)
+
+	tasks, err := runner.selectTasks(nil, false)
+	require.NoError(t, err)
+	assert.Nil(t, tasks)
+
+	// Tasks are returned in the order they are defined in.
+	tasks, err = runner.selectTasks([]string{"report", "load"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"load", "report"}, tasks)
+
+	tasks, err = runner.selectTasks([]string{"load"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"extract", "transform", "load"}, tasks)
+
+	_, err = runner.selectTasks([]string{"unknown"}, false)
+	assert.EqualError(t, err, "job jobs.test_job does not have a task with key unknown")
+}
+
+func TestRunNowOnlyRequest(t *testing.T) {
+	body, err := runNowOnlyRequest(&jobs.RunNow{
+		JobId:         123,
+		JobParameters: map[string]string{"foo": "bar"},
+	}, []string{"extract", "load"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"job_id":         float64(123),
+		"job_parameters": map[string]any{"foo": "bar"},
+		"only":           []string{"extract", "load"},
+	}, body)
+}
+
+func TestToRepairPayload(t *testing.T) {
+	run := &jobs.Run{
+		RunId: 456,
+		RepairHistory: []jobs.RepairHistoryItem{
+			{Type: jobs.RepairHistoryItemTypeOriginal},
+			{Type: jobs.RepairHistoryItemTypeRepair, Id: 2},
+			{Type: jobs.RepairHistoryItemTypeRepair, Id: 7},
+		},
+	}
+	req := &jobs.RunNow{
+		JobId:         123,
+		JobParameters: map[string]string{"foo": "bar"},
+	}
+
+	assert.Equal(t, jobs.RepairRun{
+		RunId:               456,
+		LatestRepairId:      7,
+		RerunAllFailedTasks: true,
+		RerunDependentTasks: true,
+		JobParameters:       map[string]string{"foo": "bar"},
+	}, toRepairPayload(run, req, nil))
+
+	assert.Equal(t, jobs.RepairRun{
+		RunId:               456,
+		LatestRepairId:      7,
+		RerunTasks:          []string{"load"},
+		RerunDependentTasks: true,
+		JobParameters:       map[string]string{"foo": "bar"},
+	}, toRepairPayload(run, req, []string{"load"}))
+
+	assert.Equal(t, int64(0), latestRepairId(&jobs.Run{}))
+}
+
+func TestJobRunnerRepairLatest(t *testing.T) {
+	runner, m := newJobRunnerWithTasks(t)
+
+	api := m.GetMockJobsAPI()
+	api.EXPECT().ListRuns(mock.Anything, jobs.ListRunsRequest{
+		JobId:         123,
+		CompletedOnly: true,
+		Limit:         1,
+	}).Return(&listing.SliceIterator[jobs.BaseRun]{{RunId: 456}, {RunId: 455}})
+	api.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{
+		RunId:          456,
+		IncludeHistory: true,
+	}).Return(&jobs.Run{JobId: 123, RunId: 456}, nil)
+	api.EXPECT().RepairRun(mock.Anything, jobs.RepairRun{
+		RunId:               456,
+		RerunAllFailedTasks: true,
+		RerunDependentTasks: true,
+	}).Return(&jobs.WaitGetRunJobTerminatedOrSkipped[jobs.RepairRunResponse]{RunId: 456}, nil)
+
+	opts := &Options{Job: JobOptions{repairLatest: true}}
+	waiter, err := runner.startRun(context.Background(), m.WorkspaceClient, opts, &jobs.RunNow{JobId: 123})
+	require.NoError(t, err)
+	assert.Equal(t, int64(456), waiter.RunId)
+}
+
+func TestJobRunnerRepairRunOfOtherJob(t *testing.T) {
+	runner, m := newJobRunnerWithTasks(t)
+
+	api := m.GetMockJobsAPI()
+	api.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{
+		RunId:          456,
+		IncludeHistory: true,
+	}).Return(&jobs.Run{JobId: 999, RunId: 456}, nil)
+
+	opts := &Options{Job: JobOptions{repairRunId: 456}}
+	_, err := runner.startRun(context.Background(), m.WorkspaceClient, opts, &jobs.RunNow{JobId: 123})
+	assert.EqualError(t, err, "run 456 is not a run of job jobs.test_job")
+}
+
+func TestJobRunnerRepairLatestWithoutRuns(t *testing.T) {
+	runner, m := newJobRunnerWithTasks(t)
+
+	api := m.GetMockJobsAPI()
+	api.EXPECT().ListRuns(mock.Anything, mock.Anything).Return(&listing.SliceIterator[jobs.BaseRun]{})
+
+	opts := &Options{Job: JobOptions{repairLatest: true}}
+	_, err := runner.startRun(context.Background(), m.WorkspaceClient, opts, &jobs.RunNow{JobId: 123})
+	assert.EqualError(t, err, "job jobs.test_job does not have a completed run to repair")
+}
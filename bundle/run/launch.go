@@ -0,0 +1,272 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/bundle/run/progress"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/google/uuid"
+)
+
+// launchWrapper runs a Python file and copies its stdout and stderr to a file in the
+// workspace, so that the output can be streamed while the run is in progress.
+const launchWrapper = `# Generated by the Databricks CLI to stream the output of "bundle launch".
+import os
+import runpy
+import sys
+
+output_path, script = sys.argv[1], sys.argv[2]
+output = open(output_path, "a", buffering=1)
+
+
+class Tee:
+    def __init__(self, stream):
+        self.stream = stream
+
+    def write(self, data):
+        self.stream.write(data)
+        output.write(data)
+        return len(data)
+
+    def flush(self):
+        self.stream.flush()
+        output.flush()
+
+    def __getattr__(self, name):
+        return getattr(self.stream, name)
+
+
+sys.stdout = Tee(sys.stdout)
+sys.stderr = Tee(sys.stderr)
+sys.argv = [script] + sys.argv[3:]
+sys.path.insert(0, os.path.dirname(script))
+runpy.run_path(script, run_name="__main__")
+`
+
+// LaunchOptions configures a one-time run of a single file.
+type LaunchOptions struct {
+	// Workspace path of the file to run.
+	Path string
+
+	// Whether the file is a notebook.
+	IsNotebook bool
+
+	// Command line arguments passed to Python files.
+	Args []string
+
+	// ID of the cluster to run notebooks and Python files on.
+	// If empty, they run on serverless compute.
+	ClusterId string
+
+	// ID of the SQL warehouse to run SQL files on.
+	WarehouseId string
+
+	NoWait bool
+
+	// Writer the stdout and stderr of Python files are streamed to while they run.
+	// The output of notebooks and SQL files is returned when the run completes.
+	Output io.Writer
+}
+
+// launchTask returns the task that runs the file specified by the options.
+func launchTask(opts *LaunchOptions) (*jobs.SubmitTask, error) {
+	task := &jobs.SubmitTask{
+		TaskKey: "launch",
+	}
+
+	switch {
+	case opts.IsNotebook:
+		if len(opts.Args) > 0 {
+			return nil, fmt.Errorf("arguments are only supported for Python files")
+		}
+		task.NotebookTask = &jobs.NotebookTask{
+			NotebookPath: opts.Path,
+			Source:       jobs.SourceWorkspace,
+		}
+		task.ExistingClusterId = opts.ClusterId
+
+	case strings.EqualFold(path.Ext(opts.Path), ".py"):
+		task.SparkPythonTask = &jobs.SparkPythonTask{
+			PythonFile: opts.Path,
+			Parameters: opts.Args,
+			Source:     jobs.SourceWorkspace,
+		}
+		task.ExistingClusterId = opts.ClusterId
+
+	case strings.EqualFold(path.Ext(opts.Path), ".sql"):
+		if len(opts.Args) > 0 {
+			return nil, fmt.Errorf("arguments are only supported for Python files")
+		}
+		if opts.WarehouseId == "" {
+			return nil, fmt.Errorf("running SQL file %s requires a SQL warehouse; specify one with --warehouse-id", opts.Path)
+		}
+		task.SqlTask = &jobs.SqlTask{
+			File: &jobs.SqlTaskFile{
+				Path:   opts.Path,
+				Source: jobs.SourceWorkspace,
+			},
+			WarehouseId: opts.WarehouseId,
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported file %s: only notebooks, Python files and SQL files can be launched", opts.Path)
+	}
+
+	return task, nil
+}
+
+// wrapPythonTask changes the task to run the Python file through [launchWrapper],
+// which copies the output of the file to the specified workspace path.
+func wrapPythonTask(task *jobs.SubmitTask, wrapperPath, outputPath string) {
+	parameters := []string{fusePath(outputPath), fusePath(task.SparkPythonTask.PythonFile)}
+	task.SparkPythonTask = &jobs.SparkPythonTask{
+		PythonFile: wrapperPath,
+		Parameters: append(parameters, task.SparkPythonTask.Parameters...),
+		Source:     jobs.SourceWorkspace,
+	}
+}
+
+// fusePath returns the path at which a workspace file can be accessed from
+// the file system of the compute.
+func fusePath(p string) string {
+	if strings.HasPrefix(p, "/Workspace/") || strings.HasPrefix(p, "/Volumes/") {
+		return p
+	}
+	return path.Join("/Workspace", p)
+}
+
+// streamPythonOutput uploads [launchWrapper] to the state path of the bundle and
+// changes the task to run through it. It returns the tail of the output of the run
+// and a function that removes the output when the run is done.
+func streamPythonOutput(ctx context.Context, b *bundle.Bundle, task *jobs.SubmitTask, out io.Writer) (*filer.Tail, func(), error) {
+	launchRoot := path.Join(b.Config.Workspace.StatePath, "launch")
+	f, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), launchRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = f.Write(ctx, "launch.py", strings.NewReader(launchWrapper), filer.OverwriteIfExists, filer.CreateParentDirectories)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to upload launch wrapper: %w", err)
+	}
+
+	// Every run writes its output to a file of its own.
+	name := uuid.New().String() + ".txt"
+	wrapPythonTask(task, path.Join(launchRoot, "launch.py"), path.Join(launchRoot, name))
+
+	cleanup := func() {
+		err := f.Delete(ctx, name)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Warnf(ctx, "Failed to remove launch output: %v", err)
+		}
+	}
+	return filer.NewTail(f, name, out), cleanup, nil
+}
+
+// Launch submits a one-time run of a single file from the bundle and waits
+// for it to complete. It expects the file to be synchronized to the workspace.
+// The output of Python files is streamed to [LaunchOptions.Output] while they run.
+func Launch(ctx context.Context, b *bundle.Bundle, opts *LaunchOptions) (output.RunOutput, error) {
+	task, err := launchTask(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	w := b.WorkspaceClient()
+	runId := new(int64)
+
+	var tail *filer.Tail
+	if task.SparkPythonTask != nil && !opts.NoWait && opts.Output != nil {
+		var cleanup func()
+		tail, cleanup, err = streamPythonOutput(ctx, b, task, opts.Output)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+	}
+
+	// callback to log progress events. Called on every poll request
+	progressLogger, ok := cmdio.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no progress logger found")
+	}
+
+	waiter, err := w.Jobs.Submit(ctx, jobs.SubmitRun{
+		RunName: fmt.Sprintf("%s: %s", b.Config.Bundle.Name, path.Base(opts.Path)),
+		Tasks:   []jobs.SubmitTask{*task},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot launch %s: %w", opts.Path, err)
+	}
+
+	if opts.NoWait {
+		details, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
+			RunId: waiter.RunId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		progressLogger.Log(progress.NewJobRunUrlEvent(details.RunPageUrl))
+		return nil, nil
+	}
+
+	pullRunId := pullRunIdCallback(runId)
+	logDebug := logDebugCallback(ctx, runId)
+	logProgress := logProgressCallback(ctx, progressLogger)
+
+	run, err := waiter.OnProgress(func(r *jobs.Run) {
+		pullRunId(r)
+		logDebug(r)
+		logProgress(r)
+		if tail != nil {
+			if err := tail.Flush(ctx); err != nil {
+				log.Debugf(ctx, "Failed to read launch output: %v", err)
+			}
+		}
+	}).GetWithTimeout(jobRunTimeout)
+	if tail != nil {
+		// Print the remaining output, also if the run failed.
+		if err := tail.Flush(ctx); err != nil {
+			log.Warnf(ctx, "Failed to read launch output: %v", err)
+		}
+	}
+	if err != nil && *runId != 0 {
+		logFailedTasks(ctx, w, *runId, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch run.State.ResultState {
+	case jobs.RunResultStateSuccess:
+		log.Infof(ctx, "Run has completed successfully!")
+		if tail != nil {
+			// The output has been streamed already.
+			return nil, nil
+		}
+		return output.GetJobOutput(ctx, w, run.RunId)
+
+	case jobs.RunResultStateFailed:
+		logFailedTasks(ctx, w, run.RunId, nil)
+		return nil, fmt.Errorf("run failed: %s", run.State.StateMessage)
+
+	case jobs.RunResultStateCanceled:
+		return nil, fmt.Errorf("run canceled: %s", run.State.StateMessage)
+
+	case jobs.RunResultStateTimedout:
+		return nil, fmt.Errorf("run timed out: %s", run.State.StateMessage)
+	}
+
+	return nil, fmt.Errorf("run did not complete: %s", run.State.StateMessage)
+}
@@ -0,0 +1,79 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchTaskNotebook(t *testing.T) {
+	task, err := launchTask(&LaunchOptions{
+		Path:       "/bundle/src/my_notebook",
+		IsNotebook: true,
+		ClusterId:  "1234",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/bundle/src/my_notebook", task.NotebookTask.NotebookPath)
+	assert.Equal(t, jobs.SourceWorkspace, task.NotebookTask.Source)
+	assert.Equal(t, "1234", task.ExistingClusterId)
+
+	_, err = launchTask(&LaunchOptions{
+		Path:       "/bundle/src/my_notebook",
+		IsNotebook: true,
+		Args:       []string{"foo"},
+	})
+	assert.EqualError(t, err, "arguments are only supported for Python files")
+}
+
+func TestLaunchTaskPythonFile(t *testing.T) {
+	task, err := launchTask(&LaunchOptions{
+		Path: "/bundle/src/my_script.py",
+		Args: []string{"--foo", "bar"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/bundle/src/my_script.py", task.SparkPythonTask.PythonFile)
+	assert.Equal(t, []string{"--foo", "bar"}, task.SparkPythonTask.Parameters)
+	assert.Empty(t, task.ExistingClusterId)
+}
+
+func TestLaunchTaskSqlFile(t *testing.T) {
+	_, err := launchTask(&LaunchOptions{
+		Path: "/bundle/src/query.sql",
+	})
+	assert.ErrorContains(t, err, "requires a SQL warehouse")
+
+	task, err := launchTask(&LaunchOptions{
+		Path:        "/bundle/src/query.sql",
+		WarehouseId: "abcd",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/bundle/src/query.sql", task.SqlTask.File.Path)
+	assert.Equal(t, "abcd", task.SqlTask.WarehouseId)
+}
+
+func TestLaunchTaskUnsupportedFile(t *testing.T) {
+	_, err := launchTask(&LaunchOptions{
+		Path: "/bundle/src/data.csv",
+	})
+	assert.ErrorContains(t, err, "only notebooks, Python files and SQL files can be launched")
+}
+
+func TestWrapPythonTask(t *testing.T) {
+	task, err := launchTask(&LaunchOptions{
+		Path: "/Users/user@example.com/.bundle/files/src/my_script.py",
+		Args: []string{"--foo", "bar"},
+	})
+	require.NoError(t, err)
+
+	wrapPythonTask(task, "/Users/user@example.com/.bundle/state/launch/launch.py", "/Users/user@example.com/.bundle/state/launch/output.txt")
+	assert.Equal(t, "/Users/user@example.com/.bundle/state/launch/launch.py", task.SparkPythonTask.PythonFile)
+	assert.Equal(t, []string{
+		"/Workspace/Users/user@example.com/.bundle/state/launch/output.txt",
+		"/Workspace/Users/user@example.com/.bundle/files/src/my_script.py",
+		"--foo",
+		"bar",
+	}, task.SparkPythonTask.Parameters)
+	assert.Equal(t, jobs.SourceWorkspace, task.SparkPythonTask.Source)
+}
@@ -6,9 +6,11 @@ import (
 )
 
 type Options struct {
-	Job      JobOptions
-	Pipeline PipelineOptions
-	NoWait   bool
+	Job             JobOptions
+	Pipeline        PipelineOptions
+	ServingEndpoint ServingEndpointOptions
+	Sql             SqlOptions
+	NoWait          bool
 }
 
 func (o *Options) Define(cmd *cobra.Command) {
@@ -23,8 +25,16 @@ func (o *Options) Define(cmd *cobra.Command) {
 	pipelineGroup := cmdgroup.NewFlagGroup("Pipeline")
 	o.Pipeline.Define(pipelineGroup.FlagSet())
 
+	servingEndpointGroup := cmdgroup.NewFlagGroup("Model Serving Endpoint")
+	o.ServingEndpoint.Define(servingEndpointGroup.FlagSet())
+
+	sqlGroup := cmdgroup.NewFlagGroup("SQL")
+	o.Sql.Define(sqlGroup.FlagSet())
+
 	wrappedCmd := cmdgroup.NewCommandWithGroupFlag(cmd)
 	wrappedCmd.AddFlagGroup(jobGroup)
 	wrappedCmd.AddFlagGroup(jobTaskGroup)
 	wrappedCmd.AddFlagGroup(pipelineGroup)
+	wrappedCmd.AddFlagGroup(servingEndpointGroup)
+	wrappedCmd.AddFlagGroup(sqlGroup)
 }
@@ -0,0 +1,38 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PipelineDataset is a dataset in the graph of a pipeline and the datasets it reads from.
+type PipelineDataset struct {
+	Name     string   `json:"name"`
+	FlowType string   `json:"flow_type,omitempty"`
+	Inputs   []string `json:"inputs,omitempty"`
+}
+
+// PipelineGraphOutput is the dataset graph of a pipeline as resolved by a validate-only update.
+type PipelineGraphOutput struct {
+	Datasets []PipelineDataset `json:"datasets"`
+}
+
+func (out *PipelineGraphOutput) String() (string, error) {
+	if len(out.Datasets) == 0 {
+		return "The pipeline does not define any datasets.\n", nil
+	}
+
+	result := strings.Builder{}
+	result.WriteString("Dataset graph:\n")
+	for _, d := range out.Datasets {
+		result.WriteString("  " + d.Name)
+		if d.FlowType != "" {
+			result.WriteString(fmt.Sprintf(" (%s)", d.FlowType))
+		}
+		result.WriteString("\n")
+		for _, input := range d.Inputs {
+			result.WriteString(fmt.Sprintf("    <- %s\n", input))
+		}
+	}
+	return result.String(), nil
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineGraphOutputString(t *testing.T) {
+	out := &PipelineGraphOutput{
+		Datasets: []PipelineDataset{
+			{Name: "sales_raw", FlowType: "APPEND"},
+			{Name: "sales_clean", FlowType: "COMPLETE", Inputs: []string{"sales_raw", "customers"}},
+		},
+	}
+
+	s, err := out.String()
+	require.NoError(t, err)
+	assert.Equal(t, `Dataset graph:
+  sales_raw (APPEND)
+  sales_clean (COMPLETE)
+    <- sales_raw
+    <- customers
+`, s)
+}
+
+func TestPipelineGraphOutputStringEmpty(t *testing.T) {
+	s, err := (&PipelineGraphOutput{}).String()
+	require.NoError(t, err)
+	assert.Equal(t, "The pipeline does not define any datasets.\n", s)
+}
@@ -0,0 +1,23 @@
+package output
+
+import (
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+// QualityMonitorOutput describes the result of a quality monitor refresh.
+type QualityMonitorOutput struct {
+	TableName string                          `json:"table_name"`
+	RefreshId int64                           `json:"refresh_id"`
+	State     catalog.MonitorRefreshInfoState `json:"state"`
+	Message   string                          `json:"message,omitempty"`
+}
+
+func (out *QualityMonitorOutput) String() (string, error) {
+	s := fmt.Sprintf("Refresh %d of the quality monitor for %s finished with state %s\n", out.RefreshId, out.TableName, out.State)
+	if out.Message != "" {
+		s += out.Message + "\n"
+	}
+	return s, nil
+}
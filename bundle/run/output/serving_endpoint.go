@@ -0,0 +1,24 @@
+package output
+
+import (
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go/service/serving"
+)
+
+// ServingEndpointOutput holds the response to a test query of a model serving endpoint.
+type ServingEndpointOutput struct {
+	Response *serving.QueryEndpointResponse `json:"response"`
+}
+
+func (out *ServingEndpointOutput) String() (string, error) {
+	outputString, err := structToString(out.Response)
+	if err != nil {
+		return "", err
+	}
+
+	// We add this prefix to make this output non machine readable.
+	// If user needs machine parsable output, they can use the --output json
+	// flag
+	return fmt.Sprintf("Model Serving Endpoint Response:\n%s\n", outputString), nil
+}
@@ -0,0 +1,37 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// SqlStatementOutput holds the result of the last statement of a SQL file.
+type SqlStatementOutput struct {
+	StatementId string     `json:"statement_id"`
+	Columns     []string   `json:"columns,omitempty"`
+	Rows        [][]string `json:"rows,omitempty"`
+	Truncated   bool       `json:"truncated,omitempty"`
+}
+
+func (out *SqlStatementOutput) String() (string, error) {
+	if len(out.Columns) == 0 {
+		return "", nil
+	}
+
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(out.Columns, "\t"))
+	for _, row := range out.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	err := tw.Flush()
+	if err != nil {
+		return "", err
+	}
+
+	if out.Truncated {
+		sb.WriteString("[truncated...]\n")
+	}
+	return sb.String(), nil
+}
@@ -3,6 +3,7 @@ package run
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/databricks/cli/bundle"
@@ -41,7 +42,9 @@ func (r *pipelineRunner) logEvent(ctx context.Context, event pipelines.PipelineE
 	}
 }
 
-func (r *pipelineRunner) logErrorEvent(ctx context.Context, pipelineId string, updateId string) error {
+// logErrorEvent logs the error events of the update. If progressLogger is set,
+// errors that are not part of the progress output are logged to it as well.
+func (r *pipelineRunner) logErrorEvent(ctx context.Context, pipelineId string, updateId string, progressLogger *cmdio.Logger) error {
 	w := r.bundle.WorkspaceClient()
 
 	// Note: For a 100 percent correct and complete solution we should use the
@@ -66,10 +69,90 @@ func (r *pipelineRunner) logErrorEvent(ctx context.Context, pipelineId string, u
 	// to print the events chronologically
 	for i := len(updateEvents) - 1; i >= 0; i-- {
 		r.logEvent(ctx, updateEvents[i])
+
+		// Errors of update and flow progress events are already part of the progress output.
+		eventType := updateEvents[i].EventType
+		if progressLogger != nil && eventType != "update_progress" && eventType != "flow_progress" {
+			event := progress.ProgressEvent(updateEvents[i])
+			progressLogger.Log(&event)
+		}
 	}
 	return nil
 }
 
+// selectTables prompts for the tables to update. The tables to select from are
+// the datasets of the latest update of the pipeline.
+func (r *pipelineRunner) selectTables(ctx context.Context, pipeline *pipelines.GetPipelineResponse) ([]string, error) {
+	if !cmdio.IsPromptSupported(ctx) {
+		return nil, fmt.Errorf("specify the tables to update with --refresh=TABLE,...; prompting is not supported")
+	}
+	if len(pipeline.LatestUpdates) == 0 {
+		return nil, fmt.Errorf("pipeline %s has no updates to select tables from; specify the tables to update with --refresh=TABLE,...", r.Key())
+	}
+
+	w := r.bundle.WorkspaceClient()
+	defs, err := progress.ListFlowDefinitions(ctx, w, pipeline.PipelineId, pipeline.LatestUpdates[0].UpdateId)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	for _, def := range defs {
+		tables = append(tables, def.OutputDataset)
+	}
+	slices.Sort(tables)
+	tables = slices.Compact(tables)
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("the latest update of pipeline %s does not define any tables; specify the tables to update with --refresh=TABLE,...", r.Key())
+	}
+
+	// Prompt for one table at a time until the user is done.
+	var selected []string
+	for len(tables) > 0 {
+		items := make([]cmdio.Tuple, 0, len(tables)+1)
+		done := fmt.Sprintf("update %d selected tables", len(selected))
+		if len(selected) > 0 {
+			items = append(items, cmdio.Tuple{Name: "Done", Id: done})
+		}
+		for _, table := range tables {
+			items = append(items, cmdio.Tuple{Name: table, Id: table})
+		}
+
+		id, err := cmdio.SelectOrdered(ctx, items, "Table to update")
+		if err != nil {
+			return nil, err
+		}
+		if id == done {
+			break
+		}
+
+		selected = append(selected, id)
+		tables = slices.DeleteFunc(tables, func(t string) bool { return t == id })
+	}
+
+	return selected, nil
+}
+
+// datasetGraph returns the dataset graph resolved by the update.
+func (r *pipelineRunner) datasetGraph(ctx context.Context, pipelineId string, updateId string) (*output.PipelineGraphOutput, error) {
+	defs, err := progress.ListFlowDefinitions(ctx, r.bundle.WorkspaceClient(), pipelineId, updateId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.PipelineGraphOutput{
+		Datasets: make([]output.PipelineDataset, 0, len(defs)),
+	}
+	for _, def := range defs {
+		out.Datasets = append(out.Datasets, output.PipelineDataset{
+			Name:     def.OutputDataset,
+			FlowType: def.FlowType,
+			Inputs:   def.InputDatasets,
+		})
+	}
+	return out, nil
+}
+
 type pipelineRunner struct {
 	key
 
@@ -90,12 +173,24 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 	// Include resource key in logger.
 	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
 	w := r.bundle.WorkspaceClient()
-	_, err := w.Pipelines.GetByPipelineId(ctx, pipelineID)
+	pipeline, err := w.Pipelines.GetByPipelineId(ctx, pipelineID)
 	if err != nil {
 		log.Warnf(ctx, "Cannot get pipeline: %s", err)
 		return nil, err
 	}
 
+	if opts.Pipeline.RefreshSelect {
+		err = opts.Pipeline.Validate(r.pipeline)
+		if err != nil {
+			return nil, err
+		}
+		opts.Pipeline.Refresh, err = r.selectTables(ctx, pipeline)
+		if err != nil {
+			return nil, err
+		}
+		opts.Pipeline.RefreshSelect = false
+	}
+
 	req, err := opts.Pipeline.toPayload(r.pipeline, pipelineID)
 	if err != nil {
 		return nil, err
@@ -131,7 +226,7 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 			return nil, err
 		}
 		for _, event := range events {
-			progressLogger.Log(&event)
+			progressLogger.Log(event)
 			log.Infof(ctx, event.String())
 		}
 
@@ -153,7 +248,13 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 		}
 		if state == pipelines.UpdateInfoStateFailed {
 			log.Infof(ctx, "Update has failed!")
-			err := r.logErrorEvent(ctx, pipelineID, updateID)
+
+			// Analysis errors of validate-only updates are printed with the progress.
+			var errorLogger *cmdio.Logger
+			if opts.Pipeline.ValidateOnly {
+				errorLogger = progressLogger
+			}
+			err := r.logErrorEvent(ctx, pipelineID, updateID, errorLogger)
 			if err != nil {
 				return nil, err
 			}
@@ -161,6 +262,13 @@ func (r *pipelineRunner) Run(ctx context.Context, opts *Options) (output.RunOutp
 		}
 		if state == pipelines.UpdateInfoStateCompleted {
 			log.Infof(ctx, "Update has completed successfully!")
+			if opts.Pipeline.ValidateOnly {
+				graph, err := r.datasetGraph(ctx, pipelineID, updateID)
+				if err != nil {
+					return nil, err
+				}
+				return graph, nil
+			}
 			return nil, nil
 		}
 
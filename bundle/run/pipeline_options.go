@@ -17,6 +17,9 @@ type PipelineOptions struct {
 	// List of tables to update.
 	Refresh []string
 
+	// Select the tables to update interactively.
+	RefreshSelect bool
+
 	// Perform a full graph reset and recompute.
 	FullRefreshAll bool
 
@@ -30,6 +33,7 @@ type PipelineOptions struct {
 func (o *PipelineOptions) Define(fs *flag.FlagSet) {
 	fs.BoolVar(&o.RefreshAll, "refresh-all", false, "Perform a full graph update.")
 	fs.StringSliceVar(&o.Refresh, "refresh", nil, "List of tables to update.")
+	fs.BoolVar(&o.RefreshSelect, "refresh-select", false, "Select the tables to update from those of the latest update.")
 	fs.BoolVar(&o.FullRefreshAll, "full-refresh-all", false, "Perform a full graph reset and recompute.")
 	fs.StringSliceVar(&o.FullRefresh, "full-refresh", nil, "List of tables to reset and recompute.")
 	fs.BoolVar(&o.ValidateOnly, "validate-only", false, "Perform an update to validate graph correctness.")
@@ -44,6 +48,9 @@ func (o *PipelineOptions) Validate(pipeline *resources.Pipeline) error {
 	if len(o.Refresh) > 0 {
 		set = append(set, "--refresh")
 	}
+	if o.RefreshSelect {
+		set = append(set, "--refresh-select")
+	}
 	if o.FullRefreshAll {
 		set = append(set, "--full-refresh-all")
 	}
@@ -54,6 +54,7 @@ func TestPipelineOptionsValidateSuccessWithSingleOption(t *testing.T) {
 	args := []string{
 		`--refresh-all`,
 		`--refresh=arg1,arg2,arg3`,
+		`--refresh-select`,
 		`--full-refresh-all`,
 		`--full-refresh=arg1,arg2,arg3`,
 		`--validate-only`,
@@ -71,6 +72,7 @@ func TestPipelineOptionsValidateFailureWithMultipleOptions(t *testing.T) {
 	args := []string{
 		`--refresh-all`,
 		`--refresh=arg1,arg2,arg3`,
+		`--refresh-select`,
 		`--full-refresh-all`,
 		`--full-refresh=arg1,arg2,arg3`,
 		`--validate-only`,
@@ -88,3 +90,18 @@ func TestPipelineOptionsValidateFailureWithMultipleOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestPipelineOptionsRefreshSelect(t *testing.T) {
+	fs, opts := setupPipelineOptions(t)
+	err := fs.Parse([]string{`--refresh-select`})
+	require.NoError(t, err)
+	assert.True(t, opts.RefreshSelect)
+}
+
+func TestPipelineOptionsRefreshSeparatedBySpace(t *testing.T) {
+	fs, opts := setupPipelineOptions(t)
+	err := fs.Parse([]string{`--refresh`, `arg1,arg2`, `--refresh`, `arg3`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"arg1", "arg2", "arg3"}, opts.Refresh)
+	assert.Empty(t, fs.Args())
+}
@@ -50,3 +50,25 @@ func (event *JobRunUrlEvent) String() string {
 func (event *JobRunUrlEvent) IsInplaceSupported() bool {
 	return false
 }
+
+type TaskLogEvent struct {
+	TaskKey string `json:"task_key"`
+	Stream  string `json:"stream"`
+	Line    string `json:"line"`
+}
+
+func NewTaskLogEvent(taskKey, stream, line string) *TaskLogEvent {
+	return &TaskLogEvent{
+		TaskKey: taskKey,
+		Stream:  stream,
+		Line:    line,
+	}
+}
+
+func (event *TaskLogEvent) String() string {
+	return fmt.Sprintf("[%s %s] %s", event.TaskKey, event.Stream, event.Line)
+}
+
+func (event *TaskLogEvent) IsInplaceSupported() bool {
+	return false
+}
@@ -22,3 +22,8 @@ func TestJobProgressEventString(t *testing.T) {
 	}
 	assert.Equal(t, "-0001-11-30 00:00:00 \"run_name\" TERMINATED SUCCESS state_message", event.String())
 }
+
+func TestTaskLogEventString(t *testing.T) {
+	event := NewTaskLogEvent("my_task", "stdout", "hello world")
+	assert.Equal(t, "[my_task stdout] hello world", event.String())
+}
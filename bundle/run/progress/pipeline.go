@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 )
@@ -43,17 +44,51 @@ func (event *ProgressEvent) IsInplaceSupported() bool {
 	return false
 }
 
+// FlowProgressEvent is a `flow_progress` event with the progress of the dataset
+// the flow writes to: the number of rows written and the results of its expectations.
+type FlowProgressEvent struct {
+	ProgressEvent
+	Progress *FlowProgress `json:"progress,omitempty"`
+}
+
+func (event *FlowProgressEvent) String() string {
+	result := strings.Builder{}
+	result.WriteString(event.ProgressEvent.String())
+	if event.Progress == nil {
+		return result.String()
+	}
+
+	if m := event.Progress.Metrics; m != nil && m.NumOutputRows != nil {
+		result.WriteString(fmt.Sprintf(" (%d rows written", *m.NumOutputRows))
+		if dq := event.Progress.DataQuality; dq != nil && dq.DroppedRecords > 0 {
+			result.WriteString(fmt.Sprintf(", %d dropped", dq.DroppedRecords))
+		}
+		result.WriteString(")")
+	}
+
+	if dq := event.Progress.DataQuality; dq != nil {
+		for _, e := range dq.Expectations {
+			result.WriteString(fmt.Sprintf("\n  expectation %s on %s: %d passed, %d failed", e.Name, e.Dataset, e.PassedRecords, e.FailedRecords))
+		}
+	}
+	return result.String()
+}
+
+func (event *FlowProgressEvent) IsInplaceSupported() bool {
+	return false
+}
+
 // TODO: Add inplace logging to pipelines. https://github.com/databricks/cli/issues/280
 type UpdateTracker struct {
 	UpdateId             string
 	PipelineId           string
 	LatestEventTimestamp string
-	w                    *databricks.WorkspaceClient
+	events               *eventLister
 }
 
 func NewUpdateTracker(pipelineId string, updateId string, w *databricks.WorkspaceClient) *UpdateTracker {
 	return &UpdateTracker{
-		w:                    w,
+		events:               &eventLister{w: w},
 		PipelineId:           pipelineId,
 		UpdateId:             updateId,
 		LatestEventTimestamp: "",
@@ -70,7 +105,9 @@ func NewUpdateTracker(pipelineId string, updateId string, w *databricks.Workspac
 // # If a user needs the complete logs, they can always visit the run URL
 //
 // NOTE: Incase we want inplace logging, then we will need to implement pagination
-func (l *UpdateTracker) Events(ctx context.Context) ([]ProgressEvent, error) {
+//
+// The events of flows include the progress of the dataset they write to.
+func (l *UpdateTracker) Events(ctx context.Context) ([]cmdio.Event, error) {
 	// create filter to fetch only new events
 	filter := fmt.Sprintf(`update_id = '%s'`, l.UpdateId)
 	if l.LatestEventTimestamp != "" {
@@ -78,7 +115,7 @@ func (l *UpdateTracker) Events(ctx context.Context) ([]ProgressEvent, error) {
 	}
 
 	// we only check the most recent 100 events for progress
-	events, err := l.w.Pipelines.ListPipelineEventsAll(ctx, pipelines.ListPipelineEventsRequest{
+	events, _, err := l.events.list(ctx, pipelines.ListPipelineEventsRequest{
 		PipelineId: l.PipelineId,
 		MaxResults: 100,
 		Filter:     filter,
@@ -87,19 +124,26 @@ func (l *UpdateTracker) Events(ctx context.Context) ([]ProgressEvent, error) {
 		return nil, err
 	}
 
-	result := make([]ProgressEvent, 0)
+	result := make([]cmdio.Event, 0)
 	// we iterate in reverse to return events in chronological order
 	for i := len(events) - 1; i >= 0; i-- {
 		event := events[i]
 		// filter to only include update_progress and flow_progress events
-		if event.EventType == "flow_progress" || event.EventType == "update_progress" {
-			result = append(result, ProgressEvent(event))
+		switch event.EventType {
+		case "update_progress":
+			progressEvent := ProgressEvent(event.PipelineEvent)
+			result = append(result, &progressEvent)
+		case "flow_progress":
+			result = append(result, &FlowProgressEvent{
+				ProgressEvent: ProgressEvent(event.PipelineEvent),
+				Progress:      event.Details.FlowProgress,
+			})
+		default:
+			continue
 		}
-	}
 
-	// update latest event timestamp for next time
-	if len(result) > 0 {
-		l.LatestEventTimestamp = result[len(result)-1].Timestamp
+		// update latest event timestamp for next time
+		l.LatestEventTimestamp = event.Timestamp
 	}
 
 	return result, nil
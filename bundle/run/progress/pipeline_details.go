@@ -0,0 +1,148 @@
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/client"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
+
+// FlowDefinition are the details of a `flow_definition` event. These events are
+// emitted when the graph of the pipeline is resolved at the start of an update.
+type FlowDefinition struct {
+	OutputDataset string   `json:"output_dataset"`
+	InputDatasets []string `json:"input_datasets,omitempty"`
+	FlowType      string   `json:"flow_type,omitempty"`
+}
+
+// FlowProgress are the details of a `flow_progress` event.
+type FlowProgress struct {
+	Status      string       `json:"status"`
+	Metrics     *FlowMetrics `json:"metrics,omitempty"`
+	DataQuality *DataQuality `json:"data_quality,omitempty"`
+}
+
+type FlowMetrics struct {
+	NumOutputRows *int64 `json:"num_output_rows,omitempty"`
+}
+
+type DataQuality struct {
+	DroppedRecords int64         `json:"dropped_records,omitempty"`
+	Expectations   []Expectation `json:"expectations,omitempty"`
+}
+
+type Expectation struct {
+	Name          string `json:"name"`
+	Dataset       string `json:"dataset"`
+	PassedRecords int64  `json:"passed_records"`
+	FailedRecords int64  `json:"failed_records"`
+}
+
+type eventDetails struct {
+	FlowDefinition *FlowDefinition `json:"flow_definition,omitempty"`
+	FlowProgress   *FlowProgress   `json:"flow_progress,omitempty"`
+}
+
+// pipelineEvent is a pipeline event including its details.
+type pipelineEvent struct {
+	pipelines.PipelineEvent
+	Details eventDetails
+}
+
+// eventLister lists pipeline events including their details, which are not
+// exposed by [pipelines.PipelineEvent]. The requests are made directly.
+type eventLister struct {
+	w         *databricks.WorkspaceClient
+	apiClient *client.DatabricksClient
+}
+
+func (l *eventLister) list(ctx context.Context, req pipelines.ListPipelineEventsRequest) ([]pipelineEvent, string, error) {
+	if l.apiClient == nil {
+		apiClient, err := client.New(l.w.Config)
+		if err != nil {
+			return nil, "", err
+		}
+		l.apiClient = apiClient
+	}
+
+	var resp struct {
+		Events        []json.RawMessage `json:"events"`
+		NextPageToken string            `json:"next_page_token"`
+	}
+	path := fmt.Sprintf("/api/2.0/pipelines/%s/events", req.PipelineId)
+	headers := map[string]string{"Accept": "application/json"}
+	err := l.apiClient.Do(ctx, http.MethodGet, path, headers, req, &resp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	events := make([]pipelineEvent, 0, len(resp.Events))
+	for _, raw := range resp.Events {
+		var event pipelineEvent
+		err = json.Unmarshal(raw, &event.PipelineEvent)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var details struct {
+			Details eventDetails `json:"details"`
+		}
+		err = json.Unmarshal(raw, &details)
+		if err != nil {
+			return nil, "", err
+		}
+
+		event.Details = details.Details
+		events = append(events, event)
+	}
+	return events, resp.NextPageToken, nil
+}
+
+// ListFlowDefinitions returns the definitions of the flows of a pipeline update,
+// which describe its dataset graph, in the order they were resolved.
+func ListFlowDefinitions(ctx context.Context, w *databricks.WorkspaceClient, pipelineId, updateId string) ([]FlowDefinition, error) {
+	l := &eventLister{w: w}
+	req := pipelines.ListPipelineEventsRequest{
+		PipelineId: pipelineId,
+		MaxResults: 250,
+		Filter:     fmt.Sprintf(`update_id = '%s'`, updateId),
+	}
+
+	var out []FlowDefinition
+	seen := make(map[string]bool)
+	for {
+		events, next, err := l.list(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range events {
+			def := event.Details.FlowDefinition
+			if event.EventType != "flow_definition" || def == nil || seen[def.OutputDataset] {
+				continue
+			}
+			seen[def.OutputDataset] = true
+			out = append(out, *def)
+		}
+
+		if next == "" {
+			break
+		}
+
+		// The page token cannot be combined with a filter.
+		req = pipelines.ListPipelineEventsRequest{
+			PipelineId: pipelineId,
+			MaxResults: req.MaxResults,
+			PageToken:  next,
+		}
+	}
+
+	// The events API returns most recent events first.
+	slices.Reverse(out)
+	return out, nil
+}
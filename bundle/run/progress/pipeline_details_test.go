@@ -0,0 +1,124 @@
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorkspaceClient(t *testing.T, handler http.HandlerFunc) *databricks.WorkspaceClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	w, err := databricks.NewWorkspaceClient(&databricks.Config{
+		Host:  server.URL,
+		Token: "token",
+	})
+	require.NoError(t, err)
+	return w
+}
+
+func TestUpdateTrackerEventsIncludeFlowProgress(t *testing.T) {
+	w := newTestWorkspaceClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/2.0/pipelines/p1/events", r.URL.Path)
+		assert.Equal(t, "update_id = 'u1'", r.URL.Query().Get("filter"))
+
+		// Most recent events first.
+		json.NewEncoder(rw).Encode(map[string]any{
+			"events": []any{
+				map[string]any{
+					"event_type": "flow_progress",
+					"timestamp":  "2023-03-27T23:30:38.000Z",
+					"level":      "INFO",
+					"message":    "Flow 'sales' has COMPLETED.",
+					"details": map[string]any{
+						"flow_progress": map[string]any{
+							"status":  "COMPLETED",
+							"metrics": map[string]any{"num_output_rows": 10},
+						},
+					},
+				},
+				map[string]any{
+					"event_type": "cluster_resources",
+					"timestamp":  "2023-03-27T23:30:37.000Z",
+					"level":      "INFO",
+				},
+				map[string]any{
+					"event_type": "update_progress",
+					"timestamp":  "2023-03-27T23:30:36.000Z",
+					"level":      "INFO",
+					"message":    "Update is RUNNING.",
+				},
+			},
+		})
+	})
+
+	tracker := NewUpdateTracker("p1", "u1", w)
+	events, err := tracker.Events(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.IsType(t, &ProgressEvent{}, events[0])
+	assert.Equal(t, `2023-03-27T23:30:36.000Z update_progress INFO "Update is RUNNING."`, events[0].String())
+
+	require.IsType(t, &FlowProgressEvent{}, events[1])
+	assert.Equal(t, `2023-03-27T23:30:38.000Z flow_progress   INFO "Flow 'sales' has COMPLETED." (10 rows written)`, events[1].String())
+	assert.Equal(t, "2023-03-27T23:30:38.000Z", tracker.LatestEventTimestamp)
+}
+
+func TestListFlowDefinitions(t *testing.T) {
+	w := newTestWorkspaceClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("page_token") {
+		case "":
+			assert.Equal(t, "update_id = 'u1'", q.Get("filter"))
+			json.NewEncoder(rw).Encode(map[string]any{
+				"events": []any{
+					map[string]any{
+						"event_type": "flow_definition",
+						"details": map[string]any{
+							"flow_definition": map[string]any{
+								"output_dataset": "sales_clean",
+								"input_datasets": []string{"sales_raw"},
+								"flow_type":      "COMPLETE",
+							},
+						},
+					},
+					map[string]any{
+						"event_type": "update_progress",
+					},
+				},
+				"next_page_token": "next",
+			})
+		case "next":
+			// The filter cannot be combined with a page token.
+			assert.Empty(t, q.Get("filter"))
+			json.NewEncoder(rw).Encode(map[string]any{
+				"events": []any{
+					map[string]any{
+						"event_type": "flow_definition",
+						"details": map[string]any{
+							"flow_definition": map[string]any{
+								"output_dataset": "sales_raw",
+								"flow_type":      "APPEND",
+							},
+						},
+					},
+				},
+			})
+		}
+	})
+
+	defs, err := ListFlowDefinitions(context.Background(), w, "p1", "u1")
+	require.NoError(t, err)
+	assert.Equal(t, []FlowDefinition{
+		{OutputDataset: "sales_raw", FlowType: "APPEND"},
+		{OutputDataset: "sales_clean", InputDatasets: []string{"sales_raw"}, FlowType: "COMPLETE"},
+	}, defs)
+}
@@ -76,3 +76,40 @@ func TestUpdateErrorIgnoredForWarnEvents(t *testing.T) {
 	}
 	assert.Equal(t, "2023-03-27T23:30:36.122Z update_progress WARN \"failed to update pipeline\"", event.String())
 }
+
+func TestFlowProgressEventWithDatasetProgressToString(t *testing.T) {
+	rows := int64(1234)
+	event := FlowProgressEvent{
+		ProgressEvent: ProgressEvent{
+			EventType: "flow_progress",
+			Message:   "Flow 'sales' has COMPLETED.",
+			Level:     pipelines.EventLevelInfo,
+			Timestamp: "2023-03-27T23:30:36.122Z",
+		},
+		Progress: &FlowProgress{
+			Status:  "COMPLETED",
+			Metrics: &FlowMetrics{NumOutputRows: &rows},
+			DataQuality: &DataQuality{
+				DroppedRecords: 2,
+				Expectations: []Expectation{
+					{Name: "valid_id", Dataset: "sales", PassedRecords: 1234, FailedRecords: 2},
+				},
+			},
+		},
+	}
+	assert.Equal(t, `2023-03-27T23:30:36.122Z flow_progress   INFO "Flow 'sales' has COMPLETED." (1234 rows written, 2 dropped)
+  expectation valid_id on sales: 1234 passed, 2 failed`, event.String())
+}
+
+func TestFlowProgressEventWithoutDatasetProgressToString(t *testing.T) {
+	event := FlowProgressEvent{
+		ProgressEvent: ProgressEvent{
+			EventType: "flow_progress",
+			Message:   "Flow 'sales' is QUEUED.",
+			Level:     pipelines.EventLevelInfo,
+			Timestamp: "2023-03-27T23:30:36.122Z",
+		},
+		Progress: &FlowProgress{Status: "QUEUED"},
+	}
+	assert.Equal(t, `2023-03-27T23:30:36.122Z flow_progress   INFO "Flow 'sales' is QUEUED."`, event.String())
+}
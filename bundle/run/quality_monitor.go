@@ -0,0 +1,128 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+// Interval between requests for the state of a quality monitor refresh.
+var qualityMonitorPollInterval = 10 * time.Second
+
+type qualityMonitorRunner struct {
+	key
+	nopArgsHandler
+
+	bundle  *bundle.Bundle
+	monitor *resources.QualityMonitor
+}
+
+func (r *qualityMonitorRunner) Name() string {
+	if r.monitor == nil || r.monitor.CreateMonitor == nil {
+		return ""
+	}
+	return r.monitor.CreateMonitor.TableName
+}
+
+func isRefreshDone(state catalog.MonitorRefreshInfoState) bool {
+	switch state {
+	case catalog.MonitorRefreshInfoStateSuccess,
+		catalog.MonitorRefreshInfoStateFailed,
+		catalog.MonitorRefreshInfoStateCanceled:
+		return true
+	}
+	return false
+}
+
+func (r *qualityMonitorRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	tableName := r.monitor.ID
+	if tableName == "" {
+		return nil, fmt.Errorf("quality monitor %s has not been deployed", r.Key())
+	}
+
+	// Include resource key in logger.
+	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+	w := r.bundle.WorkspaceClient()
+
+	refresh, err := w.QualityMonitors.RunRefresh(ctx, catalog.RunRefreshRequest{
+		TableName: tableName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Started refresh %d of the quality monitor for %s", refresh.RefreshId, tableName))
+	if opts.NoWait {
+		return nil, nil
+	}
+
+	// Poll refresh for completion and post status.
+	// Note: there is no "RunRefreshAndWait" wrapper for this API.
+	prevState := refresh.State
+	for !isRefreshDone(refresh.State) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(qualityMonitorPollInterval):
+		}
+
+		refresh, err = w.QualityMonitors.GetRefresh(ctx, catalog.GetRefreshRequest{
+			TableName: tableName,
+			RefreshId: strconv.FormatInt(refresh.RefreshId, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Log only if the current state is different from the previous state.
+		if refresh.State != prevState {
+			log.Infof(ctx, "Refresh status: %s", refresh.State)
+			prevState = refresh.State
+		}
+	}
+
+	if refresh.State != catalog.MonitorRefreshInfoStateSuccess {
+		return nil, fmt.Errorf("refresh %d of the quality monitor for %s finished with state %s: %s", refresh.RefreshId, tableName, refresh.State, refresh.Message)
+	}
+
+	return &output.QualityMonitorOutput{
+		TableName: tableName,
+		RefreshId: refresh.RefreshId,
+		State:     refresh.State,
+		Message:   refresh.Message,
+	}, nil
+}
+
+func (r *qualityMonitorRunner) Cancel(ctx context.Context) error {
+	w := r.bundle.WorkspaceClient()
+	resp, err := w.QualityMonitors.ListRefreshes(ctx, catalog.ListRefreshesRequest{
+		TableName: r.monitor.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, refresh := range resp.Refreshes {
+		if isRefreshDone(refresh.State) {
+			continue
+		}
+
+		err := w.QualityMonitors.CancelRefresh(ctx, catalog.CancelRefreshRequest{
+			TableName: r.monitor.ID,
+			RefreshId: strconv.FormatInt(refresh.RefreshId, 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
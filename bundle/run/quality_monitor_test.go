@@ -0,0 +1,132 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newQualityMonitorRunner(t *testing.T) (*qualityMonitorRunner, *mocks.MockWorkspaceClient) {
+	monitor := &resources.QualityMonitor{
+		ID: "main.default.table",
+		CreateMonitor: &catalog.CreateMonitor{
+			TableName: "main.default.table",
+		},
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				QualityMonitors: map[string]*resources.QualityMonitor{
+					"my_monitor": monitor,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	return &qualityMonitorRunner{key: "quality_monitors.my_monitor", bundle: b, monitor: monitor}, m
+}
+
+func TestQualityMonitorRunnerRun(t *testing.T) {
+	qualityMonitorPollInterval = 0
+	runner, m := newQualityMonitorRunner(t)
+	ctx := context.Background()
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().RunRefresh(mock.Anything, catalog.RunRefreshRequest{
+		TableName: "main.default.table",
+	}).Return(&catalog.MonitorRefreshInfo{
+		RefreshId: 42,
+		State:     catalog.MonitorRefreshInfoStatePending,
+	}, nil)
+	api.EXPECT().GetRefresh(mock.Anything, catalog.GetRefreshRequest{
+		TableName: "main.default.table",
+		RefreshId: "42",
+	}).Return(&catalog.MonitorRefreshInfo{
+		RefreshId: 42,
+		State:     catalog.MonitorRefreshInfoStateRunning,
+	}, nil).Once()
+	api.EXPECT().GetRefresh(mock.Anything, catalog.GetRefreshRequest{
+		TableName: "main.default.table",
+		RefreshId: "42",
+	}).Return(&catalog.MonitorRefreshInfo{
+		RefreshId: 42,
+		State:     catalog.MonitorRefreshInfoStateSuccess,
+	}, nil).Once()
+
+	out, err := runner.Run(ctx, &Options{})
+	require.NoError(t, err)
+	assert.Equal(t, &output.QualityMonitorOutput{
+		TableName: "main.default.table",
+		RefreshId: 42,
+		State:     catalog.MonitorRefreshInfoStateSuccess,
+	}, out)
+}
+
+func TestQualityMonitorRunnerRunFailed(t *testing.T) {
+	qualityMonitorPollInterval = 0
+	runner, m := newQualityMonitorRunner(t)
+	ctx := context.Background()
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().RunRefresh(mock.Anything, catalog.RunRefreshRequest{
+		TableName: "main.default.table",
+	}).Return(&catalog.MonitorRefreshInfo{
+		RefreshId: 42,
+		State:     catalog.MonitorRefreshInfoStateFailed,
+		Message:   "table not found",
+	}, nil)
+
+	_, err := runner.Run(ctx, &Options{})
+	assert.EqualError(t, err, "refresh 42 of the quality monitor for main.default.table finished with state FAILED: table not found")
+}
+
+func TestQualityMonitorRunnerRunNoWait(t *testing.T) {
+	runner, m := newQualityMonitorRunner(t)
+	ctx := context.Background()
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().RunRefresh(mock.Anything, catalog.RunRefreshRequest{
+		TableName: "main.default.table",
+	}).Return(&catalog.MonitorRefreshInfo{
+		RefreshId: 42,
+		State:     catalog.MonitorRefreshInfoStatePending,
+	}, nil)
+
+	out, err := runner.Run(ctx, &Options{NoWait: true})
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestQualityMonitorRunnerCancel(t *testing.T) {
+	runner, m := newQualityMonitorRunner(t)
+	ctx := context.Background()
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().ListRefreshes(ctx, catalog.ListRefreshesRequest{
+		TableName: "main.default.table",
+	}).Return(&catalog.MonitorRefreshListResponse{
+		Refreshes: []catalog.MonitorRefreshInfo{
+			{RefreshId: 1, State: catalog.MonitorRefreshInfoStateSuccess},
+			{RefreshId: 2, State: catalog.MonitorRefreshInfoStateRunning},
+		},
+	}, nil)
+	api.EXPECT().CancelRefresh(ctx, catalog.CancelRefreshRequest{
+		TableName: "main.default.table",
+		RefreshId: "2",
+	}).Return(nil)
+
+	err := runner.Cancel(ctx)
+	require.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package run
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/databricks/cli/bundle"
@@ -37,11 +38,16 @@ type Runner interface {
 // Find locates a runner matching the specified argument.
 //
 // Its behavior is as follows:
-//  1. Try to find a resource with <key> identical to the argument.
-//  2. Try to find a resource with <type>.<key> identical to the argument.
+//  1. If the argument is the path of a SQL file, return a runner for that file.
+//  2. Try to find a resource with <key> identical to the argument.
+//  3. Try to find a resource with <type>.<key> identical to the argument.
 //
 // If an argument resolves to multiple resources, it returns an error.
 func Find(b *bundle.Bundle, arg string) (Runner, error) {
+	if strings.EqualFold(filepath.Ext(arg), ".sql") {
+		return newSqlFileRunner(b, arg)
+	}
+
 	keyOnly, keyWithType := ResourceKeys(b)
 	if len(keyWithType) == 0 {
 		return nil, fmt.Errorf("bundle defines no resources")
@@ -82,3 +82,26 @@ func TestFindSingleArgWithType(t *testing.T) {
 	_, err := Find(b, "jobs.key")
 	assert.NoError(t, err)
 }
+
+func TestFindServingEndpointAndQualityMonitor(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+					"endpoint": {},
+				},
+				QualityMonitors: map[string]*resources.QualityMonitor{
+					"monitor": {},
+				},
+			},
+		},
+	}
+
+	runner, err := Find(b, "endpoint")
+	assert.NoError(t, err)
+	assert.Equal(t, "model_serving_endpoints.endpoint", runner.Key())
+
+	runner, err = Find(b, "quality_monitors.monitor")
+	assert.NoError(t, err)
+	assert.Equal(t, "quality_monitors.monitor", runner.Key())
+}
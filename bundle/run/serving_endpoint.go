@@ -0,0 +1,91 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+)
+
+// Maximum time to wait for the configuration of an endpoint to be updated.
+var servingEndpointTimeout = time.Hour
+
+type servingEndpointRunner struct {
+	key
+	nopArgsHandler
+
+	bundle   *bundle.Bundle
+	endpoint *resources.ModelServingEndpoint
+}
+
+func (r *servingEndpointRunner) Name() string {
+	if r.endpoint == nil || r.endpoint.CreateServingEndpoint == nil {
+		return ""
+	}
+	return r.endpoint.CreateServingEndpoint.Name
+}
+
+func (r *servingEndpointRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	name := r.endpoint.ID
+	if name == "" {
+		return nil, fmt.Errorf("model serving endpoint %s has not been deployed", r.Key())
+	}
+
+	// Read the payload before waiting, so that mistakes are reported immediately.
+	payload, err := opts.ServingEndpoint.toPayload(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Include resource key in logger.
+	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+	w := r.bundle.WorkspaceClient()
+
+	var endpoint *serving.ServingEndpointDetailed
+	if opts.NoWait {
+		// Without waiting, the query is served by the active configuration.
+		endpoint, err = w.ServingEndpoints.GetByName(ctx, name)
+	} else {
+		cmdio.LogString(ctx, fmt.Sprintf("Waiting for the configuration of model serving endpoint %s to be updated", name))
+		endpoint, err = w.ServingEndpoints.WaitGetServingEndpointNotUpdating(ctx, name, servingEndpointTimeout, func(e *serving.ServingEndpointDetailed) {
+			if e.State != nil {
+				log.Infof(ctx, "Endpoint status: %s", e.State.ConfigUpdate)
+			}
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.State == nil || endpoint.State.Ready != serving.EndpointStateReadyReady {
+		if opts.NoWait && payload == nil {
+			cmdio.LogString(ctx, fmt.Sprintf("Model serving endpoint %s is not ready yet", name))
+			return nil, nil
+		}
+		return nil, fmt.Errorf("model serving endpoint %s is not ready", name)
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Model serving endpoint %s is ready", name))
+	if payload == nil {
+		return nil, nil
+	}
+
+	resp, err := w.ServingEndpoints.Query(ctx, *payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query model serving endpoint %s: %w", name, err)
+	}
+
+	return &output.ServingEndpointOutput{Response: resp}, nil
+}
+
+func (r *servingEndpointRunner) Cancel(ctx context.Context) error {
+	// Updates of the configuration of an endpoint cannot be cancelled,
+	// and queries complete before [servingEndpointRunner.Run] returns.
+	return nil
+}
@@ -0,0 +1,41 @@
+package run
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	flag "github.com/spf13/pflag"
+)
+
+// ServingEndpointOptions defines options for running a model serving endpoint.
+type ServingEndpointOptions struct {
+	// Path to a JSON file with the payload of a test query.
+	Payload string
+}
+
+func (o *ServingEndpointOptions) Define(fs *flag.FlagSet) {
+	fs.StringVar(&o.Payload, "payload", "", "Path to a JSON file with a query to send to the endpoint once it is ready.")
+}
+
+// toPayload returns the query to send to the endpoint, or nil if no payload is specified.
+func (o *ServingEndpointOptions) toPayload(name string) (*serving.QueryEndpointInput, error) {
+	if o.Payload == "" {
+		return nil, nil
+	}
+
+	buf, err := os.ReadFile(o.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+
+	var payload serving.QueryEndpointInput
+	err = json.Unmarshal(buf, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payload %s: %w", o.Payload, err)
+	}
+
+	payload.Name = name
+	return &payload, nil
+}
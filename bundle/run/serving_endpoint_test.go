@@ -0,0 +1,116 @@
+package run
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newServingEndpointRunner(t *testing.T) (*servingEndpointRunner, *mocks.MockWorkspaceClient) {
+	endpoint := &resources.ModelServingEndpoint{
+		ID: "my-endpoint",
+		CreateServingEndpoint: &serving.CreateServingEndpoint{
+			Name: "my-endpoint",
+		},
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+					"my_endpoint": endpoint,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	return &servingEndpointRunner{key: "model_serving_endpoints.my_endpoint", bundle: b, endpoint: endpoint}, m
+}
+
+func TestServingEndpointRunnerRunWithPayload(t *testing.T) {
+	runner, m := newServingEndpointRunner(t)
+
+	payload := filepath.Join(t.TempDir(), "payload.json")
+	require.NoError(t, os.WriteFile(payload, []byte(`{"dataframe_records": [{"x": 1}]}`), 0644))
+
+	api := m.GetMockServingEndpointsAPI()
+	api.EXPECT().WaitGetServingEndpointNotUpdating(mock.Anything, "my-endpoint", servingEndpointTimeout, mock.Anything).Return(&serving.ServingEndpointDetailed{
+		Name: "my-endpoint",
+		State: &serving.EndpointState{
+			ConfigUpdate: serving.EndpointStateConfigUpdateNotUpdating,
+			Ready:        serving.EndpointStateReadyReady,
+		},
+	}, nil)
+	api.EXPECT().Query(mock.Anything, serving.QueryEndpointInput{
+		Name:             "my-endpoint",
+		DataframeRecords: []any{map[string]any{"x": float64(1)}},
+	}).Return(&serving.QueryEndpointResponse{
+		Predictions: []any{float64(2)},
+	}, nil)
+
+	opts := &Options{ServingEndpoint: ServingEndpointOptions{Payload: payload}}
+	out, err := runner.Run(context.Background(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, &output.ServingEndpointOutput{
+		Response: &serving.QueryEndpointResponse{
+			Predictions: []any{float64(2)},
+		},
+	}, out)
+}
+
+func TestServingEndpointRunnerRunNotReady(t *testing.T) {
+	runner, m := newServingEndpointRunner(t)
+
+	api := m.GetMockServingEndpointsAPI()
+	api.EXPECT().WaitGetServingEndpointNotUpdating(mock.Anything, "my-endpoint", servingEndpointTimeout, mock.Anything).Return(&serving.ServingEndpointDetailed{
+		Name: "my-endpoint",
+		State: &serving.EndpointState{
+			ConfigUpdate: serving.EndpointStateConfigUpdateNotUpdating,
+			Ready:        serving.EndpointStateReadyNotReady,
+		},
+	}, nil)
+
+	_, err := runner.Run(context.Background(), &Options{})
+	assert.EqualError(t, err, "model serving endpoint my-endpoint is not ready")
+}
+
+func TestServingEndpointRunnerRunNoWait(t *testing.T) {
+	runner, m := newServingEndpointRunner(t)
+
+	api := m.GetMockServingEndpointsAPI()
+	api.EXPECT().GetByName(mock.Anything, "my-endpoint").Return(&serving.ServingEndpointDetailed{
+		Name: "my-endpoint",
+		State: &serving.EndpointState{
+			ConfigUpdate: serving.EndpointStateConfigUpdateInProgress,
+			Ready:        serving.EndpointStateReadyNotReady,
+		},
+	}, nil)
+
+	out, err := runner.Run(context.Background(), &Options{NoWait: true})
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestServingEndpointRunnerRunInvalidPayload(t *testing.T) {
+	runner, _ := newServingEndpointRunner(t)
+
+	payload := filepath.Join(t.TempDir(), "payload.json")
+	require.NoError(t, os.WriteFile(payload, []byte(`not json`), 0644))
+
+	opts := &Options{ServingEndpoint: ServingEndpointOptions{Payload: payload}}
+	_, err := runner.Run(context.Background(), opts)
+	assert.ErrorContains(t, err, "failed to parse payload "+payload)
+}
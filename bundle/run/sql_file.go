@@ -0,0 +1,297 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+// Interval between requests for the state of a SQL statement.
+var sqlStatementPollInterval = time.Second
+
+// sqlFileRunner runs the statements in a SQL file of the bundle on a SQL warehouse.
+// The statements are read from the local file, so the file doesn't have to be deployed.
+type sqlFileRunner struct {
+	key
+	nopArgsHandler
+
+	bundle *bundle.Bundle
+
+	// Absolute local path of the SQL file.
+	path string
+
+	// ID of the statement that is currently running, if any.
+	mu          sync.Mutex
+	statementId string
+}
+
+// newSqlFileRunner returns a runner for the SQL file at the specified path.
+// The file must be located in the bundle root directory.
+func newSqlFileRunner(b *bundle.Bundle, path string) (*sqlFileRunner, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(b.RootPath, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("SQL file %s is not in the bundle root %s", path, b.RootPath)
+	}
+
+	info, err := os.Stat(abs)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+
+	return &sqlFileRunner{
+		key:    key(filepath.ToSlash(rel)),
+		bundle: b,
+		path:   abs,
+	}, nil
+}
+
+func (r *sqlFileRunner) Name() string {
+	return filepath.Base(r.path)
+}
+
+func (r *sqlFileRunner) setStatementId(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statementId = id
+}
+
+func (r *sqlFileRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	warehouseId := opts.Sql.WarehouseId
+	if warehouseId == "" {
+		warehouseId = r.bundle.Config.Bundle.WarehouseID
+	}
+	if warehouseId == "" {
+		return nil, fmt.Errorf("running SQL file %s requires a SQL warehouse; set warehouse_id in the target or specify one with --warehouse-id", r.Key())
+	}
+
+	raw, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := splitSqlStatements(string(raw))
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("SQL file %s does not contain any statements", r.Key())
+	}
+	if opts.NoWait && len(statements) > 1 {
+		return nil, fmt.Errorf("--no-wait is only supported for SQL files with a single statement, %s has %d", r.Key(), len(statements))
+	}
+
+	// Include resource key in logger.
+	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+	w := r.bundle.WorkspaceClient()
+
+	var resp *sql.StatementResponse
+	for i, statement := range statements {
+		log.Infof(ctx, "Running statement %d of %d", i+1, len(statements))
+
+		req := sql.ExecuteStatementRequest{
+			Statement:     statement,
+			WarehouseId:   warehouseId,
+			WaitTimeout:   "30s",
+			OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+		}
+		if opts.NoWait {
+			req.WaitTimeout = "0s"
+		}
+
+		resp, err = w.StatementExecution.ExecuteStatement(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.NoWait {
+			cmdio.LogString(ctx, fmt.Sprintf("Started statement %s on SQL warehouse %s", resp.StatementId, warehouseId))
+			return nil, nil
+		}
+
+		resp, err = r.wait(ctx, w, resp)
+		if err != nil {
+			return nil, err
+		}
+
+		switch resp.Status.State {
+		case sql.StatementStateSucceeded:
+			continue
+		case sql.StatementStateFailed:
+			msg := ""
+			if resp.Status.Error != nil {
+				msg = resp.Status.Error.Message
+			}
+			return nil, fmt.Errorf("statement %d of %s failed: %s", i+1, r.Key(), msg)
+		default:
+			return nil, fmt.Errorf("statement %d of %s did not complete: %s", i+1, r.Key(), resp.Status.State)
+		}
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Ran %d statements from %s", len(statements), r.Key()))
+	return toSqlStatementOutput(resp), nil
+}
+
+// wait polls the statement until it has finished.
+func (r *sqlFileRunner) wait(ctx context.Context, w *databricks.WorkspaceClient, resp *sql.StatementResponse) (*sql.StatementResponse, error) {
+	r.setStatementId(resp.StatementId)
+	defer r.setStatementId("")
+
+	var err error
+	for isStatementRunning(resp) {
+		select {
+		case <-ctx.Done():
+			// Don't leave the statement running on the warehouse.
+			cancelErr := w.StatementExecution.CancelExecution(context.WithoutCancel(ctx), sql.CancelExecutionRequest{
+				StatementId: resp.StatementId,
+			})
+			if cancelErr != nil {
+				log.Warnf(ctx, "Failed to cancel statement %s: %s", resp.StatementId, cancelErr)
+			}
+			return nil, ctx.Err()
+		case <-time.After(sqlStatementPollInterval):
+		}
+
+		resp, err = w.StatementExecution.GetStatement(ctx, sql.GetStatementRequest{
+			StatementId: resp.StatementId,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func isStatementRunning(resp *sql.StatementResponse) bool {
+	if resp.Status == nil {
+		return true
+	}
+	return resp.Status.State == sql.StatementStatePending || resp.Status.State == sql.StatementStateRunning
+}
+
+func toSqlStatementOutput(resp *sql.StatementResponse) *output.SqlStatementOutput {
+	out := &output.SqlStatementOutput{
+		StatementId: resp.StatementId,
+	}
+
+	if resp.Manifest != nil {
+		if resp.Manifest.Schema != nil {
+			for _, c := range resp.Manifest.Schema.Columns {
+				out.Columns = append(out.Columns, c.Name)
+			}
+		}
+
+		// Only the first chunk of the result is included.
+		out.Truncated = resp.Manifest.Truncated || resp.Manifest.TotalChunkCount > 1
+	}
+
+	if resp.Result != nil {
+		out.Rows = resp.Result.DataArray
+	}
+
+	return out
+}
+
+func (r *sqlFileRunner) Cancel(ctx context.Context) error {
+	r.mu.Lock()
+	id := r.statementId
+	r.mu.Unlock()
+
+	// Only statements started by this runner can be identified.
+	if id == "" {
+		return nil
+	}
+
+	w := r.bundle.WorkspaceClient()
+	return w.StatementExecution.CancelExecution(ctx, sql.CancelExecutionRequest{
+		StatementId: id,
+	})
+}
+
+// splitSqlStatements splits the contents of a SQL file into its statements.
+// Semicolons in quoted strings, quoted identifiers and comments don't end a statement.
+// Statements that consist only of comments are dropped.
+func splitSqlStatements(s string) []string {
+	var out []string
+	var cur strings.Builder
+	hasContent := false
+
+	flush := func() {
+		if hasContent {
+			out = append(out, strings.TrimSpace(cur.String()))
+		}
+		cur.Reset()
+		hasContent = false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ';':
+			flush()
+			continue
+
+		case c == '\'' || c == '"' || c == '`':
+			// Copy the quoted section including its quotes.
+			// Backslash escapes the next character.
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j = min(j, len(s)-1)
+			cur.WriteString(s[i : j+1])
+			hasContent = true
+			i = j
+			continue
+
+		case c == '-' && i+1 < len(s) && s[i+1] == '-':
+			j := strings.IndexByte(s[i:], '\n')
+			if j < 0 {
+				j = len(s) - i
+			}
+			cur.WriteString(s[i : i+j])
+			i += j - 1
+			continue
+
+		case c == '/' && i+1 < len(s) && s[i+1] == '*':
+			j := strings.Index(s[i+2:], "*/")
+			end := len(s)
+			if j >= 0 {
+				end = i + 2 + j + 2
+			}
+			cur.WriteString(s[i:end])
+			i = end - 1
+			continue
+		}
+
+		cur.WriteByte(c)
+		if !isSpace(c) {
+			hasContent = true
+		}
+	}
+
+	flush()
+	return out
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,198 @@
+package run
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSqlStatements(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		out  []string
+	}{
+		{
+			name: "empty",
+			in:   "",
+			out:  nil,
+		},
+		{
+			name: "single statement without semicolon",
+			in:   "SELECT 1",
+			out:  []string{"SELECT 1"},
+		},
+		{
+			name: "multiple statements",
+			in:   "SELECT 1;\nSELECT 2;\n",
+			out:  []string{"SELECT 1", "SELECT 2"},
+		},
+		{
+			name: "semicolons in quotes",
+			in:   `SELECT 'a;b', "c;d", ` + "`e;f`" + `; SELECT 'it\'s;'`,
+			out:  []string{`SELECT 'a;b', "c;d", ` + "`e;f`", `SELECT 'it\'s;'`},
+		},
+		{
+			name: "semicolons in comments",
+			in:   "SELECT 1 -- first; statement\n;\n/* second; */ SELECT 2",
+			out:  []string{"SELECT 1 -- first; statement", "/* second; */ SELECT 2"},
+		},
+		{
+			name: "comment only statements",
+			in:   "-- header\n;\n/* nothing */;\nSELECT 1;\n-- trailer\n",
+			out:  []string{"SELECT 1"},
+		},
+		{
+			name: "unterminated quote",
+			in:   "SELECT 'abc",
+			out:  []string{"SELECT 'abc"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.out, splitSqlStatements(tc.in))
+		})
+	}
+}
+
+func newSqlFileBundle(t *testing.T) *bundle.Bundle {
+	dir := t.TempDir()
+	return &bundle.Bundle{
+		RootPath: dir,
+		Config: config.Root{
+			Bundle: config.Bundle{
+				WarehouseID: "warehouse",
+			},
+		},
+	}
+}
+
+func TestFindSqlFile(t *testing.T) {
+	b := newSqlFileBundle(t)
+	testutil.WriteFile(t, "SELECT 1", b.RootPath, "queries", "query.SQL")
+
+	runner, err := Find(b, filepath.Join(b.RootPath, "queries", "query.SQL"))
+	require.NoError(t, err)
+	assert.Equal(t, "queries/query.SQL", runner.Key())
+	assert.Equal(t, "query.SQL", runner.Name())
+}
+
+func TestFindSqlFileOutsideBundleRoot(t *testing.T) {
+	b := newSqlFileBundle(t)
+	other := t.TempDir()
+	testutil.WriteFile(t, "SELECT 1", other, "query.sql")
+
+	_, err := Find(b, filepath.Join(other, "query.sql"))
+	assert.ErrorContains(t, err, "is not in the bundle root")
+}
+
+func TestSqlFileRunnerRunWithoutWarehouse(t *testing.T) {
+	b := newSqlFileBundle(t)
+	b.Config.Bundle.WarehouseID = ""
+	testutil.WriteFile(t, "SELECT 1", b.RootPath, "query.sql")
+
+	runner, err := newSqlFileRunner(b, filepath.Join(b.RootPath, "query.sql"))
+	require.NoError(t, err)
+
+	_, err = runner.Run(context.Background(), &Options{})
+	assert.ErrorContains(t, err, "running SQL file query.sql requires a SQL warehouse")
+}
+
+func TestSqlFileRunnerRunNoWaitMultipleStatements(t *testing.T) {
+	b := newSqlFileBundle(t)
+	testutil.WriteFile(t, "SELECT 1; SELECT 2", b.RootPath, "query.sql")
+
+	runner, err := newSqlFileRunner(b, filepath.Join(b.RootPath, "query.sql"))
+	require.NoError(t, err)
+
+	_, err = runner.Run(context.Background(), &Options{NoWait: true})
+	assert.EqualError(t, err, "--no-wait is only supported for SQL files with a single statement, query.sql has 2")
+}
+
+func TestSqlFileRunnerRun(t *testing.T) {
+	sqlStatementPollInterval = 0
+
+	b := newSqlFileBundle(t)
+	testutil.WriteFile(t, "CREATE TABLE t AS SELECT 1 AS x;\nSELECT x FROM t;\n", b.RootPath, "query.sql")
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	api := m.GetMockStatementExecutionAPI()
+	api.EXPECT().ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+		Statement:     "CREATE TABLE t AS SELECT 1 AS x",
+		WarehouseId:   "override",
+		WaitTimeout:   "30s",
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	}).Return(&sql.StatementResponse{
+		StatementId: "1",
+		Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+	}, nil)
+	api.EXPECT().ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+		Statement:     "SELECT x FROM t",
+		WarehouseId:   "override",
+		WaitTimeout:   "30s",
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	}).Return(&sql.StatementResponse{
+		StatementId: "2",
+		Status:      &sql.StatementStatus{State: sql.StatementStateRunning},
+	}, nil)
+	api.EXPECT().GetStatement(mock.Anything, sql.GetStatementRequest{
+		StatementId: "2",
+	}).Return(&sql.StatementResponse{
+		StatementId: "2",
+		Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+		Manifest: &sql.ResultManifest{
+			Schema: &sql.ResultSchema{
+				Columns: []sql.ColumnInfo{{Name: "x"}},
+			},
+			TotalChunkCount: 1,
+		},
+		Result: &sql.ResultData{
+			DataArray: [][]string{{"1"}},
+		},
+	}, nil)
+
+	runner, err := newSqlFileRunner(b, filepath.Join(b.RootPath, "query.sql"))
+	require.NoError(t, err)
+
+	out, err := runner.Run(context.Background(), &Options{Sql: SqlOptions{WarehouseId: "override"}})
+	require.NoError(t, err)
+	assert.Equal(t, &output.SqlStatementOutput{
+		StatementId: "2",
+		Columns:     []string{"x"},
+		Rows:        [][]string{{"1"}},
+	}, out)
+}
+
+func TestSqlFileRunnerRunFailed(t *testing.T) {
+	b := newSqlFileBundle(t)
+	testutil.WriteFile(t, "SELECT nope", b.RootPath, "query.sql")
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	api := m.GetMockStatementExecutionAPI()
+	api.EXPECT().ExecuteStatement(mock.Anything, mock.Anything).Return(&sql.StatementResponse{
+		StatementId: "1",
+		Status: &sql.StatementStatus{
+			State: sql.StatementStateFailed,
+			Error: &sql.ServiceError{Message: "column nope does not exist"},
+		},
+	}, nil)
+
+	runner, err := newSqlFileRunner(b, filepath.Join(b.RootPath, "query.sql"))
+	require.NoError(t, err)
+
+	_, err = runner.Run(context.Background(), &Options{})
+	assert.EqualError(t, err, "statement 1 of query.sql failed: column nope does not exist")
+}
@@ -0,0 +1,16 @@
+package run
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+// SqlOptions defines options for running a SQL file.
+type SqlOptions struct {
+	// ID of the SQL warehouse to run the file on.
+	// Defaults to the warehouse_id of the target.
+	WarehouseId string
+}
+
+func (o *SqlOptions) Define(fs *flag.FlagSet) {
+	fs.StringVar(&o.WarehouseId, "warehouse-id", "", "ID of the SQL warehouse to run SQL files on. Defaults to the warehouse_id of the target.")
+}
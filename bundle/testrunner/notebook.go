@@ -0,0 +1,149 @@
+package testrunner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/diag"
+	"golang.org/x/exp/maps"
+)
+
+// Name of the notebook that runs the tests, without extension.
+const notebookName = "bundle_test_runner"
+
+const notebookTemplate = `# Databricks notebook source
+%pip install --quiet pytest{{range .Libraries}} {{.}}{{end}}
+
+# COMMAND ----------
+
+dbutils.library.restartPython()
+
+# COMMAND ----------
+
+import json
+import os
+import sys
+
+import pytest
+
+root = dbutils.widgets.get("root")
+output_dir = dbutils.widgets.get("output_dir")
+args = json.loads(dbutils.widgets.get("args"))
+
+os.makedirs(output_dir, exist_ok=True)
+os.chdir(root)
+sys.path.insert(0, root)
+sys.dont_write_bytecode = True
+
+# Write the test output to a file so it can be streamed while the tests run.
+with open(os.path.join(output_dir, "output.txt"), "w", buffering=1) as out:
+    stdout, stderr = sys.stdout, sys.stderr
+    sys.stdout, sys.stderr = out, out
+    try:
+        exit_code = pytest.main([
+            "-p", "no:cacheprovider",
+            "--junitxml=" + os.path.join(output_dir, "junit.xml"),
+        ] + args)
+    finally:
+        sys.stdout, sys.stderr = stdout, stderr
+
+dbutils.notebook.exit(json.dumps({"exit_code": int(exit_code)}))
+`
+
+type generateNotebook struct{}
+
+// GenerateNotebook writes the notebook that runs the tests of the bundle to
+// the internal directory of the bundle, so that it is synced with the rest of
+// the bundle files. It expects the artifacts of the bundle to be uploaded,
+// so that the notebook can install them before running the tests.
+func GenerateNotebook() bundle.Mutator {
+	return &generateNotebook{}
+}
+
+func (m *generateNotebook) Name() string {
+	return "testrunner.GenerateNotebook"
+}
+
+func (m *generateNotebook) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	internalDir, err := b.InternalDir(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	f, err := os.Create(filepath.Join(internalDir, notebookName+".py"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer f.Close()
+
+	t, err := template.New(notebookName).Parse(notebookTemplate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = t.Execute(f, map[string]any{
+		"Libraries": wheelLibraries(b),
+	})
+	return diag.FromErr(err)
+}
+
+// wheelLibraries returns the workspace paths of the uploaded Python wheels.
+func wheelLibraries(b *bundle.Bundle) []string {
+	var out []string
+	names := maps.Keys(b.Config.Artifacts)
+	slices.Sort(names)
+	for _, name := range names {
+		a := b.Config.Artifacts[name]
+		if a.Type != config.ArtifactPythonWheel {
+			continue
+		}
+		for _, file := range a.Files {
+			if file.RemotePath != "" {
+				out = append(out, fusePath(file.RemotePath))
+			}
+		}
+	}
+	return out
+}
+
+// notebookPath returns the workspace path of the notebook generated by [GenerateNotebook].
+func notebookPath(ctx context.Context, b *bundle.Bundle) (string, error) {
+	internalDir, err := b.InternalDir(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(b.SyncRootPath, internalDir)
+	if err != nil {
+		return "", err
+	}
+
+	return path.Join(b.Config.Workspace.FilePath, filepath.ToSlash(rel), notebookName), nil
+}
+
+// rootPath returns the workspace path of the bundle root.
+func rootPath(b *bundle.Bundle) (string, error) {
+	rel, err := filepath.Rel(b.SyncRootPath, b.RootPath)
+	if err != nil {
+		return "", fmt.Errorf("bundle root is not located in the sync root: %w", err)
+	}
+
+	return path.Join(b.Config.Workspace.FilePath, filepath.ToSlash(rel)), nil
+}
+
+// fusePath returns the path at which a workspace file can be accessed from
+// the file system of the compute.
+func fusePath(p string) string {
+	if strings.HasPrefix(p, "/Workspace/") || strings.HasPrefix(p, "/Volumes/") {
+		return p
+	}
+	return path.Join("/Workspace", p)
+}
@@ -0,0 +1,70 @@
+package testrunner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNotebook(t *testing.T) {
+	tmpDir := t.TempDir()
+	b := &bundle.Bundle{
+		RootPath:     filepath.Join(tmpDir, "parent", "my_bundle"),
+		SyncRootPath: filepath.Join(tmpDir, "parent"),
+		Config: config.Root{
+			Workspace: config.Workspace{
+				FilePath: "/Workspace/files",
+			},
+			Bundle: config.Bundle{
+				Target: "development",
+			},
+			Artifacts: config.Artifacts{
+				"wheel": {
+					Type: config.ArtifactPythonWheel,
+					Files: []config.ArtifactFile{
+						{Source: "dist/a.whl", RemotePath: "/Workspace/artifacts/a.whl"},
+						{Source: "dist/b.whl", RemotePath: "/Users/foo@bar.com/b.whl"},
+					},
+				},
+				"jar": {
+					Type: "jar",
+					Files: []config.ArtifactFile{
+						{Source: "target/c.jar", RemotePath: "/Workspace/artifacts/c.jar"},
+					},
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	diags := bundle.Apply(ctx, b, GenerateNotebook())
+	require.NoError(t, diags.Error())
+
+	dir, err := b.InternalDir(ctx)
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join(dir, "bundle_test_runner.py"))
+	require.NoError(t, err)
+	assert.Contains(t, string(buf), "%pip install --quiet pytest /Workspace/artifacts/a.whl /Workspace/Users/foo@bar.com/b.whl\n")
+	assert.NotContains(t, string(buf), "c.jar")
+
+	notebook, err := notebookPath(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, "/Workspace/files/my_bundle/.databricks/bundle/development/.internal/bundle_test_runner", notebook)
+
+	root, err := rootPath(b)
+	require.NoError(t, err)
+	assert.Equal(t, "/Workspace/files/my_bundle", root)
+}
+
+func TestFusePath(t *testing.T) {
+	assert.Equal(t, "/Workspace/Users/foo@bar.com/a", fusePath("/Users/foo@bar.com/a"))
+	assert.Equal(t, "/Workspace/Users/foo@bar.com/a", fusePath("/Workspace/Users/foo@bar.com/a"))
+	assert.Equal(t, "/Volumes/main/default/v/a.whl", fusePath("/Volumes/main/default/v/a.whl"))
+}
@@ -0,0 +1,198 @@
+package testrunner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/google/uuid"
+)
+
+// Maximum duration of a test run.
+var testRunTimeout time.Duration = 24 * time.Hour
+
+// Options configures a test run.
+type Options struct {
+	// Arguments passed to pytest.
+	Args []string
+
+	// Local path the JUnit XML report is written to.
+	JUnitXMLPath string
+
+	// Writer the output of the tests is streamed to.
+	Output io.Writer
+}
+
+// Result is the result of a test run.
+type Result struct {
+	RunPageUrl string
+
+	// Exit code of pytest.
+	ExitCode int
+}
+
+// Passed returns true if all tests passed.
+func (r *Result) Passed() bool {
+	return r.ExitCode == 0
+}
+
+type notebookResult struct {
+	ExitCode int `json:"exit_code"`
+}
+
+// Run runs the tests of the bundle as a one-off job run and waits for it to
+// complete. It expects the files of the bundle and the notebook generated by
+// [GenerateNotebook] to be uploaded. The tests run on the compute specified by
+// the compute ID of the bundle, or on serverless compute if it is not set.
+func Run(ctx context.Context, b *bundle.Bundle, opts Options) (*Result, error) {
+	w := b.WorkspaceClient()
+
+	notebook, err := notebookPath(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := rootPath(b)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := json.Marshal(opts.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every run writes its output to a directory of its own.
+	outputRoot := path.Join(b.Config.Workspace.StatePath, "test")
+	runDir := uuid.New().String()
+	outputFiler, err := filer.NewWorkspaceFilesClient(w, outputRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		err := outputFiler.Delete(ctx, runDir, filer.DeleteRecursively)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Warnf(ctx, "Failed to remove test output: %v", err)
+		}
+	}()
+
+	task := jobs.SubmitTask{
+		TaskKey: "test",
+		NotebookTask: &jobs.NotebookTask{
+			NotebookPath: notebook,
+			BaseParameters: map[string]string{
+				"root":       fusePath(root),
+				"output_dir": fusePath(path.Join(outputRoot, runDir)),
+				"args":       string(args),
+			},
+		},
+	}
+	if computeID := b.Config.Bundle.ComputeID; computeID != "" {
+		task.ExistingClusterId = computeID
+	}
+
+	waiter, err := w.Jobs.Submit(ctx, jobs.SubmitRun{
+		RunName: fmt.Sprintf("%s tests", b.Config.Bundle.Name),
+		Tasks:   []jobs.SubmitTask{task},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot start test run: %w", err)
+	}
+
+	output := filer.NewTail(outputFiler, path.Join(runDir, "output.txt"), opts.Output)
+
+	var runPageUrl string
+	run, err := waiter.OnProgress(func(r *jobs.Run) {
+		if runPageUrl == "" && r.RunPageUrl != "" {
+			runPageUrl = r.RunPageUrl
+			log.Infof(ctx, "Test run available at %s", runPageUrl)
+		}
+		if err := output.Flush(ctx); err != nil {
+			log.Debugf(ctx, "Failed to read test output: %v", err)
+		}
+	}).GetWithTimeout(testRunTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	err = output.Flush(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test output: %w", err)
+	}
+
+	if run.State.ResultState != jobs.RunResultStateSuccess {
+		return nil, fmt.Errorf("test run %s: %s", run.State.ResultState, run.State.StateMessage)
+	}
+
+	result, err := getNotebookResult(ctx, b, run)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.JUnitXMLPath != "" {
+		err = download(ctx, outputFiler, path.Join(runDir, "junit.xml"), opts.JUnitXMLPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download JUnit XML report: %w", err)
+		}
+	}
+
+	return &Result{
+		RunPageUrl: run.RunPageUrl,
+		ExitCode:   result.ExitCode,
+	}, nil
+}
+
+func getNotebookResult(ctx context.Context, b *bundle.Bundle, run *jobs.Run) (*notebookResult, error) {
+	if len(run.Tasks) != 1 {
+		return nil, fmt.Errorf("expected test run to have a single task, got %d", len(run.Tasks))
+	}
+
+	out, err := b.WorkspaceClient().Jobs.GetRunOutputByRunId(ctx, run.Tasks[0].RunId)
+	if err != nil {
+		return nil, err
+	}
+	if out.NotebookOutput == nil {
+		return nil, fmt.Errorf("test run did not return a result")
+	}
+
+	var result notebookResult
+	err = json.Unmarshal([]byte(out.NotebookOutput.Result), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse result of test run: %w", err)
+	}
+	return &result, nil
+}
+
+func download(ctx context.Context, f filer.Filer, name, dst string) error {
+	r, err := f.Read(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	err = os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, r)
+	return err
+}
@@ -2,9 +2,6 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/flags"
@@ -136,17 +133,9 @@ func render(ctx context.Context, cmd *cobra.Command, status *authStatus, templat
 			"Status":           status,
 			"ConfigAttributes": config.ConfigAttributes,
 		}, "", template)
-	case flags.OutputJSON:
-		buf, err := json.MarshalIndent(status, "", "  ")
-		if err != nil {
-			return err
-		}
-		cmd.OutOrStdout().Write(buf)
 	default:
-		return fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+		return cmdio.Render(ctx, status)
 	}
-
-	return nil
 }
 
 type authStatus struct {
@@ -16,7 +16,9 @@ func New() *cobra.Command {
 	initVariableFlag(cmd)
 	cmd.AddCommand(newDeployCommand())
 	cmd.AddCommand(newDestroyCommand())
+	cmd.AddCommand(newDriftCommand())
 	cmd.AddCommand(newLaunchCommand())
+	cmd.AddCommand(newPlanCommand())
 	cmd.AddCommand(newRunCommand())
 	cmd.AddCommand(newSchemaCommand())
 	cmd.AddCommand(newSyncCommand())
@@ -1,9 +1,6 @@
 package debug
 
 import (
-	"encoding/json"
-	"fmt"
-
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
@@ -61,14 +58,8 @@ For more information about filesystem mirrors, see the Terraform documentation:
 		switch root.OutputType(cmd) {
 		case flags.OutputText:
 			cmdio.Render(cmd.Context(), dependencies.Terraform)
-		case flags.OutputJSON:
-			buf, err := json.MarshalIndent(dependencies, "", "  ")
-			if err != nil {
-				return err
-			}
-			cmd.OutOrStdout().Write(buf)
 		default:
-			return fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+			return cmdio.Render(cmd.Context(), dependencies)
 		}
 
 		return nil
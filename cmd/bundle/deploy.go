@@ -25,8 +25,10 @@ func newDeployCommand() *cobra.Command {
 	var failOnActiveRuns bool
 	var computeID string
 	var autoApprove bool
+	var lockTimeout string
 	cmd.Flags().BoolVar(&force, "force", false, "Force-override Git branch validation.")
 	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().StringVar(&lockTimeout, "lock-timeout", "", "Wait up to this duration for a deployment lock held by another deployment, for example 10m.")
 	cmd.Flags().BoolVar(&failOnActiveRuns, "fail-on-active-runs", false, "Fail if there are running jobs or pipelines in the deployment.")
 	cmd.Flags().StringVarP(&computeID, "compute-id", "c", "", "Override compute in the deployment with the given compute ID.")
 	cmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Skip interactive approvals that might be required for deployment.")
@@ -39,6 +41,9 @@ func newDeployCommand() *cobra.Command {
 			bundle.ApplyFunc(ctx, b, func(context.Context, *bundle.Bundle) diag.Diagnostics {
 				b.Config.Bundle.Force = force
 				b.Config.Bundle.Deployment.Lock.Force = forceLock
+				if cmd.Flag("lock-timeout").Changed {
+					b.Config.Bundle.Deployment.Lock.Timeout = lockTimeout
+				}
 				b.AutoApprove = autoApprove
 
 				if cmd.Flag("compute-id").Changed {
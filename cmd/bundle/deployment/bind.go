@@ -23,8 +23,10 @@ func newBindCommand() *cobra.Command {
 
 	var autoApprove bool
 	var forceLock bool
+	var lockTimeout string
 	cmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Automatically approve the binding")
 	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().StringVar(&lockTimeout, "lock-timeout", "", "Wait up to this duration for a deployment lock held by another deployment, for example 10m.")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -50,6 +52,9 @@ func newBindCommand() *cobra.Command {
 
 		bundle.ApplyFunc(ctx, b, func(context.Context, *bundle.Bundle) diag.Diagnostics {
 			b.Config.Bundle.Deployment.Lock.Force = forceLock
+			if cmd.Flag("lock-timeout").Changed {
+				b.Config.Bundle.Deployment.Lock.Timeout = lockTimeout
+			}
 			return nil
 		})
 
@@ -13,5 +13,6 @@ func NewDeploymentCommand() *cobra.Command {
 
 	cmd.AddCommand(newBindCommand())
 	cmd.AddCommand(newUnbindCommand())
+	cmd.AddCommand(newLockCommand())
 	return cmd
 }
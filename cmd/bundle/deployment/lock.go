@@ -0,0 +1,160 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/cli/libs/locker"
+	"github.com/spf13/cobra"
+)
+
+func newLockCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Inspect and release the deployment lock",
+		Long: `Inspect and release the deployment lock.
+
+A deployment lock is held while a bundle is deployed, destroyed, bound or
+unbound. A lock that has not been refreshed by its holder within its lease
+duration is stale, for example because the deployment was killed.`,
+	}
+
+	cmd.AddCommand(newLockStatusCommand())
+	cmd.AddCommand(newLockReleaseCommand())
+	return cmd
+}
+
+// configureLocker initializes the bundle and returns a locker for its state path.
+func configureLocker(cmd *cobra.Command) (*locker.Locker, error) {
+	ctx := cmd.Context()
+	b, diags := utils.ConfigureBundleWithVariables(cmd)
+	if err := diags.Error(); err != nil {
+		return nil, err
+	}
+
+	diags = bundle.Apply(ctx, b, phases.Initialize())
+	if err := diags.Error(); err != nil {
+		return nil, err
+	}
+
+	user := b.Config.Workspace.CurrentUser.UserName
+	return locker.CreateLocker(user, b.Config.Workspace.StatePath, b.WorkspaceClient())
+}
+
+type lockStatus struct {
+	Locked bool              `json:"locked"`
+	Stale  bool              `json:"stale"`
+	State  *locker.LockState `json:"state,omitempty"`
+}
+
+func renderLockStatus(ctx context.Context, status lockStatus) {
+	if !status.Locked {
+		cmdio.LogString(ctx, "No active deployment lock.")
+		return
+	}
+
+	s := status.State
+	cmdio.LogString(ctx, fmt.Sprintf("Deployment lock held by %s", s.User))
+	if s.Host != "" {
+		cmdio.LogString(ctx, fmt.Sprintf("  Host:      %s", s.Host))
+	}
+	if s.Command != "" {
+		cmdio.LogString(ctx, fmt.Sprintf("  Command:   %s", s.Command))
+	}
+	cmdio.LogString(ctx, fmt.Sprintf("  Acquired:  %s", s.AcquisitionTime.Format(time.RFC3339)))
+	if !s.RefreshTime.IsZero() {
+		cmdio.LogString(ctx, fmt.Sprintf("  Refreshed: %s", s.RefreshTime.Format(time.RFC3339)))
+	}
+	if !s.ExpiryTime.IsZero() {
+		cmdio.LogString(ctx, fmt.Sprintf("  Expires:   %s", s.ExpiryTime.Format(time.RFC3339)))
+	}
+	if s.IsForced {
+		cmdio.LogString(ctx, "  The lock was acquired forcefully.")
+	}
+	if status.Stale {
+		cmdio.LogString(ctx, "The lock is stale and can be released with 'databricks bundle deployment lock release'.")
+	}
+}
+
+func newLockStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the holder of the deployment lock",
+		Args:  root.NoArgs,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		l, err := configureLocker(cmd)
+		if err != nil {
+			return err
+		}
+
+		var status lockStatus
+		state, err := l.GetActiveLockState(ctx)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err == nil {
+			status = lockStatus{
+				Locked: true,
+				Stale:  state.IsStale(time.Now()),
+				State:  state,
+			}
+		}
+
+		switch root.OutputType(cmd) {
+		case flags.OutputText:
+			renderLockStatus(ctx, status)
+		default:
+			return cmdio.Render(ctx, status)
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+func newLockReleaseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Release a stale deployment lock",
+		Long: `Release a stale deployment lock.
+
+Only locks that have not been refreshed within their lease duration are
+released, unless --force is specified. Releasing a lock that is still held
+by a running deployment breaks the exclusive access it relies on.`,
+		Args: root.NoArgs,
+	}
+
+	var force bool
+	cmd.Flags().BoolVar(&force, "force", false, "Release the lock even if it is not stale.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		l, err := configureLocker(cmd)
+		if err != nil {
+			return err
+		}
+
+		err = l.Release(ctx, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, "Released deployment lock.")
+		return nil
+	}
+
+	return cmd
+}
@@ -25,8 +25,10 @@ func newDestroyCommand() *cobra.Command {
 
 	var autoApprove bool
 	var forceDestroy bool
+	var lockTimeout string
 	cmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Skip interactive approvals for deleting resources and files")
 	cmd.Flags().BoolVar(&forceDestroy, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().StringVar(&lockTimeout, "lock-timeout", "", "Wait up to this duration for a deployment lock held by another deployment, for example 10m.")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -38,6 +40,9 @@ func newDestroyCommand() *cobra.Command {
 		bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 			// If `--force-lock` is specified, force acquisition of the deployment lock.
 			b.Config.Bundle.Deployment.Lock.Force = forceDestroy
+			if cmd.Flag("lock-timeout").Changed {
+				b.Config.Bundle.Deployment.Lock.Timeout = lockTimeout
+			}
 
 			// If `--auto-approve`` is specified, we skip confirmation checks
 			b.AutoApprove = autoApprove
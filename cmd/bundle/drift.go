@@ -0,0 +1,90 @@
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/drift"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/render"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/spf13/cobra"
+)
+
+func newDriftCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Show changes made to deployed resources outside of the bundle",
+		Long: `Show changes made to deployed resources outside of the bundle.
+
+Fetches every deployed bundle resource from the workspace and lists the
+fields whose value differs from the bundle configuration, for example
+because the resource was edited in the UI. The next "bundle deploy" will
+overwrite these changes.
+
+Exits with a non-zero exit code if drift is detected.`,
+		Args: root.NoArgs,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := utils.ConfigureBundleWithVariables(cmd)
+
+		if !diags.HasError() {
+			diags = diags.Extend(
+				bundle.Apply(ctx, b, bundle.Seq(
+					phases.Initialize(),
+					phases.Drift(),
+				)),
+			)
+		}
+
+		var resources []drift.ResourceDrift
+		if !diags.HasError() {
+			var err error
+			resources, err = drift.Detect(ctx, b)
+			diags = diags.Extend(diag.FromErr(err))
+		}
+
+		if diags.HasError() {
+			renderOpts := render.RenderOptions{RenderSummaryTable: false}
+			err := render.RenderTextOutput(cmd.ErrOrStderr(), b, diags, renderOpts)
+			if err != nil {
+				return fmt.Errorf("failed to render output: %w", err)
+			}
+			return root.ErrAlreadyPrinted
+		}
+
+		switch root.OutputType(cmd) {
+		case flags.OutputText:
+			err := render.RenderTextOutput(cmd.ErrOrStderr(), b, diags, render.RenderOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to render output: %w", err)
+			}
+			err = render.RenderDrift(cmd.OutOrStdout(), resources)
+			if err != nil {
+				return err
+			}
+		default:
+			if resources == nil {
+				resources = []drift.ResourceDrift{}
+			}
+			err := cmdio.Render(ctx, map[string]any{"drift": resources})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Signal drift through the exit code such that it can be detected in scripts.
+		if len(resources) > 0 {
+			return root.ErrAlreadyPrinted
+		}
+		return nil
+	}
+
+	return cmd
+}
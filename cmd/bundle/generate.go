@@ -11,11 +11,33 @@ func newGenerateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate bundle configuration",
-		Long:  "Generate bundle configuration",
+		Long: `Generate bundle configuration for existing resources in the workspace.
+
+Use one of the subcommands to generate configuration for a single resource.
+Use --from-folder, --from-tag or --from-user to generate configuration for all
+jobs and pipelines with source files in a workspace folder, for example the home
+folder of a user, with a tag, or that were created by or run as a user. If more
+than one of these flags is specified, resources must match all of them. Their
+notebooks and files are downloaded to the source directory. Files that are used
+by more than one resource are downloaded once. Dashboards are not generated.
+Use --bind to bind the generated resources to the existing resources, so that
+they are managed by the bundle from the next deployment.
+
+The IDs of clusters, cluster policies, instance pools and SQL warehouses in the
+generated configuration are replaced with variables that look up these objects
+by name, and paths in the home folder of the current user refer to
+${workspace.current_user.userName}, so that the configuration can be deployed
+to other workspaces and targets.`,
 	}
 
 	cmd.AddCommand(generate.NewGenerateJobCommand())
 	cmd.AddCommand(generate.NewGeneratePipelineCommand())
+	cmd.AddCommand(generate.NewGenerateModelServingEndpointCommand())
+	cmd.AddCommand(generate.NewGenerateExperimentCommand())
+	cmd.AddCommand(generate.NewGenerateRegisteredModelCommand())
+	cmd.AddCommand(generate.NewGenerateSchemaCommand())
+	cmd.AddCommand(generate.NewGenerateQualityMonitorCommand())
+	generate.AddBulkGenerate(cmd)
 	cmd.PersistentFlags().StringVar(&key, "key", "", `resource key to use for the generated configuration`)
 	return cmd
 }
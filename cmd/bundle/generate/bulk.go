@@ -0,0 +1,419 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Style of the nested fields of jobs and pipelines which are map[string]string type.
+var bulkStyle = map[string]yaml.Style{
+	"spark_conf":    yaml.DoubleQuotedStyle,
+	"custom_tags":   yaml.DoubleQuotedStyle,
+	"tags":          yaml.DoubleQuotedStyle,
+	"configuration": yaml.DoubleQuotedStyle,
+}
+
+// resourceFilter selects the jobs and pipelines to generate configuration for.
+// All conditions that are set must match.
+type resourceFilter struct {
+	// Workspace folder that contains the source files of the resource.
+	folder string
+
+	// Tag that is set on the resource. If the value is empty, only the key must match.
+	tagKey   string
+	tagValue string
+
+	// User that created the resource or that the resource runs as.
+	user string
+}
+
+func newResourceFilter(folder string, tag string, user string) resourceFilter {
+	f := resourceFilter{user: user}
+	if folder != "" {
+		f.folder = trimWorkspacePrefix(path.Clean(folder))
+	}
+	f.tagKey, f.tagValue, _ = strings.Cut(tag, "=")
+	return f
+}
+
+// trimWorkspacePrefix removes the /Workspace prefix from a workspace path,
+// so that paths with and without the prefix can be compared.
+func trimWorkspacePrefix(p string) string {
+	if p == "/Workspace" {
+		return "/"
+	}
+	if strings.HasPrefix(p, "/Workspace/") {
+		return strings.TrimPrefix(p, "/Workspace")
+	}
+	return p
+}
+
+func (f resourceFilter) matchesPaths(paths []string) bool {
+	if f.folder == "" {
+		return true
+	}
+
+	for _, p := range paths {
+		p = trimWorkspacePrefix(path.Clean(p))
+		if f.folder == "/" || p == f.folder || strings.HasPrefix(p, f.folder+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (f resourceFilter) matchesTags(tags ...map[string]string) bool {
+	if f.tagKey == "" {
+		return true
+	}
+
+	for _, t := range tags {
+		v, ok := t[f.tagKey]
+		if ok && (f.tagValue == "" || v == f.tagValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesOwner returns true if the creator of the resource or the user it runs as is the user of the filter.
+func (f resourceFilter) matchesOwner(creator string, runAs string) bool {
+	if f.user == "" {
+		return true
+	}
+	return strings.EqualFold(creator, f.user) || strings.EqualFold(runAs, f.user)
+}
+
+func (f resourceFilter) matchesJob(settings *jobs.JobSettings) bool {
+	if settings == nil {
+		return false
+	}
+
+	var paths []string
+	for _, task := range settings.Tasks {
+		// Files in a Git repository are not located in a workspace folder.
+		if task.NotebookTask != nil && task.NotebookTask.Source != jobs.SourceGit {
+			paths = append(paths, task.NotebookTask.NotebookPath)
+		}
+		if task.SparkPythonTask != nil && task.SparkPythonTask.Source != jobs.SourceGit {
+			paths = append(paths, task.SparkPythonTask.PythonFile)
+		}
+	}
+
+	return f.matchesPaths(paths) && f.matchesTags(settings.Tags)
+}
+
+func (f resourceFilter) matchesPipeline(spec *pipelines.PipelineSpec) bool {
+	if spec == nil {
+		return false
+	}
+
+	var paths []string
+	for _, lib := range spec.Libraries {
+		if lib.Notebook != nil {
+			paths = append(paths, lib.Notebook.Path)
+		}
+		if lib.File != nil {
+			paths = append(paths, lib.File.Path)
+		}
+	}
+
+	// Pipelines don't have tags of their own, so we match the tags of their clusters.
+	var tags []map[string]string
+	for _, c := range spec.Clusters {
+		tags = append(tags, c.CustomTags)
+	}
+
+	return f.matchesPaths(paths) && f.matchesTags(tags...)
+}
+
+// findJobs returns the jobs that match the filter and are not deployed by a bundle.
+func findJobs(ctx context.Context, w *databricks.WorkspaceClient, filter resourceFilter) ([]*jobs.Job, error) {
+	all, err := w.Jobs.ListAll(ctx, jobs.ListJobsRequest{ExpandTasks: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*jobs.Job
+	for _, j := range all {
+		if !filter.matchesJob(j.Settings) {
+			continue
+		}
+
+		if j.Settings.Deployment != nil && j.Settings.Deployment.Kind == jobs.JobDeploymentKindBundle {
+			log.Infof(ctx, "Skipping job %d because it is deployed by a bundle", j.JobId)
+			continue
+		}
+
+		// The listing doesn't include all settings of a job, nor the user it runs as.
+		job, err := w.Jobs.Get(ctx, jobs.GetJobRequest{JobId: j.JobId})
+		if err != nil {
+			return nil, err
+		}
+
+		if !filter.matchesOwner(job.CreatorUserName, job.RunAsUserName) {
+			continue
+		}
+		out = append(out, job)
+	}
+
+	return out, nil
+}
+
+// findPipelines returns the pipelines that match the filter and are not deployed by a bundle.
+func findPipelines(ctx context.Context, w *databricks.WorkspaceClient, filter resourceFilter) ([]*pipelines.GetPipelineResponse, error) {
+	all, err := w.Pipelines.ListPipelinesAll(ctx, pipelines.ListPipelinesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*pipelines.GetPipelineResponse
+	for _, p := range all {
+		// The listing doesn't include the specification of a pipeline.
+		pipeline, err := w.Pipelines.Get(ctx, pipelines.GetPipelineRequest{PipelineId: p.PipelineId})
+		if err != nil {
+			return nil, err
+		}
+
+		if !filter.matchesPipeline(pipeline.Spec) || !filter.matchesOwner(pipeline.CreatorUserName, pipeline.RunAsUserName) {
+			continue
+		}
+
+		if pipeline.Spec.Deployment != nil && pipeline.Spec.Deployment.Kind == pipelines.DeploymentKindBundle {
+			log.Infof(ctx, "Skipping pipeline %s because it is deployed by a bundle", p.PipelineId)
+			continue
+		}
+
+		out = append(out, pipeline)
+	}
+
+	return out, nil
+}
+
+type generatedResource struct {
+	group string
+	key   string
+	id    string
+	value dyn.Value
+}
+
+// uniqueKey returns the normalized name, with a numeric suffix if the key is already in use.
+func uniqueKey(used map[string]bool, name string) string {
+	base := textutil.NormalizeString(name)
+	key := base
+	for i := 2; used[key]; i++ {
+		key = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[key] = true
+	return key
+}
+
+type bulkOptions struct {
+	fromFolder  string
+	fromTag     string
+	fromUser    string
+	configDir   string
+	sourceDir   string
+	force       bool
+	bind        bool
+	autoApprove bool
+}
+
+// AddBulkGenerate configures the "bundle generate" command to generate configuration
+// for all jobs and pipelines in a workspace folder, with a tag or owned by a user.
+func AddBulkGenerate(cmd *cobra.Command) {
+	var opts bulkOptions
+
+	wd, err := os.Getwd()
+	if err != nil {
+		wd = "."
+	}
+
+	cmd.Flags().StringVar(&opts.fromFolder, "from-folder", "", `Generate config for all jobs and pipelines with source files in this workspace folder`)
+	cmd.Flags().StringVar(&opts.fromTag, "from-tag", "", `Generate config for all jobs and pipelines with this tag, specified as KEY or KEY=VALUE`)
+	cmd.Flags().StringVar(&opts.fromUser, "from-user", "", `Generate config for all jobs and pipelines created by or running as this user`)
+	cmd.Flags().StringVarP(&opts.configDir, "config-dir", "d", filepath.Join(wd, "resources"), `Dir path where the output config will be stored`)
+	cmd.Flags().StringVarP(&opts.sourceDir, "source-dir", "s", filepath.Join(wd, "src"), `Dir path where the downloaded files will be stored`)
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, `Force overwrite existing files in the output directory`)
+	cmd.Flags().BoolVar(&opts.bind, "bind", false, `Bind the generated resources to the existing resources in the workspace`)
+	cmd.Flags().BoolVar(&opts.autoApprove, "auto-approve", false, `Automatically approve the binding`)
+
+	cmd.Args = root.NoArgs
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if opts.fromFolder == "" && opts.fromTag == "" && opts.fromUser == "" {
+			return cmd.Help()
+		}
+		if opts.autoApprove && !opts.bind {
+			return fmt.Errorf("--auto-approve requires --bind")
+		}
+		return opts.run(cmd)
+	}
+}
+
+func (opts *bulkOptions) run(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	b, diags := root.MustConfigureBundle(cmd)
+	if err := diags.Error(); err != nil {
+		return diags.Error()
+	}
+
+	w := b.WorkspaceClient()
+	g, err := newVariableGenerator(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	filter := newResourceFilter(opts.fromFolder, opts.fromTag, opts.fromUser)
+	foundJobs, err := findJobs(ctx, w, filter)
+	if err != nil {
+		return err
+	}
+	foundPipelines, err := findPipelines(ctx, w, filter)
+	if err != nil {
+		return err
+	}
+
+	if len(foundJobs) == 0 && len(foundPipelines) == 0 {
+		cmdio.LogString(ctx, "No jobs or pipelines found")
+		return nil
+	}
+
+	// Keys of the resources that are already defined in the bundle are not reused.
+	used := make(map[string]bool)
+	for k := range b.Config.Resources.Jobs {
+		used[k] = true
+	}
+	for k := range b.Config.Resources.Pipelines {
+		used[k] = true
+	}
+
+	var generated []generatedResource
+	downloader := newDownloader(w, opts.sourceDir, opts.configDir)
+	for _, job := range foundJobs {
+		for i := range job.Settings.Tasks {
+			err := downloader.MarkTaskForDownload(ctx, &job.Settings.Tasks[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		v, err := generate.ConvertJobToValue(job)
+		if err != nil {
+			return err
+		}
+
+		generated = append(generated, generatedResource{
+			group: "jobs",
+			key:   uniqueKey(used, job.Settings.Name),
+			id:    fmt.Sprint(job.JobId),
+			value: v,
+		})
+	}
+
+	for _, pipeline := range foundPipelines {
+		for i := range pipeline.Spec.Libraries {
+			err := downloader.MarkPipelineLibraryForDownload(ctx, &pipeline.Spec.Libraries[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		v, err := generate.ConvertPipelineToValue(pipeline.Spec)
+		if err != nil {
+			return err
+		}
+
+		generated = append(generated, generatedResource{
+			group: "pipelines",
+			key:   uniqueKey(used, pipeline.Name),
+			id:    pipeline.PipelineId,
+			value: v,
+		})
+	}
+
+	// Check that all configuration files can be written before writing any file.
+	if !opts.force {
+		for _, r := range generated {
+			filename := filepath.Join(opts.configDir, fmt.Sprintf("%s.yml", r.key))
+			if _, err := os.Stat(filename); err == nil {
+				return fmt.Errorf("%s already exists. Use --force to overwrite", filename)
+			}
+		}
+	}
+
+	err = downloader.FlushToDisk(ctx, opts.force)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range generated {
+		filename, err := saveResourceConfig(ctx, g, opts.configDir, r.group, r.key, r.value, bulkStyle, opts.force)
+		if err != nil {
+			return err
+		}
+		cmdio.LogString(ctx, fmt.Sprintf("Configuration of %s %s successfully saved to %s", strings.TrimSuffix(r.group, "s"), r.id, filename))
+	}
+
+	if !opts.bind {
+		return nil
+	}
+
+	return bindGenerated(cmd, generated, opts.autoApprove)
+}
+
+// bindGenerated binds the generated resources to the existing resources in the workspace.
+func bindGenerated(cmd *cobra.Command, generated []generatedResource, autoApprove bool) error {
+	ctx := cmd.Context()
+
+	// Load the bundle again to include the generated configuration.
+	b, diags := root.MustConfigureBundle(cmd)
+	if err := diags.Error(); err != nil {
+		return err
+	}
+
+	diags = bundle.Apply(ctx, b, phases.Initialize())
+	if err := diags.Error(); err != nil {
+		return err
+	}
+
+	for _, r := range generated {
+		resource, err := b.Config.Resources.FindResourceByConfigKey(r.key)
+		if err != nil {
+			return fmt.Errorf("failed to bind %s, make sure that the bundle includes the generated configuration: %w", r.key, err)
+		}
+
+		diags = bundle.Apply(ctx, b, phases.Bind(&terraform.BindOptions{
+			AutoApprove:  autoApprove,
+			ResourceType: resource.TerraformResourceName(),
+			ResourceKey:  r.key,
+			ResourceId:   r.id,
+		}))
+		if err := diags.Error(); err != nil {
+			return fmt.Errorf("failed to bind %s, err: %w", r.key, err)
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Successfully bound %s with an id '%s'", resource.TerraformResourceName(), r.id))
+	}
+
+	cmdio.LogString(ctx, "Run 'bundle deploy' to deploy changes to your workspace")
+	return nil
+}
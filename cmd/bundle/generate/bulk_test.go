@@ -0,0 +1,234 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFilterMatchesJob(t *testing.T) {
+	settings := &jobs.JobSettings{
+		Tags: map[string]string{"team": "data"},
+		Tasks: []jobs.Task{
+			{NotebookTask: &jobs.NotebookTask{NotebookPath: "/Workspace/Users/user@example.com/project/notebook"}},
+			{SparkPythonTask: &jobs.SparkPythonTask{PythonFile: "src/main.py", Source: jobs.SourceGit}},
+		},
+	}
+
+	assert.True(t, newResourceFilter("/Users/user@example.com", "", "").matchesJob(settings))
+	assert.True(t, newResourceFilter("/Workspace/Users/user@example.com/", "", "").matchesJob(settings))
+	assert.False(t, newResourceFilter("/Users/user@example", "", "").matchesJob(settings))
+	assert.True(t, newResourceFilter("", "team", "").matchesJob(settings))
+	assert.True(t, newResourceFilter("", "team=data", "").matchesJob(settings))
+	assert.False(t, newResourceFilter("", "team=ml", "").matchesJob(settings))
+	assert.False(t, newResourceFilter("/Users/user@example.com", "owner", "").matchesJob(settings))
+	assert.False(t, newResourceFilter("", "team", "").matchesJob(nil))
+}
+
+func TestResourceFilterMatchesPipeline(t *testing.T) {
+	spec := &pipelines.PipelineSpec{
+		Clusters: []pipelines.PipelineCluster{
+			{CustomTags: map[string]string{"team": "data"}},
+		},
+		Libraries: []pipelines.PipelineLibrary{
+			{File: &pipelines.FileLibrary{Path: "/Shared/pipelines/file.py"}},
+		},
+	}
+
+	assert.True(t, newResourceFilter("/Shared/pipelines", "team=data", "").matchesPipeline(spec))
+	assert.False(t, newResourceFilter("/Shared/jobs", "", "").matchesPipeline(spec))
+	assert.False(t, newResourceFilter("", "team=ml", "").matchesPipeline(spec))
+}
+
+func TestResourceFilterMatchesOwner(t *testing.T) {
+	assert.True(t, newResourceFilter("", "", "").matchesOwner("creator@example.com", "runner@example.com"))
+	assert.True(t, newResourceFilter("", "", "creator@example.com").matchesOwner("creator@example.com", "runner@example.com"))
+	assert.True(t, newResourceFilter("", "", "Runner@example.com").matchesOwner("creator@example.com", "runner@example.com"))
+	assert.False(t, newResourceFilter("", "", "other@example.com").matchesOwner("creator@example.com", "runner@example.com"))
+}
+
+func TestDownloaderDeduplicatesFiles(t *testing.T) {
+	m := mocks.NewMockWorkspaceClient(t)
+	workspaceApi := m.GetMockWorkspaceAPI()
+	for _, p := range []string{"/a/notebook", "/b/notebook"} {
+		workspaceApi.EXPECT().GetStatusByPath(mock.Anything, p).Return(&workspace.ObjectInfo{
+			ObjectType: workspace.ObjectTypeNotebook,
+			Language:   workspace.LanguagePython,
+			Path:       p,
+		}, nil)
+	}
+
+	root := t.TempDir()
+	d := newDownloader(m.WorkspaceClient, filepath.Join(root, "src"), filepath.Join(root, "resources"))
+
+	paths := []string{"/a/notebook", "/b/notebook", "/a/notebook"}
+	for i := range paths {
+		err := d.markNotebookForDownload(context.Background(), &paths[i])
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{
+		filepath.Join("..", "src", "notebook.py"),
+		filepath.Join("..", "src", "notebook_2.py"),
+		filepath.Join("..", "src", "notebook.py"),
+	}, paths)
+	assert.Len(t, d.files, 2)
+}
+
+func TestUniqueKey(t *testing.T) {
+	used := map[string]bool{"my_job": true}
+	assert.Equal(t, "my_job_2", uniqueKey(used, "my job"))
+	assert.Equal(t, "my_job_3", uniqueKey(used, "my-job"))
+	assert.Equal(t, "other", uniqueKey(used, "other"))
+}
+
+func TestBulkGenerateRequiresBindForAutoApprove(t *testing.T) {
+	cmd := &cobra.Command{}
+	AddBulkGenerate(cmd)
+	cmd.Flag("from-tag").Value.Set("team")
+	cmd.Flag("auto-approve").Value.Set("true")
+
+	err := cmd.RunE(cmd, []string{})
+	assert.ErrorContains(t, err, "--auto-approve requires --bind")
+}
+
+func TestBulkGenerateFromFolder(t *testing.T) {
+	cmd := &cobra.Command{}
+	AddBulkGenerate(cmd)
+
+	root := t.TempDir()
+	b := &bundle.Bundle{
+		RootPath: root,
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
+
+	// The paths of the tasks are updated in place, so every job gets its own task.
+	notebookTask := func() jobs.Task {
+		return jobs.Task{
+			TaskKey: "notebook_task",
+			NotebookTask: &jobs.NotebookTask{
+				NotebookPath: "/Users/user@example.com/notebook",
+			},
+		}
+	}
+
+	jobsApi := m.GetMockJobsAPI()
+	jobsApi.EXPECT().ListAll(mock.Anything, jobs.ListJobsRequest{ExpandTasks: true}).Return([]jobs.BaseJob{
+		{JobId: 1, Settings: &jobs.JobSettings{Name: "job", Tasks: []jobs.Task{notebookTask()}}},
+		{JobId: 2, Settings: &jobs.JobSettings{Name: "job", Tasks: []jobs.Task{notebookTask()}}},
+		{JobId: 3, Settings: &jobs.JobSettings{
+			Name:       "deployed",
+			Tasks:      []jobs.Task{notebookTask()},
+			Deployment: &jobs.JobDeployment{Kind: jobs.JobDeploymentKindBundle},
+		}},
+		{JobId: 4, Settings: &jobs.JobSettings{Name: "other", Tasks: []jobs.Task{
+			{TaskKey: "task", NotebookTask: &jobs.NotebookTask{NotebookPath: "/Shared/notebook"}},
+		}}},
+	}, nil)
+	for _, id := range []int64{1, 2} {
+		jobsApi.EXPECT().Get(mock.Anything, jobs.GetJobRequest{JobId: id}).Return(&jobs.Job{
+			JobId: id,
+			Settings: &jobs.JobSettings{
+				Name: "job",
+				JobClusters: []jobs.JobCluster{
+					{NewCluster: compute.ClusterSpec{
+						CustomTags: map[string]string{"Tag1": fmt.Sprint(id)},
+					}},
+				},
+				Tasks: []jobs.Task{notebookTask()},
+			},
+		}, nil)
+	}
+
+	pipelinesApi := m.GetMockPipelinesAPI()
+	pipelinesApi.EXPECT().ListPipelinesAll(mock.Anything, pipelines.ListPipelinesRequest{}).Return([]pipelines.PipelineStateInfo{
+		{PipelineId: "p1"},
+	}, nil)
+	pipelinesApi.EXPECT().Get(mock.Anything, pipelines.GetPipelineRequest{PipelineId: "p1"}).Return(&pipelines.GetPipelineResponse{
+		PipelineId: "p1",
+		Name:       "pipeline",
+		Spec: &pipelines.PipelineSpec{
+			Name: "pipeline",
+			Libraries: []pipelines.PipelineLibrary{
+				{Notebook: &pipelines.NotebookLibrary{Path: "/Users/user@example.com/notebook"}},
+			},
+		},
+	}, nil)
+
+	workspaceApi := m.GetMockWorkspaceAPI()
+	workspaceApi.EXPECT().GetStatusByPath(mock.Anything, "/Users/user@example.com/notebook").Return(&workspace.ObjectInfo{
+		ObjectType: workspace.ObjectTypeNotebook,
+		Language:   workspace.LanguagePython,
+		Path:       "/Users/user@example.com/notebook",
+	}, nil)
+
+	// The notebook is shared by all resources and downloaded once.
+	notebookContent := io.NopCloser(bytes.NewBufferString("# Databricks notebook source\nNotebook content"))
+	workspaceApi.EXPECT().Download(mock.Anything, "/Users/user@example.com/notebook", mock.Anything).Return(notebookContent, nil).Once()
+
+	cmd.SetContext(bundle.Context(context.Background(), b))
+	cmd.Flag("from-folder").Value.Set("/Workspace/Users/user@example.com")
+
+	configDir := filepath.Join(root, "resources")
+	cmd.Flag("config-dir").Value.Set(configDir)
+
+	srcDir := filepath.Join(root, "src")
+	cmd.Flag("source-dir").Value.Set(srcDir)
+
+	err := cmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(configDir, "job_2.yml"))
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`resources:
+  jobs:
+    job_2:
+      name: job
+      job_clusters:
+        - new_cluster:
+            custom_tags:
+              "Tag1": "2"
+      tasks:
+        - task_key: notebook_task
+          notebook_task:
+            notebook_path: %s
+`, filepath.Join("..", "src", "notebook.py")), string(data))
+
+	data, err = os.ReadFile(filepath.Join(configDir, "pipeline.yml"))
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`resources:
+  pipelines:
+    pipeline:
+      name: pipeline
+      libraries:
+        - notebook:
+            path: %s
+`, filepath.Join("..", "src", "notebook.py")), string(data))
+
+	assert.FileExists(t, filepath.Join(configDir, "job.yml"))
+	assert.NoFileExists(t, filepath.Join(configDir, "deployed.yml"))
+	assert.NoFileExists(t, filepath.Join(configDir, "other.yml"))
+
+	data, err = os.ReadFile(filepath.Join(srcDir, "notebook.py"))
+	require.NoError(t, err)
+	require.Equal(t, "# Databricks notebook source\nNotebook content", string(data))
+}
@@ -0,0 +1,76 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/spf13/cobra"
+)
+
+func NewGenerateExperimentCommand() *cobra.Command {
+	var configDir string
+	var experimentId string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "experiment",
+		Short: "Generate bundle configuration for an MLflow experiment",
+	}
+
+	cmd.Flags().StringVar(&experimentId, "existing-experiment-id", "", `ID of the experiment to generate config for`)
+	cmd.MarkFlagRequired("existing-experiment-id")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		wd = "."
+	}
+
+	cmd.Flags().StringVarP(&configDir, "config-dir", "d", filepath.Join(wd, "resources"), `Dir path where the output config will be stored`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Force overwrite existing files in the output directory`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := root.MustConfigureBundle(cmd)
+		if err := diags.Error(); err != nil {
+			return diags.Error()
+		}
+
+		w := b.WorkspaceClient()
+		resp, err := w.Experiments.GetExperiment(ctx, ml.GetExperimentRequest{ExperimentId: experimentId})
+		if err != nil {
+			return err
+		}
+
+		v, err := generate.ConvertExperimentToValue(resp.Experiment)
+		if err != nil {
+			return err
+		}
+
+		key := cmd.Flag("key").Value.String()
+		if key == "" {
+			key = textutil.NormalizeString(path.Base(resp.Experiment.Name))
+		}
+
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		filename, err := saveResourceConfig(ctx, g, configDir, "experiments", key, v, nil, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Experiment configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
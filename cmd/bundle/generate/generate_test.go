@@ -10,10 +10,15 @@ import (
 	"testing"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/variable"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -29,6 +34,7 @@ func TestGeneratePipelineCommand(t *testing.T) {
 
 	m := mocks.NewMockWorkspaceClient(t)
 	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
 	pipelineApi := m.GetMockPipelinesAPI()
 	pipelineApi.EXPECT().Get(mock.Anything, pipelines.GetPipelineRequest{PipelineId: "test-pipeline"}).Return(&pipelines.GetPipelineResponse{
 		PipelineId: "test-pipeline",
@@ -127,6 +133,7 @@ func TestGenerateJobCommand(t *testing.T) {
 
 	m := mocks.NewMockWorkspaceClient(t)
 	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
 
 	jobsApi := m.GetMockJobsAPI()
 	jobsApi.EXPECT().Get(mock.Anything, jobs.GetJobRequest{JobId: 1234}).Return(&jobs.Job{
@@ -213,3 +220,303 @@ func TestGenerateJobCommand(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "# Databricks notebook source\nNotebook content", string(data))
 }
+
+func TestGenerateModelServingEndpointCommand(t *testing.T) {
+	cmd := NewGenerateModelServingEndpointCommand()
+
+	root := t.TempDir()
+	b := &bundle.Bundle{
+		RootPath: root,
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
+
+	endpointsApi := m.GetMockServingEndpointsAPI()
+	endpointsApi.EXPECT().GetByName(mock.Anything, "test-endpoint").Return(&serving.ServingEndpointDetailed{
+		Name:    "test-endpoint",
+		Id:      "1234",
+		Creator: "user@example.com",
+		State: &serving.EndpointState{
+			Ready: serving.EndpointStateReadyReady,
+		},
+		Config: &serving.EndpointCoreConfigOutput{
+			ConfigVersion: 3,
+			ServedEntities: []serving.ServedEntityOutput{
+				{
+					Name:               "model-1",
+					EntityName:         "main.default.model",
+					EntityVersion:      "1",
+					WorkloadSize:       "Small",
+					ScaleToZeroEnabled: true,
+					Creator:            "user@example.com",
+					State: &serving.ServedModelState{
+						Deployment: serving.ServedModelStateDeploymentReady,
+					},
+				},
+			},
+		},
+		Tags: []serving.EndpointTag{
+			{Key: "team", Value: "ml"},
+		},
+	}, nil)
+
+	cmd.SetContext(bundle.Context(context.Background(), b))
+	cmd.Flag("existing-endpoint-name").Value.Set("test-endpoint")
+
+	configDir := filepath.Join(root, "resources")
+	cmd.Flag("config-dir").Value.Set(configDir)
+
+	var key string
+	cmd.Flags().StringVar(&key, "key", "", "")
+
+	err := cmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(configDir, "test_endpoint.yml"))
+	require.NoError(t, err)
+	require.Equal(t, `resources:
+  model_serving_endpoints:
+    test_endpoint:
+      name: test-endpoint
+      config:
+        served_entities:
+          - name: model-1
+            entity_name: main.default.model
+            entity_version: "1"
+            workload_size: Small
+            scale_to_zero_enabled: true
+      tags:
+        - key: team
+          value: ml
+`, string(data))
+}
+
+func TestGenerateQualityMonitorCommand(t *testing.T) {
+	cmd := NewGenerateQualityMonitorCommand()
+
+	root := t.TempDir()
+	b := &bundle.Bundle{
+		RootPath: root,
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
+
+	monitorsApi := m.GetMockQualityMonitorsAPI()
+	monitorsApi.EXPECT().GetByTableName(mock.Anything, "main.default.table").Return(&catalog.MonitorInfo{
+		TableName:               "main.default.table",
+		AssetsDir:               "/Workspace/Users/user@example.com/monitoring",
+		OutputSchemaName:        "main.monitoring",
+		DashboardId:             "abc",
+		ProfileMetricsTableName: "main.monitoring.table_profile_metrics",
+		Status:                  catalog.MonitorInfoStatusMonitorStatusActive,
+		Snapshot:                &catalog.MonitorSnapshot{},
+	}, nil)
+
+	cmd.SetContext(bundle.Context(context.Background(), b))
+	cmd.Flag("existing-table-name").Value.Set("main.default.table")
+
+	configDir := filepath.Join(root, "resources")
+	cmd.Flag("config-dir").Value.Set(configDir)
+
+	var key string
+	cmd.Flags().StringVar(&key, "key", "table_monitor", "")
+
+	err := cmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(configDir, "table_monitor.yml"))
+	require.NoError(t, err)
+	require.Equal(t, `resources:
+  quality_monitors:
+    table_monitor:
+      table_name: main.default.table
+      assets_dir: /Workspace/Users/${workspace.current_user.userName}/monitoring
+      output_schema_name: main.monitoring
+      snapshot: {}
+`, string(data))
+}
+
+func TestGenerateJobCommandWithVariables(t *testing.T) {
+	cmd := NewGenerateJobCommand()
+
+	root := t.TempDir()
+	b := &bundle.Bundle{
+		RootPath: root,
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
+
+	jobsApi := m.GetMockJobsAPI()
+	jobsApi.EXPECT().Get(mock.Anything, jobs.GetJobRequest{JobId: 1234}).Return(&jobs.Job{
+		Settings: &jobs.JobSettings{
+			Name: "test-job",
+			JobClusters: []jobs.JobCluster{
+				{NewCluster: compute.ClusterSpec{
+					PolicyId: "policy-1",
+				}},
+				{NewCluster: compute.ClusterSpec{
+					InstancePoolId: "pool-1",
+				}},
+			},
+			Tasks: []jobs.Task{
+				{
+					TaskKey:           "a",
+					ExistingClusterId: "cluster-1",
+					SparkPythonTask: &jobs.SparkPythonTask{
+						PythonFile: "dbfs:/Users/user@example.com/main.py",
+					},
+				},
+				{
+					TaskKey:           "b",
+					ExistingClusterId: "cluster-1",
+					SqlTask: &jobs.SqlTask{
+						WarehouseId: "deleted-warehouse",
+					},
+				},
+			},
+		},
+	}, nil)
+
+	m.GetMockClusterPoliciesAPI().EXPECT().GetByPolicyId(mock.Anything, "policy-1").Return(&compute.Policy{Name: "Job Compute"}, nil)
+	m.GetMockInstancePoolsAPI().EXPECT().GetByInstancePoolId(mock.Anything, "pool-1").Return(&compute.GetInstancePool{InstancePoolName: "pool"}, nil)
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "cluster-1").Return(&compute.ClusterDetails{ClusterName: "Shared"}, nil).Once()
+	m.GetMockWarehousesAPI().EXPECT().GetById(mock.Anything, "deleted-warehouse").Return(nil, fmt.Errorf("not found"))
+
+	cmd.SetContext(bundle.Context(context.Background(), b))
+	cmd.Flag("existing-job-id").Value.Set("1234")
+
+	configDir := filepath.Join(root, "resources")
+	cmd.Flag("config-dir").Value.Set(configDir)
+
+	srcDir := filepath.Join(root, "src")
+	cmd.Flag("source-dir").Value.Set(srcDir)
+
+	var key string
+	cmd.Flags().StringVar(&key, "key", "test_job", "")
+
+	err := cmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(configDir, "test_job.yml"))
+	require.NoError(t, err)
+
+	require.Equal(t, `variables:
+  job_compute_policy_id:
+    description: ID of the cluster policy "Job Compute"
+    lookup:
+      cluster_policy: Job Compute
+  pool_instance_pool_id:
+    description: ID of the instance pool "pool"
+    lookup:
+      instance_pool: pool
+  shared_cluster_id:
+    description: ID of the cluster "Shared"
+    lookup:
+      cluster: Shared
+resources:
+  jobs:
+    test_job:
+      name: test-job
+      job_clusters:
+        - new_cluster:
+            policy_id: ${var.job_compute_policy_id}
+        - new_cluster:
+            instance_pool_id: ${var.pool_instance_pool_id}
+      tasks:
+        - task_key: a
+          existing_cluster_id: ${var.shared_cluster_id}
+          spark_python_task:
+            python_file: dbfs:/Users/${workspace.current_user.userName}/main.py
+        - task_key: b
+          existing_cluster_id: ${var.shared_cluster_id}
+          sql_task:
+            warehouse_id: deleted-warehouse
+`, string(data))
+}
+
+func TestGenerateJobCommandWithExistingVariables(t *testing.T) {
+	cmd := NewGenerateJobCommand()
+
+	root := t.TempDir()
+	b := &bundle.Bundle{
+		RootPath: root,
+		Config: config.Root{
+			Variables: map[string]*variable.Variable{
+				// Looks up the same cluster, so it is referenced.
+				"my_cluster": {
+					Lookup: &variable.Lookup{Cluster: "Shared"},
+				},
+				// Has the name of the generated variable, so another name is used.
+				"pool_instance_pool_id": {
+					Default: "pool-2",
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{UserName: "user@example.com"}, nil)
+
+	jobsApi := m.GetMockJobsAPI()
+	jobsApi.EXPECT().Get(mock.Anything, jobs.GetJobRequest{JobId: 1234}).Return(&jobs.Job{
+		Settings: &jobs.JobSettings{
+			Name: "test-job",
+			JobClusters: []jobs.JobCluster{
+				{NewCluster: compute.ClusterSpec{
+					InstancePoolId: "pool-1",
+				}},
+			},
+			Tasks: []jobs.Task{
+				{
+					TaskKey:           "a",
+					ExistingClusterId: "cluster-1",
+				},
+			},
+		},
+	}, nil)
+
+	m.GetMockInstancePoolsAPI().EXPECT().GetByInstancePoolId(mock.Anything, "pool-1").Return(&compute.GetInstancePool{InstancePoolName: "pool"}, nil)
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "cluster-1").Return(&compute.ClusterDetails{ClusterName: "Shared"}, nil)
+
+	cmd.SetContext(bundle.Context(context.Background(), b))
+	cmd.Flag("existing-job-id").Value.Set("1234")
+
+	configDir := filepath.Join(root, "resources")
+	cmd.Flag("config-dir").Value.Set(configDir)
+
+	srcDir := filepath.Join(root, "src")
+	cmd.Flag("source-dir").Value.Set(srcDir)
+
+	var key string
+	cmd.Flags().StringVar(&key, "key", "test_job", "")
+
+	err := cmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(configDir, "test_job.yml"))
+	require.NoError(t, err)
+
+	require.Equal(t, `variables:
+  pool_instance_pool_id_2:
+    description: ID of the instance pool "pool"
+    lookup:
+      instance_pool: pool
+resources:
+  jobs:
+    test_job:
+      name: test-job
+      job_clusters:
+        - new_cluster:
+            instance_pool_id: ${var.pool_instance_pool_id_2}
+      tasks:
+        - task_key: a
+          existing_cluster_id: ${var.my_cluster}
+`, string(data))
+}
@@ -8,8 +8,6 @@ import (
 	"github.com/databricks/cli/bundle/config/generate"
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
-	"github.com/databricks/cli/libs/dyn"
-	"github.com/databricks/cli/libs/dyn/yamlsaver"
 	"github.com/databricks/cli/libs/textutil"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/spf13/cobra"
@@ -52,6 +50,11 @@ func NewGenerateJobCommand() *cobra.Command {
 			return err
 		}
 
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
 		downloader := newDownloader(w, sourceDir, configDir)
 		for _, task := range job.Settings.Tasks {
 			err := downloader.MarkTaskForDownload(ctx, &task)
@@ -70,27 +73,17 @@ func NewGenerateJobCommand() *cobra.Command {
 			jobKey = textutil.NormalizeString(job.Settings.Name)
 		}
 
-		result := map[string]dyn.Value{
-			"resources": dyn.V(map[string]dyn.Value{
-				"jobs": dyn.V(map[string]dyn.Value{
-					jobKey: v,
-				}),
-			}),
-		}
-
 		err = downloader.FlushToDisk(ctx, force)
 		if err != nil {
 			return err
 		}
 
-		filename := filepath.Join(configDir, fmt.Sprintf("%s.yml", jobKey))
-		saver := yamlsaver.NewSaverWithStyle(map[string]yaml.Style{
+		filename, err := saveResourceConfig(ctx, g, configDir, "jobs", jobKey, v, map[string]yaml.Style{
 			// Including all JobSettings and nested fields which are map[string]string type
 			"spark_conf":  yaml.DoubleQuotedStyle,
 			"custom_tags": yaml.DoubleQuotedStyle,
 			"tags":        yaml.DoubleQuotedStyle,
-		})
-		err = saver.SaveAsYAML(result, filename, force)
+		}, force)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,77 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func NewGenerateModelServingEndpointCommand() *cobra.Command {
+	var configDir string
+	var endpointName string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "model-serving-endpoint",
+		Short: "Generate bundle configuration for a model serving endpoint",
+	}
+
+	cmd.Flags().StringVar(&endpointName, "existing-endpoint-name", "", `Name of the model serving endpoint to generate config for`)
+	cmd.MarkFlagRequired("existing-endpoint-name")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		wd = "."
+	}
+
+	cmd.Flags().StringVarP(&configDir, "config-dir", "d", filepath.Join(wd, "resources"), `Dir path where the output config will be stored`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Force overwrite existing files in the output directory`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := root.MustConfigureBundle(cmd)
+		if err := diags.Error(); err != nil {
+			return diags.Error()
+		}
+
+		w := b.WorkspaceClient()
+		endpoint, err := w.ServingEndpoints.GetByName(ctx, endpointName)
+		if err != nil {
+			return err
+		}
+
+		v, err := generate.ConvertModelServingEndpointToValue(endpoint)
+		if err != nil {
+			return err
+		}
+
+		key := cmd.Flag("key").Value.String()
+		if key == "" {
+			key = textutil.NormalizeString(endpoint.Name)
+		}
+
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		filename, err := saveResourceConfig(ctx, g, configDir, "model_serving_endpoints", key, v, map[string]yaml.Style{
+			"environment_vars": yaml.DoubleQuotedStyle,
+		}, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Model serving endpoint configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
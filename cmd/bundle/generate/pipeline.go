@@ -8,8 +8,6 @@ import (
 	"github.com/databricks/cli/bundle/config/generate"
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
-	"github.com/databricks/cli/libs/dyn"
-	"github.com/databricks/cli/libs/dyn/yamlsaver"
 	"github.com/databricks/cli/libs/textutil"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/spf13/cobra"
@@ -52,6 +50,11 @@ func NewGeneratePipelineCommand() *cobra.Command {
 			return err
 		}
 
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
 		downloader := newDownloader(w, sourceDir, configDir)
 		for _, lib := range pipeline.Spec.Libraries {
 			err := downloader.MarkPipelineLibraryForDownload(ctx, &lib)
@@ -70,29 +73,17 @@ func NewGeneratePipelineCommand() *cobra.Command {
 			pipelineKey = textutil.NormalizeString(pipeline.Name)
 		}
 
-		result := map[string]dyn.Value{
-			"resources": dyn.V(map[string]dyn.Value{
-				"pipelines": dyn.V(map[string]dyn.Value{
-					pipelineKey: v,
-				}),
-			}),
-		}
-
 		err = downloader.FlushToDisk(ctx, force)
 		if err != nil {
 			return err
 		}
 
-		filename := filepath.Join(configDir, fmt.Sprintf("%s.yml", pipelineKey))
-		saver := yamlsaver.NewSaverWithStyle(
+		filename, err := saveResourceConfig(ctx, g, configDir, "pipelines", pipelineKey, v, map[string]yaml.Style{
 			// Including all PipelineSpec and nested fields which are map[string]string type
-			map[string]yaml.Style{
-				"spark_conf":    yaml.DoubleQuotedStyle,
-				"custom_tags":   yaml.DoubleQuotedStyle,
-				"configuration": yaml.DoubleQuotedStyle,
-			},
-		)
-		err = saver.SaveAsYAML(result, filename, force)
+			"spark_conf":    yaml.DoubleQuotedStyle,
+			"custom_tags":   yaml.DoubleQuotedStyle,
+			"configuration": yaml.DoubleQuotedStyle,
+		}, force)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,74 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/spf13/cobra"
+)
+
+func NewGenerateQualityMonitorCommand() *cobra.Command {
+	var configDir string
+	var tableName string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "quality-monitor",
+		Short: "Generate bundle configuration for a quality monitor",
+	}
+
+	cmd.Flags().StringVar(&tableName, "existing-table-name", "", `Full name of the table of the quality monitor to generate config for`)
+	cmd.MarkFlagRequired("existing-table-name")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		wd = "."
+	}
+
+	cmd.Flags().StringVarP(&configDir, "config-dir", "d", filepath.Join(wd, "resources"), `Dir path where the output config will be stored`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Force overwrite existing files in the output directory`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := root.MustConfigureBundle(cmd)
+		if err := diags.Error(); err != nil {
+			return diags.Error()
+		}
+
+		w := b.WorkspaceClient()
+		monitor, err := w.QualityMonitors.GetByTableName(ctx, tableName)
+		if err != nil {
+			return err
+		}
+
+		v, err := generate.ConvertQualityMonitorToValue(monitor)
+		if err != nil {
+			return err
+		}
+
+		key := cmd.Flag("key").Value.String()
+		if key == "" {
+			key = textutil.NormalizeString(monitor.TableName)
+		}
+
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		filename, err := saveResourceConfig(ctx, g, configDir, "quality_monitors", key, v, nil, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Quality monitor configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
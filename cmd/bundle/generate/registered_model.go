@@ -0,0 +1,74 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/spf13/cobra"
+)
+
+func NewGenerateRegisteredModelCommand() *cobra.Command {
+	var configDir string
+	var modelName string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "registered-model",
+		Short: "Generate bundle configuration for a registered model in Unity Catalog",
+	}
+
+	cmd.Flags().StringVar(&modelName, "existing-registered-model-name", "", `Full name of the registered model to generate config for`)
+	cmd.MarkFlagRequired("existing-registered-model-name")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		wd = "."
+	}
+
+	cmd.Flags().StringVarP(&configDir, "config-dir", "d", filepath.Join(wd, "resources"), `Dir path where the output config will be stored`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Force overwrite existing files in the output directory`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := root.MustConfigureBundle(cmd)
+		if err := diags.Error(); err != nil {
+			return diags.Error()
+		}
+
+		w := b.WorkspaceClient()
+		model, err := w.RegisteredModels.GetByFullName(ctx, modelName)
+		if err != nil {
+			return err
+		}
+
+		v, err := generate.ConvertRegisteredModelToValue(model)
+		if err != nil {
+			return err
+		}
+
+		key := cmd.Flag("key").Value.String()
+		if key == "" {
+			key = textutil.NormalizeString(model.Name)
+		}
+
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		filename, err := saveResourceConfig(ctx, g, configDir, "registered_models", key, v, nil, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Registered model configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle/config/generate"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/textutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func NewGenerateSchemaCommand() *cobra.Command {
+	var configDir string
+	var schemaName string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Generate bundle configuration for a schema in Unity Catalog",
+	}
+
+	cmd.Flags().StringVar(&schemaName, "existing-schema-name", "", `Full name of the schema to generate config for`)
+	cmd.MarkFlagRequired("existing-schema-name")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		wd = "."
+	}
+
+	cmd.Flags().StringVarP(&configDir, "config-dir", "d", filepath.Join(wd, "resources"), `Dir path where the output config will be stored`)
+	cmd.Flags().BoolVarP(&force, "force", "f", false, `Force overwrite existing files in the output directory`)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := root.MustConfigureBundle(cmd)
+		if err := diags.Error(); err != nil {
+			return diags.Error()
+		}
+
+		w := b.WorkspaceClient()
+		schema, err := w.Schemas.GetByFullName(ctx, schemaName)
+		if err != nil {
+			return err
+		}
+
+		v, err := generate.ConvertSchemaToValue(schema)
+		if err != nil {
+			return err
+		}
+
+		key := cmd.Flag("key").Value.String()
+		if key == "" {
+			key = textutil.NormalizeString(schema.Name)
+		}
+
+		g, err := newVariableGenerator(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		filename, err := saveResourceConfig(ctx, g, configDir, "schemas", key, v, map[string]yaml.Style{
+			"properties": yaml.DoubleQuotedStyle,
+		}, force)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Schema configuration successfully saved to %s", filename))
+		return nil
+	}
+
+	return cmd
+}
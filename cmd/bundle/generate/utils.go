@@ -7,28 +7,46 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/generate"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
 	"github.com/databricks/cli/libs/notebook"
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
 )
 
 type downloader struct {
-	files     map[string]string
+	// Maps the local target path of a file to its workspace path.
+	files map[string]string
+
+	// Maps the workspace path of a file to its local target path, so that
+	// files referenced by more than one task or resource are downloaded once.
+	targets map[string]string
+
 	w         *databricks.WorkspaceClient
 	sourceDir string
 	configDir string
 }
 
 func (n *downloader) MarkTaskForDownload(ctx context.Context, task *jobs.Task) error {
-	if task.NotebookTask == nil {
-		return nil
+	if task.NotebookTask != nil {
+		return n.markNotebookForDownload(ctx, &task.NotebookTask.NotebookPath)
 	}
 
-	return n.markNotebookForDownload(ctx, &task.NotebookTask.NotebookPath)
+	// Python files are only downloaded if they are stored in the workspace.
+	// Files in a Git repository or on cloud storage are left as is.
+	if task.SparkPythonTask != nil && task.SparkPythonTask.Source != jobs.SourceGit && path.IsAbs(task.SparkPythonTask.PythonFile) {
+		return n.markFileForDownload(ctx, &task.SparkPythonTask.PythonFile)
+	}
+
+	return nil
 }
 
 func (n *downloader) MarkPipelineLibraryForDownload(ctx context.Context, lib *pipelines.PipelineLibrary) error {
@@ -49,11 +67,7 @@ func (n *downloader) markFileForDownload(ctx context.Context, filePath *string)
 		return err
 	}
 
-	filename := path.Base(*filePath)
-	targetPath := filepath.Join(n.sourceDir, filename)
-
-	n.files[targetPath] = *filePath
-
+	targetPath := n.targetPath(*filePath, path.Base(*filePath))
 	rel, err := filepath.Rel(n.configDir, targetPath)
 	if err != nil {
 		return err
@@ -71,10 +85,7 @@ func (n *downloader) markNotebookForDownload(ctx context.Context, notebookPath *
 
 	ext := notebook.GetExtensionByLanguage(info)
 
-	filename := path.Base(*notebookPath) + ext
-	targetPath := filepath.Join(n.sourceDir, filename)
-
-	n.files[targetPath] = *notebookPath
+	targetPath := n.targetPath(*notebookPath, path.Base(*notebookPath)+ext)
 
 	// Update the notebook path to be relative to the config dir
 	rel, err := filepath.Rel(n.configDir, targetPath)
@@ -86,6 +97,28 @@ func (n *downloader) markNotebookForDownload(ctx context.Context, notebookPath *
 	return nil
 }
 
+// targetPath returns the local path to download the file at the workspace path to.
+// Files with the same name in different workspace directories get a numeric suffix.
+func (n *downloader) targetPath(workspacePath string, filename string) string {
+	if targetPath, ok := n.targets[workspacePath]; ok {
+		return targetPath
+	}
+
+	ext := path.Ext(filename)
+	base := strings.TrimSuffix(filename, ext)
+	targetPath := filepath.Join(n.sourceDir, filename)
+	for i := 2; ; i++ {
+		if _, ok := n.files[targetPath]; !ok {
+			break
+		}
+		targetPath = filepath.Join(n.sourceDir, fmt.Sprintf("%s_%d%s", base, i, ext))
+	}
+
+	n.files[targetPath] = workspacePath
+	n.targets[workspacePath] = targetPath
+	return targetPath
+}
+
 func (n *downloader) FlushToDisk(ctx context.Context, force bool) error {
 	err := os.MkdirAll(n.sourceDir, 0755)
 	if err != nil {
@@ -137,8 +170,52 @@ func (n *downloader) FlushToDisk(ctx context.Context, force bool) error {
 func newDownloader(w *databricks.WorkspaceClient, sourceDir string, configDir string) *downloader {
 	return &downloader{
 		files:     make(map[string]string),
+		targets:   make(map[string]string),
 		w:         w,
 		sourceDir: sourceDir,
 		configDir: configDir,
 	}
 }
+
+// newVariableGenerator returns a generator that replaces workspace-specific values
+// in the generated configuration with references to variables. Variables that are
+// already defined in the bundle are reused or not redefined.
+func newVariableGenerator(ctx context.Context, b *bundle.Bundle) (*generate.VariableGenerator, error) {
+	w := b.WorkspaceClient()
+	me, err := w.CurrentUser.Me(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return generate.NewVariableGenerator(w, me.UserName, b.Config.Variables), nil
+}
+
+// saveResourceConfig saves the configuration of a single resource in the
+// specified resource group to <configDir>/<key>.yml and returns its path.
+// The variables that the configuration references are saved in the same file.
+func saveResourceConfig(ctx context.Context, g *generate.VariableGenerator, configDir string, group string, key string, v dyn.Value, style map[string]yaml.Style, force bool) (string, error) {
+	v, variables, err := g.Apply(ctx, v)
+	if err != nil {
+		return "", err
+	}
+
+	// We're using location lines to define the order of keys in exported YAML.
+	result := map[string]dyn.Value{
+		"resources": dyn.NewValue(map[string]dyn.Value{
+			group: dyn.V(map[string]dyn.Value{
+				key: v,
+			}),
+		}, []dyn.Location{{Line: 1}}),
+	}
+	if variables.Kind() != dyn.KindNil {
+		result["variables"] = variables.WithLocations([]dyn.Location{{Line: 0}})
+	}
+
+	filename := filepath.Join(configDir, fmt.Sprintf("%s.yml", key))
+	saver := yamlsaver.NewSaverWithStyle(style)
+	err = saver.SaveAsYAML(result, filename, force)
+	if err != nil {
+		return "", err
+	}
+
+	return filename, nil
+}
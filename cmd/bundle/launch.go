@@ -1,36 +1,160 @@
 package bundle
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/render"
+	"github.com/databricks/cli/bundle/run"
+	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
 )
 
 func newLaunchCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "launch",
-		Short: "Launches a notebook on development cluster",
-		Long:  `Reads a file and executes it on dev cluster`,
-		Args:  root.ExactArgs(1),
+		Use:   "launch PATH [-- ARGS...]",
+		Short: "Run a single file of the bundle on Databricks compute",
+		Long: `Run a single file of the bundle on Databricks compute.
 
-		// We're not ready to expose this command until we specify its semantics.
-		Hidden: true,
+This command synchronizes the bundle files to the workspace and runs the
+specified notebook, Python file or SQL file from its synchronized location as
+a one-time run. It waits for the run to complete. The stdout and stderr of
+Python files are printed while the run is in progress. The output of notebooks
+and SQL files is printed when the run completes.
+
+Notebooks and Python files run on the cluster specified by --compute-id,
+--cluster or the compute_id of the target, or on serverless compute if none
+is set. SQL files run on the SQL warehouse specified by --warehouse-id.
+Arguments after the path are passed to Python files.`,
+		Args: root.MinimumNArgs(1),
 	}
 
+	var forceLock bool
+	var computeID string
+	var clusterName string
+	var warehouseID string
+	var noWait bool
+	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().StringVarP(&computeID, "compute-id", "c", "", "Override compute in the deployment with the given compute ID.")
+	cmd.Flags().StringVar(&clusterName, "cluster", "", "Name of the cluster to run the file on.")
+	cmd.Flags().StringVar(&warehouseID, "warehouse-id", "", "ID of the SQL warehouse to run SQL files on.")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Don't wait for the run to complete.")
+	cmd.MarkFlagsMutuallyExclusive("compute-id", "cluster")
+
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
-		return fmt.Errorf("TODO")
-		// contents, err := os.ReadFile(args[0])
-		// if err != nil {
-		// 	return err
-		// }
-		// results := project.RunPythonOnDev(cmd.Context(), string(contents))
-		// if results.Failed() {
-		// 	return results.Err()
-		// }
-		// fmt.Fprintf(cmd.OutOrStdout(), "Success: %s", results.Text())
-		// return nil
+		ctx := cmd.Context()
+		b, diags := utils.ConfigureBundleWithVariables(cmd)
+
+		if !diags.HasError() {
+			bundle.ApplyFunc(ctx, b, func(context.Context, *bundle.Bundle) diag.Diagnostics {
+				b.Config.Bundle.Deployment.Lock.Force = forceLock
+				if cmd.Flag("compute-id").Changed {
+					b.Config.Bundle.ComputeID = computeID
+				}
+				return nil
+			})
+
+			diags = diags.Extend(bundle.Apply(ctx, b, phases.Initialize()))
+		}
+
+		// Translate the path before uploading files, so that an invalid path fails early.
+		var remotePath string
+		var isNotebook bool
+		if !diags.HasError() {
+			var err error
+			remotePath, isNotebook, err = translateLaunchPath(b, args[0])
+			diags = diags.Extend(diag.FromErr(err))
+		}
+
+		if !diags.HasError() {
+			diags = diags.Extend(bundle.Apply(ctx, b, phases.Launch()))
+		}
+
+		if diags.HasError() {
+			renderOpts := render.RenderOptions{RenderSummaryTable: false}
+			err := render.RenderTextOutput(cmd.OutOrStdout(), b, diags, renderOpts)
+			if err != nil {
+				return fmt.Errorf("failed to render output: %w", err)
+			}
+			return root.ErrAlreadyPrinted
+		}
+
+		clusterID := b.Config.Bundle.ComputeID
+		if clusterName != "" {
+			cluster, err := b.WorkspaceClient().Clusters.GetByClusterName(ctx, clusterName)
+			if err != nil {
+				return fmt.Errorf("failed to find cluster %s: %w", clusterName, err)
+			}
+			clusterID = cluster.ClusterId
+		}
+
+		// Stream output to stderr if the result is printed as JSON.
+		streamOutput := cmd.OutOrStdout()
+		if root.OutputType(cmd) == flags.OutputJSON {
+			streamOutput = cmd.ErrOrStderr()
+		}
+
+		output, err := run.Launch(ctx, b, &run.LaunchOptions{
+			Path:        remotePath,
+			IsNotebook:  isNotebook,
+			Args:        args[1:],
+			ClusterId:   clusterID,
+			WarehouseId: warehouseID,
+			NoWait:      noWait,
+			Output:      streamOutput,
+		})
+		if err != nil {
+			return err
+		}
+		if output != nil {
+			switch root.OutputType(cmd) {
+			case flags.OutputText:
+				resultString, err := output.String()
+				if err != nil {
+					return err
+				}
+				_, err = cmd.OutOrStdout().Write([]byte(resultString))
+				return err
+			default:
+				return cmdio.Render(ctx, output)
+			}
+		}
+		return nil
 	}
 
 	return cmd
 }
+
+// translateLaunchPath returns the workspace path of a local file of the bundle and
+// whether it is a notebook. It returns an error if the file doesn't exist or is not
+// synchronized to the workspace.
+func translateLaunchPath(b *bundle.Bundle, path string) (string, bool, error) {
+	localPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", false, err
+	}
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return "", false, err
+	}
+	if info.IsDir() {
+		return "", false, fmt.Errorf("%s is a directory", path)
+	}
+
+	rel, err := filepath.Rel(b.SyncRootPath, localPath)
+	if err != nil || !filepath.IsLocal(rel) {
+		return "", false, fmt.Errorf("%s is not located in the sync root %s", path, b.SyncRootPath)
+	}
+
+	return mutator.TranslateLocalPath(b, localPath)
+}
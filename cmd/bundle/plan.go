@@ -0,0 +1,95 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/render"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/spf13/cobra"
+)
+
+func newPlanCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes a deploy would make",
+		Long: `Show the changes a deploy would make.
+
+Lists every bundle resource that would be created, updated, deleted or
+recreated by "bundle deploy", together with the fields that change.
+Nothing is uploaded or deployed to the workspace.`,
+		Args: root.NoArgs,
+	}
+
+	var computeID string
+	cmd.Flags().StringVarP(&computeID, "compute-id", "c", "", "Override compute in the deployment with the given compute ID.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b, diags := utils.ConfigureBundleWithVariables(cmd)
+
+		var changes []terraform.ResourceChange
+		if !diags.HasError() {
+			bundle.ApplyFunc(ctx, b, func(context.Context, *bundle.Bundle) diag.Diagnostics {
+				if cmd.Flag("compute-id").Changed {
+					b.Config.Bundle.ComputeID = computeID
+				}
+				return nil
+			})
+
+			diags = diags.Extend(
+				bundle.Apply(ctx, b, bundle.Seq(
+					phases.Initialize(),
+					phases.Build(),
+					phases.Plan(),
+				)),
+			)
+		}
+
+		if !diags.HasError() {
+			var err error
+			if b.Config.Bundle.Deployment.IsDirect() {
+				changes, err = direct.PlanChanges(ctx, b, terraform.PlanDeploy)
+			} else {
+				changes, err = terraform.PlanChanges(ctx, b)
+			}
+			diags = diags.Extend(diag.FromErr(err))
+			diags = diags.Extend(bundle.Apply(ctx, b, terraform.RemovePlan()))
+		}
+
+		if diags.HasError() {
+			renderOpts := render.RenderOptions{RenderSummaryTable: false}
+			err := render.RenderTextOutput(cmd.ErrOrStderr(), b, diags, renderOpts)
+			if err != nil {
+				return fmt.Errorf("failed to render output: %w", err)
+			}
+			return root.ErrAlreadyPrinted
+		}
+
+		// Warnings are rendered to stderr for every output type, so that they don't interfere with JSON output.
+		err := render.RenderTextOutput(cmd.ErrOrStderr(), b, diags, render.RenderOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to render output: %w", err)
+		}
+
+		switch root.OutputType(cmd) {
+		case flags.OutputText:
+			return render.RenderPlan(cmd.OutOrStdout(), changes)
+		default:
+			if changes == nil {
+				changes = []terraform.ResourceChange{}
+			}
+			return cmdio.Render(ctx, map[string]any{"changes": changes})
+		}
+	}
+
+	return cmd
+}
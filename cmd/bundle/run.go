@@ -1,10 +1,10 @@
 package bundle
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/databricks/cli/bundle/run"
@@ -18,8 +18,8 @@ import (
 func newRunCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "run [flags] KEY",
-		Short: "Run a job or pipeline update",
-		Long: `Run the job or pipeline identified by KEY.
+		Short: "Run a job, pipeline update or other resource of the bundle",
+		Long: `Run the job, pipeline, quality monitor or model serving endpoint identified by KEY.
 
 The KEY is the unique identifier of the resource to run. In addition to
 customizing the run using any of the available flags, you can also specify
@@ -37,6 +37,34 @@ parameter names.
 
 If the specified job does not use job parameters and the job has a Python file
 task or a Python wheel task, the second example applies.
+
+Use --only to run a subset of the tasks of a job, optionally with the tasks
+they depend on (--include-dependencies). Use --repair RUN_ID or --repair-latest
+to rerun the failed tasks of an earlier run of the job, or the tasks specified
+with --only, together with the tasks that depend on them. Parameters specified
+on the command line override those of the repaired run.
+
+Use --follow to print the driver logs of job tasks while they run. The logs are
+read from the DBFS location the cluster delivers its logs to, so this requires
+clusters with a DBFS log destination (cluster_log_conf). Clusters deliver their
+logs every few minutes, so the output is not real-time. Nothing is printed for
+tasks that run on serverless compute or on clusters without a DBFS log
+destination; a warning is printed for these tasks instead.
+
+If a task fails, the last lines of its driver logs (--tail-lines) are printed
+after its error trace, if its cluster delivers its logs to DBFS.
+
+For pipelines, --validate-only checks the pipeline without processing data and
+prints its dataset graph. With --refresh-select, the tables to update are
+selected from those of the latest update.
+
+Running a quality monitor triggers a refresh of the monitor. Running a model
+serving endpoint waits for its configuration to be updated and, if --payload is
+specified, sends the contents of that JSON file as a test query.
+
+If KEY is the path of a .sql file in the bundle, its statements are run on the
+SQL warehouse specified by --warehouse-id or the warehouse_id of the target.
+The result of the last statement is printed.
 `,
 	}
 
@@ -57,10 +85,19 @@ task or a Python wheel task, the second example applies.
 
 		diags = bundle.Apply(ctx, b, bundle.Seq(
 			phases.Initialize(),
-			terraform.Interpolate(),
-			terraform.Write(),
-			terraform.StatePull(),
-			terraform.Load(terraform.ErrorOnEmptyState),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(
+					direct.StatePull(),
+					direct.Load(direct.ErrorOnEmptyState),
+				),
+				bundle.Seq(
+					terraform.Interpolate(),
+					terraform.Write(),
+					terraform.StatePull(),
+					terraform.Load(terraform.ErrorOnEmptyState),
+				),
+			),
 		))
 		if err := diags.Error(); err != nil {
 			return err
@@ -117,14 +154,8 @@ task or a Python wheel task, the second example applies.
 					return err
 				}
 				cmd.OutOrStdout().Write([]byte(resultString))
-			case flags.OutputJSON:
-				b, err := json.MarshalIndent(output, "", "  ")
-				if err != nil {
-					return err
-				}
-				cmd.OutOrStdout().Write(b)
 			default:
-				return fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+				return cmdio.Render(ctx, output)
 			}
 		}
 		return nil
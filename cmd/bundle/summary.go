@@ -1,17 +1,18 @@
 package bundle
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
 )
@@ -41,45 +42,76 @@ func newSummaryCommand() *cobra.Command {
 			return err
 		}
 
-		cacheDir, err := terraform.Dir(ctx, b)
-		if err != nil {
-			return err
-		}
-		_, stateFileErr := os.Stat(filepath.Join(cacheDir, terraform.TerraformStateFileName))
-		_, configFileErr := os.Stat(filepath.Join(cacheDir, terraform.TerraformConfigFileName))
-		noCache := errors.Is(stateFileErr, os.ErrNotExist) || errors.Is(configFileErr, os.ErrNotExist)
-
-		if forcePull || noCache {
-			diags = bundle.Apply(ctx, b, bundle.Seq(
-				terraform.StatePull(),
-				terraform.Interpolate(),
-				terraform.Write(),
-			))
-			if err := diags.Error(); err != nil {
+		if b.Config.Bundle.Deployment.IsDirect() {
+			err := loadDirectState(cmd, b, forcePull)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := loadTerraformState(cmd, b, forcePull)
+			if err != nil {
 				return err
 			}
-		}
-
-		diags = bundle.Apply(ctx, b, terraform.Load())
-		if err := diags.Error(); err != nil {
-			return err
 		}
 
 		switch root.OutputType(cmd) {
 		case flags.OutputText:
 			return fmt.Errorf("%w, only json output is supported", errors.ErrUnsupported)
-		case flags.OutputJSON:
-			buf, err := json.MarshalIndent(b.Config, "", "  ")
-			if err != nil {
-				return err
-			}
-			cmd.OutOrStdout().Write(buf)
 		default:
-			return fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+			return cmdio.Render(ctx, b.Config)
 		}
-
-		return nil
 	}
 
 	return cmd
 }
+
+func loadTerraformState(cmd *cobra.Command, b *bundle.Bundle, forcePull bool) error {
+	ctx := cmd.Context()
+	cacheDir, err := terraform.Dir(ctx, b)
+	if err != nil {
+		return err
+	}
+	_, stateFileErr := os.Stat(filepath.Join(cacheDir, terraform.TerraformStateFileName))
+	_, configFileErr := os.Stat(filepath.Join(cacheDir, terraform.TerraformConfigFileName))
+	noCache := errors.Is(stateFileErr, os.ErrNotExist) || errors.Is(configFileErr, os.ErrNotExist)
+
+	if forcePull || noCache {
+		diags := bundle.Apply(ctx, b, bundle.Seq(
+			terraform.StatePull(),
+			terraform.Interpolate(),
+			terraform.Write(),
+		))
+		if err := diags.Error(); err != nil {
+			return err
+		}
+	}
+
+	diags := bundle.Apply(ctx, b, terraform.Load())
+	if err := diags.Error(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func loadDirectState(cmd *cobra.Command, b *bundle.Bundle, forcePull bool) error {
+	ctx := cmd.Context()
+	cacheDir, err := direct.Dir(ctx, b)
+	if err != nil {
+		return err
+	}
+	_, stateFileErr := os.Stat(filepath.Join(cacheDir, direct.StateFileName))
+	noCache := errors.Is(stateFileErr, os.ErrNotExist)
+
+	if forcePull || noCache {
+		diags := bundle.Apply(ctx, b, direct.StatePull())
+		if err := diags.Error(); err != nil {
+			return err
+		}
+	}
+
+	diags := bundle.Apply(ctx, b, direct.Load())
+	if err := diags.Error(); err != nil {
+		return err
+	}
+	return nil
+}
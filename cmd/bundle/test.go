@@ -1,29 +1,104 @@
 package bundle
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/render"
+	"github.com/databricks/cli/bundle/testrunner"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
 	"github.com/spf13/cobra"
 )
 
 func newTestCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "test",
-		Short: "run tests for the project",
-		Long:  `This is longer description of the command`,
+		Use:   "test [flags] [-- PYTEST_ARGS...]",
+		Short: "Run the tests of the bundle on Databricks compute",
+		Long: `Run the tests of the bundle on Databricks compute.
 
-		// We're not ready to expose this command until we specify its semantics.
-		Hidden: true,
+This command builds the artifacts of the bundle, uploads them together with the
+bundle files, and runs pytest in the bundle root directory as a one-off job run.
+Python wheels built by the bundle are installed before the tests run.
+
+The tests run on the compute specified by --compute-id or the compute_id of the
+target, or on serverless compute if neither is set. Arguments after -- are
+passed to pytest.
+
+The output of the tests is streamed while they run and a JUnit XML report is
+written to the path specified by --junit-xml. The command exits with a non-zero
+exit code if any test fails.`,
+		Args: cobra.ArbitraryArgs,
 	}
 
+	var forceLock bool
+	var computeID string
+	var junitXML string
+	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().StringVarP(&computeID, "compute-id", "c", "", "Override compute in the deployment with the given compute ID.")
+	cmd.Flags().StringVar(&junitXML, "junit-xml", "", "Path to write the JUnit XML report to. Defaults to junit.xml in the bundle cache directory.")
+
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
-		return fmt.Errorf("TODO")
-		// results := project.RunPythonOnDev(cmd.Context(), `return 1`)
-		// if results.Failed() {
-		// 	return results.Err()
-		// }
-		// fmt.Fprintf(cmd.OutOrStdout(), "Success: %s", results.Text())
-		// return nil
+		ctx := cmd.Context()
+		b, diags := utils.ConfigureBundleWithVariables(cmd)
+
+		if !diags.HasError() {
+			bundle.ApplyFunc(ctx, b, func(context.Context, *bundle.Bundle) diag.Diagnostics {
+				b.Config.Bundle.Deployment.Lock.Force = forceLock
+				if cmd.Flag("compute-id").Changed {
+					b.Config.Bundle.ComputeID = computeID
+				}
+				return nil
+			})
+
+			diags = diags.Extend(
+				bundle.Apply(ctx, b, bundle.Seq(
+					phases.Initialize(),
+					phases.Build(),
+					phases.Test(),
+				)),
+			)
+		}
+
+		if diags.HasError() {
+			renderOpts := render.RenderOptions{RenderSummaryTable: false}
+			err := render.RenderTextOutput(cmd.OutOrStdout(), b, diags, renderOpts)
+			if err != nil {
+				return fmt.Errorf("failed to render output: %w", err)
+			}
+			return root.ErrAlreadyPrinted
+		}
+
+		if junitXML == "" {
+			cacheDir, err := b.CacheDir(ctx)
+			if err != nil {
+				return err
+			}
+			junitXML = filepath.Join(cacheDir, "junit.xml")
+		}
+
+		cmdio.LogString(ctx, "Running tests...")
+		result, err := testrunner.Run(ctx, b, testrunner.Options{
+			Args:         args,
+			JUnitXMLPath: junitXML,
+			Output:       cmd.OutOrStdout(),
+		})
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("JUnit XML report written to %s", junitXML))
+		if !result.Passed() {
+			cmdio.LogString(ctx, fmt.Sprintf("Tests failed (pytest exit code %d). See %s", result.ExitCode, result.RunPageUrl))
+			return root.ErrAlreadyPrinted
+		}
+
+		return nil
 	}
 
 	return cmd
@@ -9,9 +9,15 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func configureVariables(cmd *cobra.Command, b *bundle.Bundle, variables []string) diag.Diagnostics {
+func configureVariables(cmd *cobra.Command, b *bundle.Bundle, variables []string, variableFiles []string) diag.Diagnostics {
 	return bundle.ApplyFunc(cmd.Context(), b, func(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
 		err := b.Config.InitializeVariables(variables)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Values passed with the "--var" flag take precedence over values from files.
+		err = b.Config.InitializeVariablesFromFiles(b.RootPath, variableFiles)
 		return diag.FromErr(err)
 	})
 }
@@ -28,8 +34,13 @@ func ConfigureBundleWithVariables(cmd *cobra.Command) (*bundle.Bundle, diag.Diag
 		return b, diag.FromErr(err)
 	}
 
+	variableFiles, err := cmd.Flags().GetStringArray("var-file")
+	if err != nil {
+		return b, diag.FromErr(err)
+	}
+
 	// Initialize variables by assigning them values passed as command line flags
-	diags = diags.Extend(configureVariables(cmd, b, variables))
+	diags = diags.Extend(configureVariables(cmd, b, variables, variableFiles))
 
 	return b, diags
 }
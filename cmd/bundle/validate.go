@@ -1,7 +1,6 @@
 package bundle
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
@@ -10,17 +9,17 @@ import (
 	"github.com/databricks/cli/bundle/render"
 	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
 )
 
-func renderJsonOutput(cmd *cobra.Command, b *bundle.Bundle, diags diag.Diagnostics) error {
-	buf, err := json.MarshalIndent(b.Config.Value().AsAny(), "", "  ")
+func renderStructuredOutput(cmd *cobra.Command, b *bundle.Bundle, diags diag.Diagnostics) error {
+	err := cmdio.Render(cmd.Context(), b.Config.Value().AsAny())
 	if err != nil {
 		return err
 	}
-	cmd.OutOrStdout().Write(buf)
 	return diags.Error()
 }
 
@@ -64,10 +63,8 @@ func newValidateCommand() *cobra.Command {
 			}
 
 			return nil
-		case flags.OutputJSON:
-			return renderJsonOutput(cmd, b, diags)
 		default:
-			return fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+			return renderStructuredOutput(cmd, b, diags)
 		}
 	}
 
@@ -6,4 +6,5 @@ import (
 
 func initVariableFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringSlice("var", []string{}, `set values for variables defined in bundle config. Example: --var="foo=bar"`)
+	cmd.PersistentFlags().StringArray("var-file", []string{}, `set values for variables defined in bundle config from a YAML or JSON file. Can be repeated, later files take precedence. Example: --var-file=vars.yml`)
 }
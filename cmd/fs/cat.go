@@ -1,16 +1,32 @@
 package fs
 
 import (
+	"context"
+
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
 	"github.com/spf13/cobra"
 )
 
+func cat(ctx context.Context, f filer.Filer, path string) error {
+	r, err := f.Read(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return cmdio.Render(ctx, r)
+}
+
 func newCatCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "cat FILE_PATH",
-		Short:   "Show file content.",
-		Long:    `Show the contents of a file in DBFS or a UC Volume.`,
+		Use:   "cat FILE_PATH",
+		Short: "Show file content.",
+		Long: `Show the contents of a file in DBFS or a UC Volume.
+
+	  If FILE_PATH contains a glob pattern, for example dbfs:/Volumes/main/raw/landing/*.csv,
+	  the contents of all matching files are shown, in lexicographical order of their paths.
+	`,
 		Args:    root.ExactArgs(1),
 		PreRunE: root.MustWorkspaceClient,
 	}
@@ -23,11 +39,21 @@ func newCatCommand() *cobra.Command {
 			return err
 		}
 
-		r, err := f.Read(ctx, path)
+		if !isGlob(ctx, f, path) {
+			return cat(ctx, f, path)
+		}
+
+		matches, err := globPaths(ctx, f, args[0], path)
 		if err != nil {
 			return err
 		}
-		return cmdio.Render(ctx, r)
+		for _, match := range matches {
+			err := cat(ctx, f, match)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 
 	v := newValidArgs()
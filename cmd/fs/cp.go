@@ -4,28 +4,68 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"net"
+	"net/http"
 	"path"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
+// Path argument that refers to standard input or standard output.
+const stdioPath = "-"
+
+// Default number of files that are copied in parallel by a recursive copy.
+// The Files API limits the number of concurrent requests per user, so we keep this low.
+const defaultCopyConcurrency = 4
+
+// Maximum number of attempts to copy a file if the API returns a transient error.
+const maxCopyAttempts = 5
+
+// Time to wait before the next attempt to copy a file. Variable so that tests can override it.
+var copyRetryBackoff = func(attempt int) time.Duration {
+	return min(time.Second<<attempt, 30*time.Second)
+}
+
+// Minimum time between progress updates for a file. Variable so that tests can override it.
+var copyProgressInterval = time.Second
+
 type copy struct {
-	overwrite bool
-	recursive bool
+	overwrite   bool
+	recursive   bool
+	resume      bool
+	concurrency int
 
 	ctx          context.Context
 	sourceFiler  filer.Filer
 	targetFiler  filer.Filer
 	sourceScheme string
 	targetScheme string
+
+	// Guards the output, because files are copied in parallel.
+	mu sync.Mutex
+}
+
+// fileToCopy is a file that is copied by a recursive copy.
+type fileToCopy struct {
+	sourcePath string
+	targetPath string
+	size       int64
 }
 
-func (c *copy) cpWriteCallback(sourceDir, targetDir string) fs.WalkDirFunc {
+func (c *copy) cpWriteCallback(sourceDir, targetDir string, files *[]fileToCopy) fs.WalkDirFunc {
 	return func(sourcePath string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -46,7 +86,18 @@ func (c *copy) cpWriteCallback(sourceDir, targetDir string) fs.WalkDirFunc {
 			return c.targetFiler.Mkdir(c.ctx, targetPath)
 		}
 
-		return c.cpFileToFile(sourcePath, targetPath)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		// Files are copied once the directory tree has been created.
+		*files = append(*files, fileToCopy{
+			sourcePath: sourcePath,
+			targetPath: targetPath,
+			size:       info.Size(),
+		})
+		return nil
 	}
 }
 
@@ -55,74 +106,324 @@ func (c *copy) cpDirToDir(sourceDir, targetDir string) error {
 		return fmt.Errorf("source path %s is a directory. Please specify the --recursive flag", sourceDir)
 	}
 
+	var files []fileToCopy
+	sourceFs := filer.NewFS(c.ctx, c.sourceFiler)
+	err := fs.WalkDir(sourceFs, sourceDir, c.cpWriteCallback(sourceDir, targetDir, &files))
+	if err != nil {
+		return err
+	}
+
+	return c.cpFiles(files)
+}
+
+// cpGlobToDir copies the paths that match a glob pattern to the target directory.
+// The matches keep their path relative to the directory the pattern starts in,
+// so that files with the same name in different directories don't collide.
+func (c *copy) cpGlobToDir(baseDir string, sourcePaths []string, targetDir string) error {
+	info, err := c.targetFiler.Stat(c.ctx, targetDir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// Proceed; the directory is created below.
+	case err != nil:
+		return err
+	case !info.IsDir():
+		return fmt.Errorf("target path %s must be a directory when copying multiple files", c.fullTargetPath(targetDir))
+	}
+
+	var files []fileToCopy
+	var dirs []string
+	createdDirs := make(map[string]bool)
 	sourceFs := filer.NewFS(c.ctx, c.sourceFiler)
-	return fs.WalkDir(sourceFs, sourceDir, c.cpWriteCallback(sourceDir, targetDir))
+	for _, sourcePath := range sourcePaths {
+		// Skip matches in directories that are copied recursively.
+		if slices.ContainsFunc(dirs, func(dir string) bool { return strings.HasPrefix(sourcePath, dir+"/") }) {
+			continue
+		}
+
+		relPath, err := filepath.Rel(baseDir, sourcePath)
+		if err != nil {
+			return err
+		}
+		targetPath := path.Join(targetDir, filepath.ToSlash(relPath))
+
+		info, err := c.sourceFiler.Stat(c.ctx, sourcePath)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if !c.recursive {
+				cmdio.LogString(c.ctx, fmt.Sprintf("Skipping directory %s. Please specify the --recursive flag to copy it", c.fullSourcePath(sourcePath)))
+				continue
+			}
+			dirs = append(dirs, sourcePath)
+			err = fs.WalkDir(sourceFs, sourcePath, c.cpWriteCallback(sourcePath, targetPath, &files))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Create the parent directory of the file, which may be nested below the target directory.
+		if dir := path.Dir(targetPath); !createdDirs[dir] {
+			err = c.targetFiler.Mkdir(c.ctx, dir)
+			if err != nil {
+				return err
+			}
+			createdDirs[dir] = true
+		}
+
+		files = append(files, fileToCopy{
+			sourcePath: sourcePath,
+			targetPath: targetPath,
+			size:       info.Size(),
+		})
+	}
+
+	return c.cpFiles(files)
 }
 
-func (c *copy) cpFileToDir(sourcePath, targetDir string) error {
+// cpFiles copies files in parallel.
+func (c *copy) cpFiles(files []fileToCopy) error {
+	group, groupCtx := errgroup.WithContext(c.ctx)
+	group.SetLimit(c.concurrency)
+
+	for _, file := range files {
+		// Skip the file if the context has already been cancelled.
+		select {
+		case <-groupCtx.Done():
+			continue
+		default:
+			// Proceed.
+		}
+
+		group.Go(func() error {
+			return c.cpFileToFile(groupCtx, file.sourcePath, file.targetPath, file.size)
+		})
+	}
+
+	// Wait for the files to be copied and return the first non-nil error.
+	return group.Wait()
+}
+
+func (c *copy) cpFileToDir(ctx context.Context, sourcePath, targetDir string, size int64) error {
 	fileName := filepath.Base(sourcePath)
 	targetPath := path.Join(targetDir, fileName)
 
-	return c.cpFileToFile(sourcePath, targetPath)
+	return c.cpFileToFile(ctx, sourcePath, targetPath, size)
 }
 
-func (c *copy) cpFileToFile(sourcePath, targetPath string) error {
-	// Get reader for file at source path
-	r, err := c.sourceFiler.Read(c.ctx, sourcePath)
-	if err != nil {
-		return err
+// cpFileToFile copies a file and retries transient errors. The context is
+// cancelled when a concurrent copy fails, to stop the copy early.
+func (c *copy) cpFileToFile(ctx context.Context, sourcePath, targetPath string, size int64) error {
+	overwrite := c.overwrite
+
+	// A failed attempt may have created the target file. The next attempt may only
+	// overwrite it if it didn't exist before the first attempt, so that files that
+	// already existed are never overwritten without --overwrite.
+	created := false
+
+	// When resuming a copy, files that have the same size as the source file have been copied
+	// already. Files with a different size are partial copies, so they are overwritten.
+	if c.resume || !overwrite {
+		targetInfo, err := c.targetFiler.Stat(ctx, targetPath)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			created = true
+		case err != nil:
+			return err
+		case !c.resume:
+			// skip if file already exists
+			return c.emitFileSkippedEvent(sourcePath, targetPath)
+		case targetInfo.IsDir():
+			return fmt.Errorf("cannot overwrite directory %s with file %s", c.fullTargetPath(targetPath), c.fullSourcePath(sourcePath))
+		case targetInfo.Size() == size:
+			return c.emitFileSkippedEvent(sourcePath, targetPath)
+		default:
+			overwrite = true
+		}
 	}
-	defer r.Close()
 
-	if c.overwrite {
-		err = c.targetFiler.Write(c.ctx, targetPath, r, filer.OverwriteIfExists)
-		if err != nil {
+	for attempt := 1; ; attempt++ {
+		err := c.writeFile(ctx, sourcePath, targetPath, size, overwrite)
+		if err == nil {
+			break
+		}
+
+		// skip if file already exists
+		if errors.Is(err, fs.ErrExist) && !overwrite {
+			return c.emitFileSkippedEvent(sourcePath, targetPath)
+		}
+		if attempt >= maxCopyAttempts || !isTransientError(ctx, err) {
 			return err
 		}
+
+		backoff := copyRetryBackoff(attempt)
+		log.Warnf(ctx, "Failed to copy %s (attempt %d of %d), retrying in %s: %v", c.fullSourcePath(sourcePath), attempt, maxCopyAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		if created {
+			overwrite = true
+		}
+	}
+
+	return c.emitFileCopiedEvent(sourcePath, targetPath)
+}
+
+// cpReaderToFile copies a stream, such as standard input, to the target path.
+// The stream can be read only once, so the copy is not retried.
+func (c *copy) cpReaderToFile(r io.Reader, targetPath string) error {
+	targetInfo, err := c.targetFiler.Stat(c.ctx, targetPath)
+	if err == nil && targetInfo.IsDir() {
+		return fmt.Errorf("cannot copy standard input to directory %s. Please specify a file path", c.fullTargetPath(targetPath))
+	}
+
+	if c.overwrite {
+		err = c.targetFiler.Write(c.ctx, targetPath, r, filer.OverwriteIfExists)
 	} else {
 		err = c.targetFiler.Write(c.ctx, targetPath, r)
 		// skip if file already exists
-		if err != nil && errors.Is(err, fs.ErrExist) {
-			return c.emitFileSkippedEvent(sourcePath, targetPath)
+		if errors.Is(err, fs.ErrExist) {
+			return c.emitFileSkippedEvent(stdioPath, targetPath)
 		}
+	}
+	if err != nil {
+		return err
+	}
+	return c.emitFileCopiedEvent(stdioPath, targetPath)
+}
+
+// cpFilesToWriter writes the contents of the source files to a stream, such as standard output.
+// No events are emitted, because they would be mixed with the contents of the files.
+func (c *copy) cpFilesToWriter(sourcePaths []string, w io.Writer) error {
+	for _, sourcePath := range sourcePaths {
+		r, err := c.sourceFiler.Read(c.ctx, sourcePath)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(w, r)
+		r.Close()
 		if err != nil {
 			return err
 		}
 	}
-	return c.emitFileCopiedEvent(sourcePath, targetPath)
+	return nil
 }
 
-// TODO: emit these events on stderr
-// TODO: add integration tests for these events
-func (c *copy) emitFileSkippedEvent(sourcePath, targetPath string) error {
-	fullSourcePath := sourcePath
-	if c.sourceScheme != "" {
-		fullSourcePath = path.Join(c.sourceScheme+":", sourcePath)
+// writeFile makes a single attempt to copy a file. The source file is opened
+// for every attempt, because a failed attempt may have consumed part of it.
+func (c *copy) writeFile(ctx context.Context, sourcePath, targetPath string, size int64, overwrite bool) error {
+	// Get reader for file at source path
+	r, err := c.sourceFiler.Read(ctx, sourcePath)
+	if err != nil {
+		return err
 	}
-	fullTargetPath := targetPath
-	if c.targetScheme != "" {
-		fullTargetPath = path.Join(c.targetScheme+":", targetPath)
+	defer r.Close()
+
+	pr := &progressReader{
+		r: r,
+		c: c,
+		event: fileProgressEvent{
+			SourcePath: c.fullSourcePath(sourcePath),
+			TargetPath: c.fullTargetPath(targetPath),
+			TotalBytes: size,
+			Type:       EventTypeFileProgress,
+		},
+		lastUpdate: time.Now(),
 	}
 
-	event := newFileSkippedEvent(fullSourcePath, fullTargetPath)
-	template := "{{.SourcePath}} -> {{.TargetPath}} (skipped; already exists)\n"
+	if overwrite {
+		return c.targetFiler.Write(ctx, targetPath, pr, filer.OverwriteIfExists)
+	}
+	return c.targetFiler.Write(ctx, targetPath, pr)
+}
 
-	return cmdio.RenderWithTemplate(c.ctx, event, "", template)
+// isTransientError returns true if copying a file can succeed if it is retried.
+func isTransientError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var aerr *apierr.APIError
+	if errors.As(err, &aerr) {
+		switch aerr.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return aerr.IsRetriable(ctx)
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) {
+		return true
+	}
+
+	return errors.Is(err, io.ErrUnexpectedEOF)
 }
 
-func (c *copy) emitFileCopiedEvent(sourcePath, targetPath string) error {
-	fullSourcePath := sourcePath
-	if c.sourceScheme != "" {
-		fullSourcePath = path.Join(c.sourceScheme+":", sourcePath)
+// progressReader logs the progress of a copy while the source file is read.
+type progressReader struct {
+	r     io.Reader
+	c     *copy
+	event fileProgressEvent
+
+	// Time of the last progress update. Files that are copied within
+	// the progress interval don't log any progress.
+	lastUpdate time.Time
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.event.BytesCopied += int64(n)
+	if time.Since(p.lastUpdate) >= copyProgressInterval {
+		p.lastUpdate = time.Now()
+		event := p.event
+		p.c.mu.Lock()
+		cmdio.Log(p.c.ctx, &event)
+		p.c.mu.Unlock()
 	}
-	fullTargetPath := targetPath
-	if c.targetScheme != "" {
-		fullTargetPath = path.Join(c.targetScheme+":", targetPath)
+	return n, err
+}
+
+func (c *copy) fullSourcePath(sourcePath string) string {
+	if c.sourceScheme == "" {
+		return sourcePath
 	}
+	return path.Join(c.sourceScheme+":", sourcePath)
+}
+
+func (c *copy) fullTargetPath(targetPath string) string {
+	if c.targetScheme == "" {
+		return targetPath
+	}
+	return path.Join(c.targetScheme+":", targetPath)
+}
+
+// TODO: emit these events on stderr
+// TODO: add integration tests for these events
+func (c *copy) emitFileSkippedEvent(sourcePath, targetPath string) error {
+	event := newFileSkippedEvent(c.fullSourcePath(sourcePath), c.fullTargetPath(targetPath))
+	template := "{{.SourcePath}} -> {{.TargetPath}} (skipped; already exists)\n"
 
-	event := newFileCopiedEvent(fullSourcePath, fullTargetPath)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return cmdio.RenderWithTemplate(c.ctx, event, "", template)
+}
+
+func (c *copy) emitFileCopiedEvent(sourcePath, targetPath string) error {
+	event := newFileCopiedEvent(c.fullSourcePath(sourcePath), c.fullTargetPath(targetPath))
 	template := "{{.SourcePath}} -> {{.TargetPath}}\n"
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return cmdio.RenderWithTemplate(c.ctx, event, "", template)
 }
 
@@ -134,12 +435,30 @@ func newCpCommand() *cobra.Command {
 
 	  For paths in DBFS and UC Volumes, it is required that you specify the "dbfs" scheme.
 	  For example: dbfs:/foo/bar.
+	  For paths in the workspace, specify the "workspace" scheme.
+	  For example: workspace:/Users/someone@example.com/foo.
 
 	  Recursively copying a directory will copy all files inside directory
 	  at SOURCE_PATH to the directory at TARGET_PATH.
 
 	  When copying a file, if TARGET_PATH is a directory, the file will be created
 	  inside the directory, otherwise the file is created at TARGET_PATH.
+
+	  Files in a directory are copied in parallel. Use --concurrency to control the
+	  number of files that are copied at the same time. Copies that fail because of
+	  a transient error are retried.
+
+	  If SOURCE_PATH contains a glob pattern, for example dbfs:/Volumes/main/raw/landing/*.json,
+	  all matching files are copied to the directory at TARGET_PATH. The pattern "**"
+	  matches any number of directories, for example dbfs:/Volumes/main/raw/**/*.parquet.
+	  Matches keep their path relative to the first directory in the pattern.
+
+	  Specify - as SOURCE_PATH to copy standard input to a file, or as TARGET_PATH
+	  to write the contents of files to standard output.
+
+	  Use --resume to continue an interrupted copy. Files that already exist at
+	  TARGET_PATH with the same size as the source file are skipped, other files
+	  are overwritten.
 	`,
 		Args:    root.ExactArgs(2),
 		PreRunE: root.MustWorkspaceClient,
@@ -148,10 +467,16 @@ func newCpCommand() *cobra.Command {
 	var c copy
 	cmd.Flags().BoolVar(&c.overwrite, "overwrite", false, "overwrite existing files")
 	cmd.Flags().BoolVarP(&c.recursive, "recursive", "r", false, "recursively copy files from directory")
+	cmd.Flags().BoolVar(&c.resume, "resume", false, "skip files that already exist with the same size and overwrite others")
+	cmd.Flags().IntVar(&c.concurrency, "concurrency", defaultCopyConcurrency, "number of files to copy in parallel")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
+		if c.concurrency < 1 {
+			return fmt.Errorf("--concurrency must be at least 1")
+		}
+
 		// Get source filer and source path without scheme
 		fullSourcePath := args[0]
 		sourceFiler, sourcePath, err := filerForPath(ctx, fullSourcePath)
@@ -170,15 +495,47 @@ func newCpCommand() *cobra.Command {
 		if isDbfsPath(fullSourcePath) {
 			c.sourceScheme = "dbfs"
 		}
+		if isWorkspacePath(fullSourcePath) {
+			c.sourceScheme = "workspace"
+		}
 		c.targetScheme = ""
 		if isDbfsPath(fullTargetPath) {
 			c.targetScheme = "dbfs"
 		}
+		if isWorkspacePath(fullTargetPath) {
+			c.targetScheme = "workspace"
+		}
 
 		c.ctx = ctx
 		c.sourceFiler = sourceFiler
 		c.targetFiler = targetFiler
 
+		// Copy from standard input or to standard output.
+		switch {
+		case fullSourcePath == stdioPath && fullTargetPath == stdioPath:
+			return fmt.Errorf("source and target path cannot both be %s", stdioPath)
+		case fullSourcePath == stdioPath:
+			return c.cpReaderToFile(cmd.InOrStdin(), targetPath)
+		case fullTargetPath == stdioPath:
+			sourcePaths := []string{sourcePath}
+			if isGlob(ctx, sourceFiler, sourcePath) {
+				sourcePaths, err = globPaths(ctx, sourceFiler, fullSourcePath, sourcePath)
+				if err != nil {
+					return err
+				}
+			}
+			return c.cpFilesToWriter(sourcePaths, cmd.OutOrStdout())
+		}
+
+		// case 0: source path is a glob pattern, then copy all matches to the target directory
+		if isGlob(ctx, sourceFiler, sourcePath) {
+			matches, err := globPaths(ctx, sourceFiler, fullSourcePath, sourcePath)
+			if err != nil {
+				return err
+			}
+			return c.cpGlobToDir(filer.GlobBase(sourcePath), matches, targetPath)
+		}
+
 		// Get information about file at source path
 		sourceInfo, err := sourceFiler.Stat(ctx, sourcePath)
 		if err != nil {
@@ -193,11 +550,11 @@ func newCpCommand() *cobra.Command {
 		// case 2: source path is a file, and target path is a directory. In this case
 		// we copy the file to inside the directory
 		if targetInfo, err := targetFiler.Stat(ctx, targetPath); err == nil && targetInfo.IsDir() {
-			return c.cpFileToDir(sourcePath, targetPath)
+			return c.cpFileToDir(ctx, sourcePath, targetPath, sourceInfo.Size())
 		}
 
 		// case 3: source path is a file, and target path is a file
-		return c.cpFileToFile(sourcePath, targetPath)
+		return c.cpFileToFile(ctx, sourcePath, targetPath, sourceInfo.Size())
 	}
 
 	v := newValidArgs()
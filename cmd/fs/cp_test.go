@@ -0,0 +1,319 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingFiler fails the first writes with the specified error.
+type failingFiler struct {
+	filer.Filer
+
+	failures atomic.Int32
+	err      error
+
+	// If set, failed writes leave a partial file behind.
+	partial bool
+}
+
+func (f *failingFiler) Write(ctx context.Context, name string, reader io.Reader, mode ...filer.WriteMode) error {
+	if f.failures.Add(-1) >= 0 {
+		// Consume part of the reader to simulate a failed upload.
+		buf := make([]byte, 1)
+		_, _ = reader.Read(buf)
+		if f.partial {
+			err := f.Filer.Write(ctx, name, bytes.NewReader(buf), mode...)
+			if err != nil {
+				return err
+			}
+		}
+		return f.err
+	}
+	return f.Filer.Write(ctx, name, reader, mode...)
+}
+
+func setupTestCopy(t *testing.T, c *copy) *bytes.Buffer {
+	var out bytes.Buffer
+	ctx := cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputText, nil, &out, &out, "", ""))
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	c.ctx = ctx
+	c.sourceFiler = f
+	if c.targetFiler == nil {
+		c.targetFiler = f
+	}
+	if c.concurrency == 0 {
+		c.concurrency = defaultCopyConcurrency
+	}
+
+	backoff := copyRetryBackoff
+	copyRetryBackoff = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { copyRetryBackoff = backoff })
+	return &out
+}
+
+func TestCopyDirToDirInParallel(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := filepath.Join(t.TempDir(), "target")
+
+	for i := range 20 {
+		writeTestFile(t, filepath.Join(sourceDir, fmt.Sprintf("dir%d", i%3), fmt.Sprintf("%d.txt", i)), fmt.Sprint(i), time.Now())
+	}
+
+	c := &copy{recursive: true, concurrency: 8}
+	out := setupTestCopy(t, c)
+	require.NoError(t, c.cpDirToDir(filepath.ToSlash(sourceDir), filepath.ToSlash(targetDir)))
+
+	for i := range 20 {
+		data, err := os.ReadFile(filepath.Join(targetDir, fmt.Sprintf("dir%d", i%3), fmt.Sprintf("%d.txt", i)))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprint(i), string(data))
+	}
+	assert.Equal(t, 20, bytes.Count(out.Bytes(), []byte("\n")))
+}
+
+func TestCopyResume(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(sourceDir, "copied.txt"), "copied", time.Now())
+	writeTestFile(t, filepath.Join(targetDir, "copied.txt"), "COPIED", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "partial.txt"), "complete", time.Now())
+	writeTestFile(t, filepath.Join(targetDir, "partial.txt"), "comp", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "missing.txt"), "missing", time.Now())
+
+	c := &copy{recursive: true, resume: true}
+	out := setupTestCopy(t, c)
+	require.NoError(t, c.cpDirToDir(filepath.ToSlash(sourceDir), filepath.ToSlash(targetDir)))
+	assert.Contains(t, out.String(), "copied.txt (skipped; already exists)")
+
+	// Files with the same size are assumed to be copied already.
+	data, err := os.ReadFile(filepath.Join(targetDir, "copied.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "COPIED", string(data))
+
+	data, err = os.ReadFile(filepath.Join(targetDir, "partial.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "complete", string(data))
+
+	data, err = os.ReadFile(filepath.Join(targetDir, "missing.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "missing", string(data))
+}
+
+func TestCopyRetriesTransientErrors(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+	writeTestFile(t, filepath.Join(sourceDir, "file.txt"), "content", time.Now())
+
+	local, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	target := &failingFiler{
+		Filer: local,
+		err:   &apierr.APIError{StatusCode: http.StatusServiceUnavailable, Message: "unavailable"},
+	}
+	target.failures.Store(2)
+
+	c := &copy{targetFiler: target}
+	out := setupTestCopy(t, c)
+	require.NoError(t, c.cpFileToFile(c.ctx, filepath.ToSlash(filepath.Join(sourceDir, "file.txt")), filepath.ToSlash(filepath.Join(targetDir, "file.txt")), 7))
+	assert.NotContains(t, out.String(), "skipped")
+
+	data, err := os.ReadFile(filepath.Join(targetDir, "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+}
+
+func TestCopyRetryOverwritesPartialFile(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+	writeTestFile(t, filepath.Join(sourceDir, "file.txt"), "content", time.Now())
+
+	local, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	target := &failingFiler{
+		Filer:   local,
+		err:     &apierr.APIError{StatusCode: http.StatusServiceUnavailable, Message: "unavailable"},
+		partial: true,
+	}
+	target.failures.Store(1)
+
+	// The file created by the failed attempt is overwritten by the next attempt.
+	c := &copy{targetFiler: target}
+	out := setupTestCopy(t, c)
+	require.NoError(t, c.cpFileToFile(c.ctx, filepath.ToSlash(filepath.Join(sourceDir, "file.txt")), filepath.ToSlash(filepath.Join(targetDir, "file.txt")), 7))
+	assert.NotContains(t, out.String(), "skipped")
+
+	data, err := os.ReadFile(filepath.Join(targetDir, "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+}
+
+func TestCopyDoesNotOverwriteExistingFile(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+	writeTestFile(t, filepath.Join(sourceDir, "file.txt"), "content", time.Now())
+	writeTestFile(t, filepath.Join(targetDir, "file.txt"), "existing", time.Now())
+
+	local, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	target := &failingFiler{
+		Filer: local,
+		err:   &apierr.APIError{StatusCode: http.StatusServiceUnavailable, Message: "unavailable"},
+	}
+	target.failures.Store(1)
+
+	// Files that exist before the copy are skipped without --overwrite.
+	c := &copy{targetFiler: target}
+	out := setupTestCopy(t, c)
+	require.NoError(t, c.cpFileToFile(c.ctx, filepath.ToSlash(filepath.Join(sourceDir, "file.txt")), filepath.ToSlash(filepath.Join(targetDir, "file.txt")), 7))
+	assert.Contains(t, out.String(), "skipped; already exists")
+
+	data, err := os.ReadFile(filepath.Join(targetDir, "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "existing", string(data))
+}
+
+func TestCopyDoesNotRetryOtherErrors(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+	writeTestFile(t, filepath.Join(sourceDir, "file.txt"), "content", time.Now())
+
+	local, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	target := &failingFiler{
+		Filer: local,
+		err:   &apierr.APIError{StatusCode: http.StatusForbidden, Message: "forbidden"},
+	}
+	target.failures.Store(1)
+
+	c := &copy{targetFiler: target}
+	setupTestCopy(t, c)
+	err = c.cpFileToFile(c.ctx, filepath.ToSlash(filepath.Join(sourceDir, "file.txt")), filepath.ToSlash(filepath.Join(targetDir, "file.txt")), 7)
+	assert.ErrorContains(t, err, "forbidden")
+	assert.NoFileExists(t, filepath.Join(targetDir, "file.txt"))
+}
+
+func TestProgressReaderLogsProgress(t *testing.T) {
+	var out bytes.Buffer
+	logger := cmdio.NewLogger(flags.ModeAppend)
+	logger.Writer = &out
+
+	interval := copyProgressInterval
+	copyProgressInterval = 0
+	t.Cleanup(func() { copyProgressInterval = interval })
+
+	pr := &progressReader{
+		r: bytes.NewBufferString("0123456789"),
+		c: &copy{ctx: cmdio.NewContext(context.Background(), logger)},
+		event: fileProgressEvent{
+			SourcePath: "source",
+			TargetPath: "target",
+			TotalBytes: 10,
+		},
+	}
+	_, err := pr.Read(make([]byte, 5))
+	require.NoError(t, err)
+	assert.Equal(t, "source -> target (50%, 5 of 10 bytes)\n", out.String())
+}
+
+func runTestCpCommand(t *testing.T, stdin string, args ...string) string {
+	var out bytes.Buffer
+	ctx := cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputText, nil, &out, &out, "", ""))
+
+	cmd := newCpCommand()
+	cmd.SetContext(ctx)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(&out)
+	require.NoError(t, cmd.ParseFlags(args))
+	require.NoError(t, cmd.RunE(cmd, cmd.Flags().Args()))
+	return out.String()
+}
+
+func TestCpGlob(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := filepath.Join(t.TempDir(), "target")
+
+	writeTestFile(t, filepath.Join(sourceDir, "a.json"), "a", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "b.csv"), "b", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "2024", "c.json"), "c", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "2024", "01", "d.json"), "d", time.Now())
+
+	runTestCpCommand(t, "", filepath.ToSlash(sourceDir)+"/**/*.json", filepath.ToSlash(targetDir))
+	assert.FileExists(t, filepath.Join(targetDir, "a.json"))
+	assert.FileExists(t, filepath.Join(targetDir, "2024", "c.json"))
+	assert.FileExists(t, filepath.Join(targetDir, "2024", "01", "d.json"))
+	assert.NoFileExists(t, filepath.Join(targetDir, "b.csv"))
+}
+
+func TestCpGlobDirectories(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(sourceDir, "dir1", "a.txt"), "a", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "dir2", "sub", "b.txt"), "b", time.Now())
+
+	// Directories are skipped without --recursive.
+	runTestCpCommand(t, "", filepath.ToSlash(sourceDir)+"/dir*", filepath.ToSlash(targetDir))
+	assert.NoDirExists(t, filepath.Join(targetDir, "dir1"))
+
+	runTestCpCommand(t, "", "-r", filepath.ToSlash(sourceDir)+"/dir*", filepath.ToSlash(targetDir))
+	assert.FileExists(t, filepath.Join(targetDir, "dir1", "a.txt"))
+	assert.FileExists(t, filepath.Join(targetDir, "dir2", "sub", "b.txt"))
+}
+
+func TestCpGlobNoMatches(t *testing.T) {
+	var out bytes.Buffer
+	ctx := cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputText, nil, &out, &out, "", ""))
+
+	cmd := newCpCommand()
+	cmd.SetContext(ctx)
+	pattern := filepath.ToSlash(t.TempDir()) + "/*.json"
+	err := cmd.RunE(cmd, []string{pattern, filepath.ToSlash(t.TempDir())})
+	assert.EqualError(t, err, "no matches found: "+pattern)
+}
+
+func TestCpStdinToFile(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "data.csv")
+
+	out := runTestCpCommand(t, "a,b\n1,2\n", "-", filepath.ToSlash(target))
+	assert.Equal(t, "- -> "+filepath.ToSlash(target)+"\n", out)
+
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "a,b\n1,2\n", string(data))
+
+	out = runTestCpCommand(t, "other", "-", filepath.ToSlash(target))
+	assert.Contains(t, out, "(skipped; already exists)")
+
+	runTestCpCommand(t, "other", "--overwrite", "-", filepath.ToSlash(target))
+	data, err = os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "other", string(data))
+}
+
+func TestCpFilesToStdout(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeTestFile(t, filepath.Join(sourceDir, "a.txt"), "a\n", time.Now())
+	writeTestFile(t, filepath.Join(sourceDir, "b.txt"), "b\n", time.Now())
+
+	out := runTestCpCommand(t, "", filepath.ToSlash(sourceDir)+"/*.txt", "-")
+	assert.Equal(t, "a\nb\n", out)
+}
@@ -0,0 +1,142 @@
+package fs
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+type duEntry struct {
+	Path  string `json:"path"`
+	Size  int64  `json:"size"`
+	Files int    `json:"files"`
+
+	// Size in text output, which is human-readable if requested.
+	DisplaySize string `json:"-"`
+}
+
+// formatSize returns a human-readable size, for example 1.5M.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit && exp < 3; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%c", float64(size)/float64(div), "KMGT"[exp])
+}
+
+// diskUsage returns the total size of the files below every directory below dir, including dir itself.
+// If summarize is true, it only returns the total size of dir. The entries are sorted by path.
+func diskUsage(fsys fs.FS, dir string, summarize bool) ([]*duEntry, error) {
+	var dirs []string
+	entries := make(map[string]*duEntry)
+
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if p == dir || !summarize {
+				dirs = append(dirs, p)
+				entries[p] = &duEntry{Path: p}
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		// The size of a file counts towards all directories that contain it.
+		// If dir is a file, it is the only entry.
+		if p == dir {
+			entries[p] = &duEntry{Path: p}
+			dirs = append(dirs, p)
+		}
+		for q := p; ; q = path.Dir(q) {
+			if e, ok := entries[q]; ok {
+				e.Size += info.Size()
+				e.Files++
+			}
+			if q == dir || q == path.Dir(q) {
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*duEntry, len(dirs))
+	for i, p := range dirs {
+		result[i] = entries[p]
+	}
+	return result, nil
+}
+
+func newDuCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "du PATH",
+		Short: "Show disk usage.",
+		Long: `Show the total size of the files in every directory below PATH, recursively.
+
+	  Use -s to only show the total size of PATH.
+	`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var summarize bool
+	var humanReadable bool
+	cmd.Flags().BoolVarP(&summarize, "summarize", "s", false, "only show the total size of PATH")
+	cmd.Flags().BoolVarP(&humanReadable, "human-readable", "h", false, "show sizes in human-readable format, for example 1.5M")
+
+	// The -h shorthand is used by --human-readable, like in du(1), so help is only available as --help.
+	cmd.Flags().Bool("help", false, "help for du")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		f, p, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		entries, err := diskUsage(filer.NewFS(ctx, f), path.Clean(p), summarize)
+		if err != nil {
+			return err
+		}
+
+		prefix := schemePrefix(args[0])
+		for _, e := range entries {
+			e.Path = prefix + e.Path
+			e.DisplaySize = fmt.Sprint(e.Size)
+			if humanReadable {
+				e.DisplaySize = formatSize(e.Size)
+			}
+		}
+
+		return cmdio.RenderWithTemplate(ctx, entries, "", cmdio.Heredoc(`
+		{{range .}}{{.DisplaySize}}	{{.Path}}
+		{{end}}
+		`))
+	}
+
+	v := newValidArgs()
+	v.onlyDirs = true
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package fs
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0", formatSize(0))
+	assert.Equal(t, "1023", formatSize(1023))
+	assert.Equal(t, "1.0K", formatSize(1024))
+	assert.Equal(t, "1.5M", formatSize(3*1024*1024/2))
+	assert.Equal(t, "2.0G", formatSize(2*1024*1024*1024))
+	assert.Equal(t, "2048.0T", formatSize(2*1024*1024*1024*1024*1024))
+}
+
+func TestDiskUsage(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "a.txt"), "aaaa", time.Now())
+	writeTestFile(t, filepath.Join(dir, "sub", "b.txt"), "bb", time.Now())
+	writeTestFile(t, filepath.Join(dir, "sub", "nested", "c.txt"), "c", time.Now())
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	fsys := filer.NewFS(context.Background(), f)
+	root := filepath.ToSlash(dir)
+
+	entries, err := diskUsage(fsys, root, false)
+	require.NoError(t, err)
+	assert.Equal(t, []*duEntry{
+		{Path: root, Size: 7, Files: 3},
+		{Path: root + "/sub", Size: 3, Files: 2},
+		{Path: root + "/sub/nested", Size: 1, Files: 1},
+	}, entries)
+
+	entries, err = diskUsage(fsys, root, true)
+	require.NoError(t, err)
+	assert.Equal(t, []*duEntry{{Path: root, Size: 7, Files: 3}}, entries)
+
+	entries, err = diskUsage(fsys, root+"/a.txt", false)
+	require.NoError(t, err)
+	assert.Equal(t, []*duEntry{{Path: root + "/a.txt", Size: 4, Files: 1}}, entries)
+}
@@ -1,5 +1,7 @@
 package fs
 
+import "fmt"
+
 type fileIOEvent struct {
 	SourcePath string    `json:"source_path,omitempty"`
 	TargetPath string    `json:"target_path,omitempty"`
@@ -9,8 +11,10 @@ type fileIOEvent struct {
 type EventType string
 
 const (
-	EventTypeFileCopied  = EventType("FILE_COPIED")
-	EventTypeFileSkipped = EventType("FILE_SKIPPED")
+	EventTypeFileCopied   = EventType("FILE_COPIED")
+	EventTypeFileSkipped  = EventType("FILE_SKIPPED")
+	EventTypeFileDeleted  = EventType("FILE_DELETED")
+	EventTypeFileProgress = EventType("FILE_PROGRESS")
 )
 
 func newFileCopiedEvent(sourcePath, targetPath string) fileIOEvent {
@@ -28,3 +32,31 @@ func newFileSkippedEvent(sourcePath, targetPath string) fileIOEvent {
 		Type:       EventTypeFileSkipped,
 	}
 }
+
+func newFileDeletedEvent(targetPath string) fileIOEvent {
+	return fileIOEvent{
+		TargetPath: targetPath,
+		Type:       EventTypeFileDeleted,
+	}
+}
+
+// fileProgressEvent is logged periodically while a large file is copied.
+type fileProgressEvent struct {
+	SourcePath  string    `json:"source_path,omitempty"`
+	TargetPath  string    `json:"target_path,omitempty"`
+	BytesCopied int64     `json:"bytes_copied"`
+	TotalBytes  int64     `json:"total_bytes"`
+	Type        EventType `json:"type"`
+}
+
+func (event *fileProgressEvent) String() string {
+	if event.TotalBytes <= 0 {
+		return fmt.Sprintf("%s -> %s (%d bytes)", event.SourcePath, event.TargetPath, event.BytesCopied)
+	}
+	percent := event.BytesCopied * 100 / event.TotalBytes
+	return fmt.Sprintf("%s -> %s (%d%%, %d of %d bytes)", event.SourcePath, event.TargetPath, percent, event.BytesCopied, event.TotalBytes)
+}
+
+func (event *fileProgressEvent) IsInplaceSupported() bool {
+	return true
+}
@@ -0,0 +1,238 @@
+package fs
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+// Suffixes of sizes, in powers of 1024.
+var sizeSuffixes = map[string]int64{
+	"":  1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+}
+
+// parseSize parses a size in bytes with an optional suffix, for example 10M.
+// Suffixes are powers of 1024 and can be followed by "B" or "iB".
+func parseSize(s string) (int64, error) {
+	v := strings.ToUpper(strings.TrimSpace(s))
+	v = strings.TrimSuffix(strings.TrimSuffix(v, "B"), "I")
+
+	suffix := ""
+	if len(v) > 0 && strings.ContainsAny(v[len(v)-1:], "KMGT") {
+		suffix = v[len(v)-1:]
+		v = v[:len(v)-1]
+	}
+
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q, expected a number of bytes with an optional suffix K, M, G or T", s)
+	}
+	return int64(n * float64(sizeSuffixes[suffix])), nil
+}
+
+// parseTime parses a point in time. This is either a date, a timestamp in RFC 3339 format,
+// or an age relative to now, for example 30m, 12h or 7d.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err == nil {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, expected an age like 7d or 12h, a date like 2024-01-31, or an RFC 3339 timestamp", s)
+}
+
+type finder struct {
+	name     string
+	fileType string
+	newer    time.Time
+	older    time.Time
+	minSize  int64
+	maxSize  int64
+}
+
+// matches returns true if the file or directory satisfies all conditions.
+func (f *finder) matches(d fs.DirEntry, info fs.FileInfo) bool {
+	if f.name != "" {
+		if ok, _ := path.Match(f.name, d.Name()); !ok {
+			return false
+		}
+	}
+	switch f.fileType {
+	case "f":
+		if d.IsDir() {
+			return false
+		}
+	case "d":
+		if !d.IsDir() {
+			return false
+		}
+	}
+	if !f.newer.IsZero() && !info.ModTime().After(f.newer) {
+		return false
+	}
+	if !f.older.IsZero() && !info.ModTime().Before(f.older) {
+		return false
+	}
+
+	// The size of a directory doesn't include its contents, so sizes only match files.
+	if f.minSize > 0 || f.maxSize > 0 {
+		if d.IsDir() {
+			return false
+		}
+		if f.minSize > 0 && info.Size() < f.minSize {
+			return false
+		}
+		if f.maxSize > 0 && info.Size() > f.maxSize {
+			return false
+		}
+	}
+	return true
+}
+
+// find returns the files and directories below dir that satisfy all conditions.
+// Their names are prefixed with the specified prefix, which includes the scheme.
+func (f *finder) find(fsys fs.FS, dir string, prefix string) ([]jsonDirEntry, error) {
+	entries := make([]jsonDirEntry, 0)
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// The directory itself is not a result, like the files and directories in it.
+		if p == dir && d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !f.matches(d, info) {
+			return nil
+		}
+
+		entries = append(entries, jsonDirEntry{
+			Name:    prefix + p,
+			IsDir:   d.IsDir(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+		return nil
+	})
+	return entries, err
+}
+
+func newFindCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find PATH",
+		Short: "Find files and directories.",
+		Long: `Recursively find files and directories below PATH that satisfy all conditions.
+
+	  Use --name to match the name of files and directories with a glob pattern.
+	  The values of --newer and --older are either an age, for example 30m, 12h or 7d,
+	  a date like 2024-01-31, or a timestamp in RFC 3339 format.
+	  The values of --min-size and --max-size are in bytes, with an optional suffix
+	  K, M, G or T. They only match files.
+	`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var f finder
+	var newer, older, minSize, maxSize string
+	var long bool
+	cmd.Flags().StringVar(&f.name, "name", "", "only find files and directories with a name that matches this glob pattern")
+	cmd.Flags().StringVar(&f.fileType, "type", "", "only find files (f) or directories (d)")
+	cmd.Flags().StringVar(&newer, "newer", "", "only find files and directories modified after this time")
+	cmd.Flags().StringVar(&older, "older", "", "only find files and directories modified before this time")
+	cmd.Flags().StringVar(&minSize, "min-size", "", "only find files of at least this size")
+	cmd.Flags().StringVar(&maxSize, "max-size", "", "only find files of at most this size")
+	cmd.Flags().BoolVarP(&long, "long", "l", false, "Displays full information including size, file type and modification time.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		now := time.Now()
+
+		if _, err := path.Match(f.name, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", f.name, err)
+		}
+		if f.fileType != "" && f.fileType != "f" && f.fileType != "d" {
+			return fmt.Errorf("invalid type %q, expected f or d", f.fileType)
+		}
+
+		var err error
+		if newer != "" {
+			f.newer, err = parseTime(newer, now)
+			if err != nil {
+				return err
+			}
+		}
+		if older != "" {
+			f.older, err = parseTime(older, now)
+			if err != nil {
+				return err
+			}
+		}
+		if minSize != "" {
+			f.minSize, err = parseSize(minSize)
+			if err != nil {
+				return err
+			}
+		}
+		if maxSize != "" {
+			f.maxSize, err = parseSize(maxSize)
+			if err != nil {
+				return err
+			}
+		}
+
+		fl, p, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		entries, err := f.find(filer.NewFS(ctx, fl), path.Clean(p), schemePrefix(args[0]))
+		if err != nil {
+			return err
+		}
+
+		if long {
+			return cmdio.RenderWithTemplate(ctx, entries, "", cmdio.Heredoc(`
+			{{range .}}{{if .IsDir}}DIRECTORY {{else}}FILE      {{end}}{{.Size}} {{.ModTime|pretty_date}} {{.Name}}
+			{{end}}
+			`))
+		}
+		return cmdio.RenderWithTemplate(ctx, entries, "", cmdio.Heredoc(`
+		{{range .}}{{.Name}}
+		{{end}}
+		`))
+	}
+
+	v := newValidArgs()
+	v.onlyDirs = true
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
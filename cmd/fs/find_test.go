@@ -0,0 +1,91 @@
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSize(t *testing.T) {
+	for s, expected := range map[string]int64{
+		"100":    100,
+		"10K":    10 * 1024,
+		"10kb":   10 * 1024,
+		"1.5M":   3 * 1024 * 1024 / 2,
+		"2GiB":   2 * 1024 * 1024 * 1024,
+		"1T":     1024 * 1024 * 1024 * 1024,
+		"0":      0,
+		" 12 ":   12,
+		"5B":     5,
+		"1.5MiB": 3 * 1024 * 1024 / 2,
+	} {
+		size, err := parseSize(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, expected, size, s)
+	}
+
+	for _, s := range []string{"", "abc", "-1", "10X"} {
+		_, err := parseSize(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	v, err := parseTime("7d", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 3, 12, 0, 0, 0, time.UTC), v)
+
+	v, err = parseTime("90m", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 10, 10, 30, 0, 0, time.UTC), v)
+
+	v, err = parseTime("2024-01-31T10:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC), v)
+
+	v, err = parseTime("2024-01-31", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local), v)
+
+	_, err = parseTime("yesterday", now)
+	assert.Error(t, err)
+}
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-48 * time.Hour)
+	writeTestFile(t, filepath.Join(dir, "small.json"), "{}", time.Now())
+	writeTestFile(t, filepath.Join(dir, "data", "large.parquet"), string(make([]byte, 2048)), time.Now())
+	writeTestFile(t, filepath.Join(dir, "data", "stale.json"), "{}", old)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "data"), old, old))
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	fsys := filer.NewFS(context.Background(), f)
+	root := filepath.ToSlash(dir)
+
+	names := func(fd finder) []string {
+		entries, err := fd.find(fsys, root, "")
+		require.NoError(t, err)
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{root + "/data/stale.json", root + "/small.json"}, names(finder{name: "*.json"}))
+	assert.Equal(t, []string{root + "/data"}, names(finder{fileType: "d"}))
+	assert.Equal(t, []string{root + "/data/large.parquet"}, names(finder{minSize: 1024}))
+	assert.Equal(t, []string{root + "/data/stale.json"}, names(finder{fileType: "f", older: time.Now().Add(-24 * time.Hour)}))
+	assert.Equal(t, []string{root + "/data/large.parquet", root + "/small.json"}, names(finder{fileType: "f", newer: time.Now().Add(-24 * time.Hour)}))
+	assert.Equal(t, []string{root + "/small.json"}, names(finder{fileType: "f", maxSize: 10, newer: time.Now().Add(-24 * time.Hour)}))
+}
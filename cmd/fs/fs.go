@@ -8,16 +8,20 @@ func New() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "fs",
 		Short:   "Filesystem related commands",
-		Long:    `Commands to do file system operations on DBFS and UC Volumes.`,
+		Long:    `Commands to do file system operations on DBFS, UC Volumes and workspace files.`,
 		GroupID: "workspace",
 	}
 
 	cmd.AddCommand(
 		newCatCommand(),
 		newCpCommand(),
+		newDuCommand(),
+		newFindCommand(),
 		newLsCommand(),
 		newMkdirCommand(),
 		newRmCommand(),
+		newStatCommand(),
+		newSyncCommand(),
 	)
 
 	return cmd
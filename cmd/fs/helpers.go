@@ -30,13 +30,20 @@ func filerForPath(ctx context.Context, fullPath string) (filer.Filer, string, er
 		return f, fullPath, err
 	}
 
-	if parts[0] != "dbfs" {
+	if parts[0] != "dbfs" && parts[0] != "workspace" {
 		return nil, "", fmt.Errorf("invalid scheme: %s", parts[0])
 	}
 
 	path := parts[1]
 	w := root.WorkspaceClient(ctx)
 
+	// The file is a workspace file. Notebooks are presented with the extension
+	// of their language, so that they can be copied to and from local files.
+	if parts[0] == "workspace" {
+		f, err := filer.NewWorkspaceFilesExtensionsClient(w, "/")
+		return f, path, err
+	}
+
 	// If the specified path has the "Volumes" prefix, use the Files API.
 	if strings.HasPrefix(path, "/Volumes/") {
 		f, err := filer.NewFilesClient(w, "/")
@@ -48,12 +55,44 @@ func filerForPath(ctx context.Context, fullPath string) (filer.Filer, string, er
 	return f, path, err
 }
 
+// isGlob returns true if the path is a glob pattern that must be expanded.
+// Paths with glob characters that exist as they are, for example part[1].csv,
+// refer to that file or directory and are not expanded.
+func isGlob(ctx context.Context, f filer.Filer, p string) bool {
+	if !filer.HasGlobMeta(p) {
+		return false
+	}
+
+	// Errors other than the path not existing are returned when globbing.
+	_, err := f.Stat(ctx, p)
+	return err != nil
+}
+
+// globPaths returns the paths that match the glob pattern in the path argument.
+// It returns an error if the pattern doesn't match any paths.
+func globPaths(ctx context.Context, f filer.Filer, fullPath, pattern string) ([]string, error) {
+	matches, err := filer.Glob(ctx, f, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no matches found: %s", fullPath)
+	}
+	return matches, nil
+}
+
 const dbfsPrefix string = "dbfs:"
 
 func isDbfsPath(path string) bool {
 	return strings.HasPrefix(path, dbfsPrefix)
 }
 
+const workspacePrefix string = "workspace:"
+
+func isWorkspacePath(path string) bool {
+	return strings.HasPrefix(path, workspacePrefix)
+}
+
 type validArgs struct {
 	mustWorkspaceClientFunc func(cmd *cobra.Command, args []string) error
 	filerForPathFunc        func(ctx context.Context, fullPath string) (filer.Filer, string, error)
@@ -96,6 +135,12 @@ func (v *validArgs) Validate(cmd *cobra.Command, args []string, toComplete strin
 		completer.SetIsLocalPath(false)
 	}
 
+	// Workspace paths have the same properties
+	if isWorkspacePath(toComplete) {
+		completer.SetPrefix(workspacePrefix)
+		completer.SetIsLocalPath(false)
+	}
+
 	completions, directive, err := completer.CompletePath(toCompletePath)
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveError
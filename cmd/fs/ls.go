@@ -1,6 +1,7 @@
 package fs
 
 import (
+	"context"
 	"io/fs"
 	"path"
 	"sort"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
 	"github.com/spf13/cobra"
 )
 
@@ -37,11 +39,56 @@ func toJsonDirEntry(f fs.DirEntry, baseDir string, isAbsolute bool) (*jsonDirEnt
 	}, nil
 }
 
+func readJsonDirEntries(ctx context.Context, f filer.Filer, fullPath, dir string, absolute bool) ([]jsonDirEntry, error) {
+	entries, err := f.ReadDir(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonDirEntries := make([]jsonDirEntry, len(entries))
+	for i, entry := range entries {
+		jsonDirEntry, err := toJsonDirEntry(entry, fullPath, absolute)
+		if err != nil {
+			return nil, err
+		}
+		jsonDirEntries[i] = *jsonDirEntry
+	}
+	return jsonDirEntries, nil
+}
+
+// globJsonDirEntries returns the files and directories that match the pattern.
+// Their names are always absolute, because they can be in different directories.
+func globJsonDirEntries(ctx context.Context, f filer.Filer, fullPath, pattern string) ([]jsonDirEntry, error) {
+	matches, err := globPaths(ctx, f, fullPath, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonDirEntries := make([]jsonDirEntry, len(matches))
+	for i, match := range matches {
+		info, err := f.Stat(ctx, match)
+		if err != nil {
+			return nil, err
+		}
+		jsonDirEntry, err := toJsonDirEntry(fs.FileInfoToDirEntry(info), schemePrefix(fullPath)+path.Dir(match), true)
+		if err != nil {
+			return nil, err
+		}
+		jsonDirEntries[i] = *jsonDirEntry
+	}
+	return jsonDirEntries, nil
+}
+
 func newLsCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "ls DIR_PATH",
-		Short:   "Lists files.",
-		Long:    `Lists files in DBFS and UC Volumes.`,
+		Use:   "ls DIR_PATH",
+		Short: "Lists files.",
+		Long: `Lists files in DBFS and UC Volumes.
+
+	  If DIR_PATH contains a glob pattern, for example dbfs:/Volumes/main/raw/landing/*.json,
+	  the matching files and directories are listed instead. The pattern "**" matches
+	  any number of directories.
+	`,
 		Args:    root.ExactArgs(1),
 		PreRunE: root.MustWorkspaceClient,
 	}
@@ -59,19 +106,15 @@ func newLsCommand() *cobra.Command {
 			return err
 		}
 
-		entries, err := f.ReadDir(ctx, path)
+		var jsonDirEntries []jsonDirEntry
+		if isGlob(ctx, f, path) {
+			jsonDirEntries, err = globJsonDirEntries(ctx, f, args[0], path)
+		} else {
+			jsonDirEntries, err = readJsonDirEntries(ctx, f, args[0], path, absolute)
+		}
 		if err != nil {
 			return err
 		}
-
-		jsonDirEntries := make([]jsonDirEntry, len(entries))
-		for i, entry := range entries {
-			jsonDirEntry, err := toJsonDirEntry(entry, args[0], absolute)
-			if err != nil {
-				return err
-			}
-			jsonDirEntries[i] = *jsonDirEntry
-		}
 		sort.Slice(jsonDirEntries, func(i, j int) bool {
 			return jsonDirEntries[i].Name < jsonDirEntries[j].Name
 		})
@@ -1,16 +1,33 @@
 package fs
 
 import (
+	"context"
+	"errors"
+	"io/fs"
+	"slices"
+
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/filer"
 	"github.com/spf13/cobra"
 )
 
+func rm(ctx context.Context, f filer.Filer, path string, recursive bool) error {
+	if recursive {
+		return f.Delete(ctx, path, filer.DeleteRecursively)
+	}
+	return f.Delete(ctx, path)
+}
+
 func newRmCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "rm PATH",
-		Short:   "Remove files and directories.",
-		Long:    `Remove files and directories from DBFS and UC Volumes.`,
+		Use:   "rm PATH",
+		Short: "Remove files and directories.",
+		Long: `Remove files and directories from DBFS and UC Volumes.
+
+	  If PATH contains a glob pattern, for example dbfs:/Volumes/main/raw/landing/*.json,
+	  all matching files and directories are removed. If a file or directory with
+	  the literal name PATH exists, only that file or directory is removed.
+	`,
 		Args:    root.ExactArgs(1),
 		PreRunE: root.MustWorkspaceClient,
 	}
@@ -26,10 +43,26 @@ func newRmCommand() *cobra.Command {
 			return err
 		}
 
-		if recursive {
-			return f.Delete(ctx, path, filer.DeleteRecursively)
+		if !isGlob(ctx, f, path) {
+			return rm(ctx, f, path, recursive)
+		}
+
+		matches, err := globPaths(ctx, f, args[0], path)
+		if err != nil {
+			return err
+		}
+
+		// Remove the matches in reverse order, so that files are removed before the
+		// directories that contain them. Matches can be removed already, if they were
+		// in a directory that was removed recursively.
+		slices.Reverse(matches)
+		for _, match := range matches {
+			err := rm(ctx, f, match, recursive)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
 		}
-		return f.Delete(ctx, path)
+		return nil
 	}
 
 	v := newValidArgs()
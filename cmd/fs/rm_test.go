@@ -0,0 +1,51 @@
+package fs
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRmGlob(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "a.json"), "a", time.Now())
+	writeTestFile(t, filepath.Join(dir, "b.csv"), "b", time.Now())
+	writeTestFile(t, filepath.Join(dir, "sub", "c.json"), "c", time.Now())
+
+	cmd := newRmCommand()
+	cmd.SetContext(context.Background())
+	require.NoError(t, cmd.ParseFlags([]string{"-r"}))
+
+	err := cmd.RunE(cmd, []string{filepath.ToSlash(dir) + "/**/*.json"})
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "a.json"))
+	assert.NoFileExists(t, filepath.Join(dir, "sub", "c.json"))
+	assert.FileExists(t, filepath.Join(dir, "b.csv"))
+
+	err = cmd.RunE(cmd, []string{filepath.ToSlash(dir) + "/*.json"})
+	assert.ErrorContains(t, err, "no matches found")
+}
+
+func TestRmLiteralPathWithGlobCharacters(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "part[1].csv"), "a", time.Now())
+	writeTestFile(t, filepath.Join(dir, "part1.csv"), "b", time.Now())
+
+	cmd := newRmCommand()
+	cmd.SetContext(context.Background())
+
+	// The path refers to the file with brackets in its name, not to the files matching the pattern.
+	err := cmd.RunE(cmd, []string{filepath.ToSlash(dir) + "/part[1].csv"})
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "part[1].csv"))
+	assert.FileExists(t, filepath.Join(dir, "part1.csv"))
+
+	// The path is expanded as a pattern once the file no longer exists.
+	err = cmd.RunE(cmd, []string{filepath.ToSlash(dir) + "/part[1].csv"})
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "part1.csv"))
+}
@@ -0,0 +1,123 @@
+package fs
+
+import (
+	"context"
+	"io/fs"
+	"path"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/notebook"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/spf13/cobra"
+)
+
+type statInfo struct {
+	Path    string    `json:"path"`
+	Name    string    `json:"name"`
+	IsDir   bool      `json:"is_directory"`
+	Size    int64     `json:"size"`
+	Mode    string    `json:"mode"`
+	ModTime time.Time `json:"last_modified"`
+
+	// Only set for paths in the workspace.
+	ObjectType workspace.ObjectType `json:"object_type,omitempty"`
+	ObjectId   int64                `json:"object_id,omitempty"`
+	Language   workspace.Language   `json:"language,omitempty"`
+	ResourceId string               `json:"resource_id,omitempty"`
+}
+
+func toStatInfo(info fs.FileInfo, fullPath string) statInfo {
+	s := statInfo{
+		Path:    fullPath,
+		Name:    info.Name(),
+		IsDir:   info.IsDir(),
+		Size:    info.Size(),
+		Mode:    info.Mode().String(),
+		ModTime: info.ModTime(),
+	}
+
+	if i, ok := info.(notebook.FileInfoWithWorkspaceObjectInfo); ok {
+		oi := i.WorkspaceObjectInfo()
+		s.ObjectType = oi.ObjectType
+		s.ObjectId = oi.ObjectId
+		s.Language = oi.Language
+		s.ResourceId = oi.ResourceId
+	}
+	return s
+}
+
+func stat(ctx context.Context, f filer.Filer, fullPath, p string, glob bool) ([]statInfo, error) {
+	paths := []string{p}
+	if glob {
+		var err error
+		paths, err = globPaths(ctx, f, fullPath, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	infos := make([]statInfo, len(paths))
+	for i, p := range paths {
+		info, err := f.Stat(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		infos[i] = toStatInfo(info, schemePrefix(fullPath)+path.Clean(p))
+	}
+	return infos, nil
+}
+
+const statTemplate = `Path:	{{.Path}}
+Type:	{{if .IsDir}}directory{{else}}file{{end}}
+Size:	{{.Size}}
+Mode:	{{.Mode}}
+Last modified:	{{.ModTime|pretty_date}}
+{{if .ObjectType}}Object type:	{{.ObjectType}}
+Object ID:	{{.ObjectId}}
+{{end}}{{if .Language}}Language:	{{.Language}}
+{{end}}`
+
+func newStatCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stat PATH",
+		Short: "Show file status.",
+		Long: `Show the status of a file or directory.
+
+	  For paths in the workspace, this includes the type of the object,
+	  its ID and, for notebooks, its language.
+
+	  If PATH contains a glob pattern, the status of all matches is shown.
+	`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		f, p, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		glob := isGlob(ctx, f, p)
+		infos, err := stat(ctx, f, args[0], p, glob)
+		if err != nil {
+			return err
+		}
+
+		// A single path is rendered as an object in JSON, the matches of a pattern as a list.
+		if !glob {
+			return cmdio.RenderWithTemplate(ctx, infos[0], "", statTemplate)
+		}
+		return cmdio.RenderWithTemplate(ctx, infos, "", "{{range .}}"+statTemplate+"\n{{end}}")
+	}
+
+	v := newValidArgs()
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type workspaceFileInfo struct {
+	filer.FakeFileInfo
+	oi workspace.ObjectInfo
+}
+
+func (info workspaceFileInfo) WorkspaceObjectInfo() workspace.ObjectInfo {
+	return info.oi
+}
+
+func TestToStatInfo(t *testing.T) {
+	s := toStatInfo(filer.FakeFileInfo{FakeName: "file.txt", FakeSize: 3}, "dbfs:/Volumes/main/raw/file.txt")
+	assert.Equal(t, "dbfs:/Volumes/main/raw/file.txt", s.Path)
+	assert.Equal(t, "file.txt", s.Name)
+	assert.Equal(t, int64(3), s.Size)
+	assert.False(t, s.IsDir)
+	assert.Empty(t, s.ObjectType)
+
+	s = toStatInfo(workspaceFileInfo{
+		FakeFileInfo: filer.FakeFileInfo{FakeName: "notebook.py"},
+		oi: workspace.ObjectInfo{
+			ObjectType: workspace.ObjectTypeNotebook,
+			ObjectId:   1234,
+			Language:   workspace.LanguagePython,
+		},
+	}, "workspace:/Users/someone@example.com/notebook.py")
+	assert.Equal(t, workspace.ObjectTypeNotebook, s.ObjectType)
+	assert.Equal(t, int64(1234), s.ObjectId)
+	assert.Equal(t, workspace.LanguagePython, s.Language)
+}
+
+func TestStatCommand(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	writeTestFile(t, filepath.Join(dir, "file.txt"), "abc", modTime)
+
+	var out bytes.Buffer
+	cmd := newStatCommand()
+	cmd.SetContext(cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputText, nil, &out, &out, "", "")))
+	err := cmd.RunE(cmd, []string{filepath.ToSlash(filepath.Join(dir, "file.txt"))})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`Path:           %s
+Type:           file
+Size:           3
+Mode:           -rw-r--r--
+Last modified:  %s
+`, filepath.ToSlash(filepath.Join(dir, "file.txt")), modTime.Local().Format("2006-01-02T15:04:05Z")), out.String())
+
+	out.Reset()
+	cmd.SetContext(cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputJSON, nil, &out, &out, "", "")))
+	err = cmd.RunE(cmd, []string{filepath.ToSlash(dir) + "/*.txt"})
+	require.NoError(t, err)
+
+	var infos []statInfo
+	require.NoError(t, json.Unmarshal(out.Bytes(), &infos))
+	require.Len(t, infos, 1)
+	assert.Equal(t, "file.txt", infos[0].Name)
+}
@@ -0,0 +1,419 @@
+package fs
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+type syncSummary struct {
+	CopiedFiles  int   `json:"copied_files"`
+	CopiedBytes  int64 `json:"copied_bytes"`
+	DeletedFiles int   `json:"deleted_files"`
+	SkippedFiles int   `json:"skipped_files"`
+	DryRun       bool  `json:"dry_run,omitempty"`
+}
+
+// tree holds the files and directories below a root directory by their
+// slash-separated path relative to the root directory.
+type tree struct {
+	files map[string]fs.FileInfo
+	dirs  map[string]bool
+}
+
+type syncer struct {
+	delete   bool
+	dryRun   bool
+	checksum bool
+	include  []string
+	exclude  []string
+
+	ctx          context.Context
+	sourceFiler  filer.Filer
+	targetFiler  filer.Filer
+	sourceScheme string
+	targetScheme string
+
+	// Paths of the source and target directories without scheme.
+	sourcePrefix string
+	targetPrefix string
+
+	summary syncSummary
+}
+
+// matchesPattern returns true if the pattern matches the relative path or one of its parent directories.
+// Patterns without a slash are matched against every path component, like in rsync.
+func matchesPattern(pattern string, relPath string) bool {
+	components := strings.Split(relPath, "/")
+	for i := range components {
+		var m bool
+		if strings.Contains(pattern, "/") {
+			m, _ = path.Match(strings.TrimPrefix(pattern, "/"), strings.Join(components[:i+1], "/"))
+		} else {
+			m, _ = path.Match(pattern, components[i])
+		}
+		if m {
+			return true
+		}
+	}
+	return false
+}
+
+// isIncluded returns true if the file at the relative path is synchronized.
+func (s *syncer) isIncluded(relPath string) bool {
+	for _, p := range s.exclude {
+		if matchesPattern(p, relPath) {
+			return false
+		}
+	}
+	if len(s.include) == 0 {
+		return true
+	}
+	for _, p := range s.include {
+		if matchesPattern(p, relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *syncer) readTree(f filer.Filer, dir string) (*tree, error) {
+	t := &tree{
+		files: make(map[string]fs.FileInfo),
+		dirs:  make(map[string]bool),
+	}
+
+	err := fs.WalkDir(filer.NewFS(s.ctx, f), dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel := strings.TrimPrefix(strings.TrimPrefix(p, dir), "/")
+		if rel == "" {
+			return nil
+		}
+
+		if d.IsDir() {
+			// Excluded directories are skipped entirely.
+			for _, pattern := range s.exclude {
+				if matchesPattern(pattern, rel) {
+					return fs.SkipDir
+				}
+			}
+			t.dirs[rel] = true
+			return nil
+		}
+
+		if !s.isIncluded(rel) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		t.files[rel] = info
+		return nil
+	})
+	return t, err
+}
+
+// isUpToDate returns true if the target file doesn't have to be copied.
+// Without checksums, a file is up to date if it has the same size as the source file
+// and was modified after it. Modification times are not preserved by a copy,
+// so the target file of a previous sync is always more recent than its source.
+func (s *syncer) isUpToDate(rel string, source fs.FileInfo, target fs.FileInfo) (bool, error) {
+	if source.Size() != target.Size() {
+		return false, nil
+	}
+
+	if !s.checksum {
+		return !source.ModTime().After(target.ModTime()), nil
+	}
+
+	sourceSum, err := checksum(s.ctx, s.sourceFiler, path.Join(s.sourcePrefix, rel))
+	if err != nil {
+		return false, err
+	}
+	targetSum, err := checksum(s.ctx, s.targetFiler, path.Join(s.targetPrefix, rel))
+	if err != nil {
+		return false, err
+	}
+	return sourceSum == targetSum, nil
+}
+
+// checksum returns the SHA-256 checksum of the contents of a file.
+// None of the filers expose checksums, so the file has to be read.
+func checksum(ctx context.Context, f filer.Filer, p string) (string, error) {
+	r, err := f.Read(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+func (s *syncer) copyFile(sourcePath, targetPath string) error {
+	r, err := s.sourceFiler.Read(s.ctx, sourcePath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return s.targetFiler.Write(s.ctx, targetPath, r, filer.OverwriteIfExists, filer.CreateParentDirectories)
+}
+
+func (s *syncer) sync(sourceDir, targetDir string) error {
+	source, err := s.readTree(s.sourceFiler, sourceDir)
+	if err != nil {
+		return err
+	}
+
+	// The target directory is created if it doesn't exist.
+	target := &tree{
+		files: make(map[string]fs.FileInfo),
+		dirs:  make(map[string]bool),
+	}
+	info, err := s.targetFiler.Stat(s.ctx, targetDir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		if !s.dryRun {
+			err = s.targetFiler.Mkdir(s.ctx, targetDir)
+			if err != nil {
+				return err
+			}
+		}
+	case err != nil:
+		return err
+	case !info.IsDir():
+		return fmt.Errorf("target path %s is not a directory", targetDir)
+	default:
+		target, err = s.readTree(s.targetFiler, targetDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.sourcePrefix = sourceDir
+	s.targetPrefix = targetDir
+	s.summary.DryRun = s.dryRun
+
+	// Create directories first, so that empty directories are synchronized too.
+	for _, rel := range sortedKeys(source.dirs) {
+		if target.dirs[rel] || s.dryRun {
+			continue
+		}
+		err := s.targetFiler.Mkdir(s.ctx, path.Join(targetDir, rel))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rel := range sortedKeys(source.files) {
+		sourcePath := path.Join(sourceDir, rel)
+		targetPath := path.Join(targetDir, rel)
+
+		if target.dirs[rel] {
+			return fmt.Errorf("cannot overwrite directory %s with file %s", targetPath, sourcePath)
+		}
+
+		if targetInfo, ok := target.files[rel]; ok {
+			upToDate, err := s.isUpToDate(rel, source.files[rel], targetInfo)
+			if err != nil {
+				return err
+			}
+			if upToDate {
+				s.summary.SkippedFiles++
+				continue
+			}
+		}
+
+		if !s.dryRun {
+			err := s.copyFile(sourcePath, targetPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		s.summary.CopiedFiles++
+		s.summary.CopiedBytes += source.files[rel].Size()
+		err := cmdio.RenderWithTemplate(s.ctx, newFileCopiedEvent(s.fullSourcePath(sourcePath), s.fullTargetPath(targetPath)), "", "{{.SourcePath}} -> {{.TargetPath}}\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.delete {
+		err := s.deleteExtraneous(source, target, targetDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	template := "Copied {{.CopiedFiles}} files ({{.CopiedBytes}} bytes), deleted {{.DeletedFiles}} files, {{.SkippedFiles}} files up to date{{if .DryRun}} (dry run){{end}}\n"
+	return cmdio.RenderWithTemplate(s.ctx, s.summary, "", template)
+}
+
+// deleteExtraneous deletes the files and directories in the target directory that don't exist in the source directory.
+// Files that are excluded from the sync are kept, as are the directories that contain them.
+func (s *syncer) deleteExtraneous(source, target *tree, targetDir string) error {
+	for _, rel := range sortedKeys(target.files) {
+		if _, ok := source.files[rel]; ok {
+			continue
+		}
+
+		targetPath := path.Join(targetDir, rel)
+		if !s.dryRun {
+			err := s.targetFiler.Delete(s.ctx, targetPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		s.summary.DeletedFiles++
+		err := cmdio.RenderWithTemplate(s.ctx, newFileDeletedEvent(s.fullTargetPath(targetPath)), "", "deleted {{.TargetPath}}\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.dryRun {
+		return nil
+	}
+
+	// Delete directories after their contents, so the deepest directories go first.
+	dirs := sortedKeys(target.dirs)
+	slices.Reverse(dirs)
+	for _, rel := range dirs {
+		if source.dirs[rel] {
+			continue
+		}
+
+		err := s.targetFiler.Delete(s.ctx, path.Join(targetDir, rel))
+		if errors.As(err, &filer.DirectoryNotEmptyError{}) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *syncer) fullSourcePath(p string) string {
+	return s.sourceScheme + p
+}
+
+func (s *syncer) fullTargetPath(p string) string {
+	return s.targetScheme + p
+}
+
+// schemePrefix returns the scheme of the path including the colon, if any.
+func schemePrefix(fullPath string) string {
+	switch {
+	case isDbfsPath(fullPath):
+		return dbfsPrefix
+	case isWorkspacePath(fullPath):
+		return workspacePrefix
+	}
+	return ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func newSyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync SOURCE_PATH TARGET_PATH",
+		Short: "Synchronize a directory to another directory.",
+		Long: `Synchronize the contents of a directory to another directory.
+
+	  Both paths can be on DBFS, UC Volumes, the workspace or your local filesystem.
+	  For paths in DBFS and UC Volumes, specify the "dbfs" scheme, for example dbfs:/foo/bar.
+	  For paths in the workspace, specify the "workspace" scheme, for example
+	  workspace:/Users/someone@example.com/foo.
+
+	  Files are copied if they don't exist in the target directory, if their size
+	  is different, or if the source file was modified after the target file.
+	  Use --checksum to compare the contents of files instead of their modification time.
+
+	  Use --include and --exclude to synchronize a subset of the files. Patterns without
+	  a slash match the name of a file or any of its parent directories. Other patterns
+	  match the path relative to SOURCE_PATH.
+	`,
+		Args:    root.ExactArgs(2),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var s syncer
+	cmd.Flags().BoolVar(&s.delete, "delete", false, "delete files in the target directory that don't exist in the source directory")
+	cmd.Flags().BoolVar(&s.dryRun, "dry-run", false, "show what would be copied and deleted without making changes")
+	cmd.Flags().BoolVar(&s.checksum, "checksum", false, "compare the contents of files instead of their modification time")
+	cmd.Flags().StringArrayVar(&s.include, "include", nil, "only synchronize files matching this pattern (can be repeated)")
+	cmd.Flags().StringArrayVar(&s.exclude, "exclude", nil, "don't synchronize files matching this pattern (can be repeated)")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		for _, p := range append(s.include, s.exclude...) {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", p, err)
+			}
+		}
+
+		sourceFiler, sourcePath, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		targetFiler, targetPath, err := filerForPath(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		sourceInfo, err := sourceFiler.Stat(ctx, sourcePath)
+		if err != nil {
+			return err
+		}
+		if !sourceInfo.IsDir() {
+			return fmt.Errorf("source path %s is not a directory", args[0])
+		}
+
+		s.ctx = ctx
+		s.sourceFiler = sourceFiler
+		s.targetFiler = targetFiler
+		s.sourceScheme = schemePrefix(args[0])
+		s.targetScheme = schemePrefix(args[1])
+		return s.sync(path.Clean(sourcePath), path.Clean(targetPath))
+	}
+
+	v := newValidArgs()
+	v.pathArgCount = 2
+	v.onlyDirs = true
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
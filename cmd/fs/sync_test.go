@@ -0,0 +1,139 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, p string, content string, modTime time.Time) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	require.NoError(t, os.Chtimes(p, modTime, modTime))
+}
+
+func runTestSync(t *testing.T, s *syncer, sourceDir, targetDir string) string {
+	var out bytes.Buffer
+	ctx := cmdio.InContext(context.Background(), cmdio.NewIO(flags.OutputText, nil, &out, &out, "", ""))
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	s.ctx = ctx
+	s.sourceFiler = f
+	s.targetFiler = f
+	s.summary = syncSummary{}
+	require.NoError(t, s.sync(filepath.ToSlash(sourceDir), filepath.ToSlash(targetDir)))
+	return out.String()
+}
+
+func TestSyncCopiesChangedFiles(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := filepath.Join(t.TempDir(), "target")
+
+	old := time.Now().Add(-time.Hour)
+	writeTestFile(t, filepath.Join(sourceDir, "a.txt"), "a", old)
+	writeTestFile(t, filepath.Join(sourceDir, "dir", "b.txt"), "b", old)
+	require.NoError(t, os.Mkdir(filepath.Join(sourceDir, "empty"), 0755))
+
+	out := runTestSync(t, &syncer{}, sourceDir, targetDir)
+	assert.Contains(t, out, "Copied 2 files (2 bytes), deleted 0 files, 0 files up to date")
+	assert.DirExists(t, filepath.Join(targetDir, "empty"))
+
+	data, err := os.ReadFile(filepath.Join(targetDir, "dir", "b.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(data))
+
+	// Nothing has changed since the last sync.
+	out = runTestSync(t, &syncer{}, sourceDir, targetDir)
+	assert.Equal(t, "Copied 0 files (0 bytes), deleted 0 files, 2 files up to date\n", out)
+
+	// A file with a different size is copied.
+	writeTestFile(t, filepath.Join(sourceDir, "a.txt"), "aa", old)
+	out = runTestSync(t, &syncer{}, sourceDir, targetDir)
+	assert.Contains(t, out, filepath.ToSlash(filepath.Join(targetDir, "a.txt")))
+	assert.Contains(t, out, "Copied 1 files (2 bytes), deleted 0 files, 1 files up to date")
+}
+
+func TestSyncChecksum(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+
+	// The source file is more recent, but has the same contents.
+	writeTestFile(t, filepath.Join(sourceDir, "same.txt"), "same", time.Now())
+	writeTestFile(t, filepath.Join(targetDir, "same.txt"), "same", time.Now().Add(-time.Hour))
+
+	// The target file is more recent, but has different contents.
+	writeTestFile(t, filepath.Join(sourceDir, "changed.txt"), "new", time.Now().Add(-time.Hour))
+	writeTestFile(t, filepath.Join(targetDir, "changed.txt"), "old", time.Now())
+
+	out := runTestSync(t, &syncer{}, sourceDir, targetDir)
+	assert.Contains(t, out, "Copied 1 files (4 bytes), deleted 0 files, 1 files up to date")
+	assert.Contains(t, out, "same.txt")
+
+	writeTestFile(t, filepath.Join(sourceDir, "same.txt"), "same", time.Now().Add(time.Hour))
+	out = runTestSync(t, &syncer{checksum: true}, sourceDir, targetDir)
+	assert.Contains(t, out, "Copied 1 files (3 bytes), deleted 0 files, 1 files up to date")
+	assert.Contains(t, out, "changed.txt")
+
+	data, err := os.ReadFile(filepath.Join(targetDir, "changed.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+}
+
+func TestSyncDeleteAndDryRun(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+
+	old := time.Now().Add(-time.Hour)
+	writeTestFile(t, filepath.Join(sourceDir, "keep.txt"), "keep", old)
+	writeTestFile(t, filepath.Join(targetDir, "keep.txt"), "keep", time.Now())
+	writeTestFile(t, filepath.Join(targetDir, "extra", "extra.txt"), "extra", time.Now())
+	writeTestFile(t, filepath.Join(targetDir, "excluded.log"), "log", time.Now())
+
+	out := runTestSync(t, &syncer{delete: true, dryRun: true, exclude: []string{"*.log"}}, sourceDir, targetDir)
+	assert.Contains(t, out, "deleted "+filepath.ToSlash(filepath.Join(targetDir, "extra", "extra.txt")))
+	assert.Contains(t, out, "Copied 0 files (0 bytes), deleted 1 files, 1 files up to date (dry run)")
+	assert.FileExists(t, filepath.Join(targetDir, "extra", "extra.txt"))
+
+	runTestSync(t, &syncer{delete: true, exclude: []string{"*.log"}}, sourceDir, targetDir)
+	assert.NoDirExists(t, filepath.Join(targetDir, "extra"))
+	assert.FileExists(t, filepath.Join(targetDir, "excluded.log"))
+	assert.FileExists(t, filepath.Join(targetDir, "keep.txt"))
+}
+
+func TestSyncIncludeExclude(t *testing.T) {
+	sourceDir := t.TempDir()
+	targetDir := t.TempDir()
+
+	old := time.Now().Add(-time.Hour)
+	writeTestFile(t, filepath.Join(sourceDir, "a.py"), "a", old)
+	writeTestFile(t, filepath.Join(sourceDir, "b.txt"), "b", old)
+	writeTestFile(t, filepath.Join(sourceDir, "src", "c.py"), "c", old)
+	writeTestFile(t, filepath.Join(sourceDir, "node_modules", "d.py"), "d", old)
+
+	runTestSync(t, &syncer{include: []string{"*.py"}, exclude: []string{"node_modules"}}, sourceDir, targetDir)
+	assert.FileExists(t, filepath.Join(targetDir, "a.py"))
+	assert.FileExists(t, filepath.Join(targetDir, "src", "c.py"))
+	assert.NoFileExists(t, filepath.Join(targetDir, "b.txt"))
+	assert.NoDirExists(t, filepath.Join(targetDir, "node_modules"))
+}
+
+func TestMatchesPattern(t *testing.T) {
+	assert.True(t, matchesPattern("*.py", "a.py"))
+	assert.True(t, matchesPattern("*.py", "dir/a.py"))
+	assert.True(t, matchesPattern("dir", "dir/a.py"))
+	assert.True(t, matchesPattern("dir/*.py", "dir/a.py"))
+	assert.True(t, matchesPattern("/dir", "dir/sub/a.py"))
+	assert.False(t, matchesPattern("dir/*.py", "other/dir/a.py"))
+	assert.False(t, matchesPattern("*.py", "a.txt"))
+}
@@ -43,3 +43,13 @@ func MaximumNArgs(n int) cobra.PositionalArgs {
 		return nil
 	}
 }
+
+func MinimumNArgs(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < n {
+			msg := fmt.Sprintf("requires at least %d arg(s), only received %d", n, len(args))
+			return &InvalidArgsError{Message: msg, Command: cmd}
+		}
+		return nil
+	}
+}
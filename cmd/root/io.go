@@ -1,6 +1,8 @@
 package root
 
 import (
+	"fmt"
+
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/env"
 	"github.com/databricks/cli/libs/flags"
@@ -10,7 +12,9 @@ import (
 const envOutputFormat = "DATABRICKS_OUTPUT_FORMAT"
 
 type outputFlag struct {
-	output flags.Output
+	output  flags.Output
+	columns []string
+	query   string
 }
 
 func initOutputFlag(cmd *cobra.Command) *outputFlag {
@@ -24,7 +28,9 @@ func initOutputFlag(cmd *cobra.Command) *outputFlag {
 		f.output.Set(v)
 	}
 
-	cmd.PersistentFlags().VarP(&f.output, "output", "o", "output type: text or json")
+	cmd.PersistentFlags().VarP(&f.output, "output", "o", "output type: text, json, jsonl, yaml, csv, tsv or table")
+	cmd.PersistentFlags().StringSliceVar(&f.columns, "columns", nil, "comma-separated columns for csv, tsv and table output, for example id,name,state.life_cycle_state")
+	cmd.PersistentFlags().StringVar(&f.query, "query", "", "JMESPath expression to filter the output, for example \"[?state == 'RUNNING'].name\"")
 	return &f
 }
 
@@ -45,7 +51,18 @@ func (f *outputFlag) initializeIO(cmd *cobra.Command) error {
 		headerTemplate = cmd.Annotations["headerTemplate"]
 	}
 
+	if len(f.columns) > 0 && !f.output.IsTabular() {
+		return fmt.Errorf("--columns is only supported with csv, tsv and table output")
+	}
+
 	cmdIO := cmdio.NewIO(f.output, cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr(), headerTemplate, template)
+	cmdIO.SetColumns(f.columns)
+	if f.query != "" {
+		err := cmdIO.SetQuery(f.query)
+		if err != nil {
+			return err
+		}
+	}
 	ctx := cmdio.InContext(cmd.Context(), cmdIO)
 	cmd.SetContext(ctx)
 	return nil
@@ -23,15 +23,15 @@ const (
 type logFlags struct {
 	file   flags.LogFileFlag
 	level  flags.LogLevelFlag
-	output flags.Output
+	output flags.LogFormat
 	debug  bool
 }
 
 func (f *logFlags) makeLogHandler(opts slog.HandlerOptions) (slog.Handler, error) {
 	switch f.output {
-	case flags.OutputJSON:
+	case flags.LogFormatJSON:
 		return slog.NewJSONHandler(f.file.Writer(), &opts), nil
-	case flags.OutputText:
+	case flags.LogFormatText:
 		w := f.file.Writer()
 		return handler.NewFriendlyHandler(w, &handler.Options{
 			Color:       cmdio.IsTTY(w),
@@ -75,7 +75,7 @@ func initLogFlags(cmd *cobra.Command) *logFlags {
 	f := logFlags{
 		file:   flags.NewLogFileFlag(),
 		level:  flags.NewLogLevelFlag(),
-		output: flags.OutputText,
+		output: flags.LogFormatText,
 	}
 
 	// Configure defaults from environment, if applicable.
@@ -97,6 +97,13 @@ func Execute(ctx context.Context, cmd *cobra.Command) error {
 
 	// Run the command
 	cmd, err := cmd.ExecuteContextC(ctx)
+	if err == nil {
+		// Commands that print their output directly may not apply --query.
+		// The command has already run, so this is not reported as a failure.
+		if qerr := cmdio.CheckQueryApplied(cmd.Context()); qerr != nil {
+			cmdio.LogString(cmd.Context(), fmt.Sprintf("Warning: %s", qerr))
+		}
+	}
 	if err != nil && !errors.Is(err, ErrAlreadyPrinted) {
 		// If cmdio logger initialization succeeds, then this function logs with the
 		// initialized cmdio logger, otherwise with the default cmdio logger
@@ -77,7 +77,7 @@ func New() *cobra.Command {
 	f := syncFlags{
 		output: flags.OutputText,
 	}
-	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch)")
+	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch if file system notifications are unavailable)")
 	cmd.Flags().BoolVar(&f.full, "full", false, "perform full synchronization (default is incremental)")
 	cmd.Flags().BoolVar(&f.watch, "watch", false, "watch local file system for changes")
 	cmd.Flags().Var(&f.output, "output", "type of output format")
@@ -113,18 +113,20 @@ func New() *cobra.Command {
 			return err
 		}
 
-		ctx := cmd.Context()
-		s, err := sync.New(ctx, *opts)
-		if err != nil {
-			return err
-		}
-
 		var outputFunc func(context.Context, <-chan sync.Event, io.Writer)
 		switch f.output {
 		case flags.OutputText:
 			outputFunc = textOutput
 		case flags.OutputJSON:
 			outputFunc = jsonOutput
+		default:
+			return fmt.Errorf("unsupported output type %s, expected text or json", f.output)
+		}
+
+		ctx := cmd.Context()
+		s, err := sync.New(ctx, *opts)
+		if err != nil {
+			return err
 		}
 
 		var wg stdsync.WaitGroup
@@ -7,12 +7,14 @@ require (
 	github.com/briandowns/spinner v1.23.1 // Apache 2.0
 	github.com/databricks/databricks-sdk-go v0.46.0 // Apache 2.0
 	github.com/fatih/color v1.17.0 // MIT
+	github.com/fsnotify/fsnotify v1.7.0 // BSD-3-Clause
 	github.com/ghodss/yaml v1.0.0 // MIT + NOTICE
 	github.com/google/uuid v1.6.0 // BSD-3-Clause
 	github.com/hashicorp/go-version v1.7.0 // MPL 2.0
 	github.com/hashicorp/hc-install v0.7.0 // MPL 2.0
 	github.com/hashicorp/terraform-exec v0.21.0 // MPL 2.0
 	github.com/hashicorp/terraform-json v0.22.1 // MPL 2.0
+	github.com/jmespath/go-jmespath v0.4.0 // Apache 2.0
 	github.com/manifoldco/promptui v0.9.0 // BSD-3-Clause
 	github.com/mattn/go-isatty v0.0.20 // MIT
 	github.com/nwidger/jsoncolor v0.3.2 // MIT
@@ -25,6 +27,7 @@ require (
 	golang.org/x/mod v0.20.0
 	golang.org/x/oauth2 v0.23.0
 	golang.org/x/sync v0.8.0
+	golang.org/x/sys v0.25.0 // indirect
 	golang.org/x/term v0.24.0
 	golang.org/x/text v0.18.0
 	gopkg.in/ini.v1 v1.67.0 // Apache 2.0
@@ -61,7 +64,6 @@ require (
 	go.opentelemetry.io/otel/trace v1.24.0 // indirect
 	golang.org/x/crypto v0.24.0 // indirect
 	golang.org/x/net v0.26.0 // indirect
-	golang.org/x/sys v0.25.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
 	google.golang.org/api v0.182.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240521202816-d264139d666e // indirect
@@ -222,10 +222,10 @@ func (a *syncTest) snapshotContains(files []string) {
 	assert.Equal(a.t, s.Host, a.w.Config.Host)
 	assert.Equal(a.t, s.RemotePath, a.remoteRoot)
 	for _, filePath := range files {
-		_, ok := s.LastModifiedTimes[filePath]
-		assert.True(a.t, ok, fmt.Sprintf("%s not in snapshot file: %v", filePath, s.LastModifiedTimes))
+		_, ok := s.ContentHashes[filePath]
+		assert.True(a.t, ok, fmt.Sprintf("%s not in snapshot file: %v", filePath, s.ContentHashes))
 	}
-	assert.Equal(a.t, len(files), len(s.LastModifiedTimes))
+	assert.Equal(a.t, len(files), len(s.ContentHashes))
 }
 
 func TestAccSyncFullFileSync(t *testing.T) {
@@ -26,6 +26,8 @@ type cmdIO struct {
 	outputFormat   flags.Output
 	headerTemplate string
 	template       string
+	columns        []string
+	query          *query
 	in             io.Reader
 	out            io.Writer
 	err            io.Writer
@@ -48,6 +50,23 @@ func NewIO(outputFormat flags.Output, in io.Reader, out io.Writer, err io.Writer
 	}
 }
 
+// SetColumns configures the columns of CSV, TSV and table output.
+// If no columns are configured, the fields with a scalar value in a sample of the rows are used.
+func (c *cmdIO) SetColumns(columns []string) {
+	c.columns = columns
+}
+
+// SetQuery configures a JMESPath expression that is applied to every rendered value
+// before it is formatted. It returns an error if the expression is invalid.
+func (c *cmdIO) SetQuery(expression string) error {
+	q, err := newQuery(expression)
+	if err != nil {
+		return err
+	}
+	c.query = q
+	return nil
+}
+
 func IsInteractive(ctx context.Context) bool {
 	c := fromContext(ctx)
 	return c.interactive
@@ -0,0 +1,175 @@
+package cmdio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/jmespath/go-jmespath"
+)
+
+// query is a JMESPath expression that is applied to rendered values.
+type query struct {
+	expression *jmespath.JMESPath
+
+	// Whether the expression is a projection over the elements of the top-level list,
+	// for example "[*].name" or "[?state == 'RUNNING']". Such expressions can be applied
+	// to every element of an iterator separately, so that results are streamed.
+	elementWise bool
+
+	// Whether the expression has been applied to the output of the command.
+	applied bool
+}
+
+func newQuery(expression string) (*query, error) {
+	e, err := jmespath.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expression, err)
+	}
+	return &query{
+		expression:  e,
+		elementWise: isElementWise(expression),
+	}, nil
+}
+
+// isElementWise returns true if the expression starts with a projection over the
+// top-level list that extends to the end of the expression. The go-jmespath package
+// doesn't expose the children of its AST nodes, so we check the leading token and the
+// type of the root node. If the root node is a projection, its left-hand side is the
+// leading token and everything else is evaluated per element.
+func isElementWise(expression string) bool {
+	e := strings.TrimSpace(expression)
+	if !strings.HasPrefix(e, "[*]") && !strings.HasPrefix(e, "[]") && !strings.HasPrefix(e, "[?") {
+		return false
+	}
+
+	node, err := jmespath.NewParser().Parse(e)
+	if err != nil {
+		return false
+	}
+	root := node.String()
+	return strings.HasPrefix(root, "ASTProjection ") || strings.HasPrefix(root, "ASTFilterProjection ")
+}
+
+// search applies the expression to the JSON representation of the value,
+// so that expressions refer to fields by their JSON names.
+func (q *query) search(v any) (any, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var data any
+	err = json.Unmarshal(buf, &data)
+	if err != nil {
+		return nil, err
+	}
+	return q.expression.Search(data)
+}
+
+// queryIteratorRenderer returns a renderer for the result of the query on the elements of the iterator.
+// If the expression is element-wise, the results are streamed. Otherwise the iterator is
+// consumed entirely and the expression is applied to the list of its elements.
+func queryIteratorRenderer[T any](ctx context.Context, q *query, i listing.Iterator[T]) (any, error) {
+	if q.elementWise {
+		return newIteratorRenderer[any](&queryIterator[T]{query: q, iterator: i}), nil
+	}
+
+	items := []any{}
+	for i.HasNext(ctx) {
+		item, err := i.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	v, err := q.search(items)
+	if err != nil {
+		return nil, err
+	}
+	return newRenderer(v), nil
+}
+
+// queryIterator applies an element-wise query to every element of an iterator
+// and yields the elements of the results.
+type queryIterator[T any] struct {
+	query    *query
+	iterator listing.Iterator[T]
+
+	// Results of the query that haven't been returned yet.
+	buffer []any
+	err    error
+}
+
+func (qi *queryIterator[T]) HasNext(ctx context.Context) bool {
+	for len(qi.buffer) == 0 && qi.err == nil && qi.iterator.HasNext(ctx) {
+		item, err := qi.iterator.Next(ctx)
+		if err != nil {
+			qi.err = err
+			break
+		}
+
+		v, err := qi.query.search([]any{item})
+		if err != nil {
+			qi.err = err
+			break
+		}
+
+		// The result of a projection is a list, or null if the element doesn't match.
+		if list, ok := v.([]any); ok {
+			qi.buffer = list
+		}
+	}
+	return len(qi.buffer) > 0 || qi.err != nil
+}
+
+func (qi *queryIterator[T]) Next(ctx context.Context) (any, error) {
+	if qi.err != nil {
+		err := qi.err
+		qi.err = nil
+		return nil, err
+	}
+	if len(qi.buffer) == 0 {
+		return nil, listing.ErrNoMoreItems
+	}
+	v := qi.buffer[0]
+	qi.buffer = qi.buffer[1:]
+	return v, nil
+}
+
+// renderQuery renders the result of the query on the value.
+// Templates describe the original value, so in text mode the result is rendered as JSON.
+func (c *cmdIO) renderQuery(ctx context.Context, v any) error {
+	if _, ok := v.(io.Reader); ok {
+		return errors.New("query not supported for this output")
+	}
+	c.query.applied = true
+	result, err := c.query.search(v)
+	if err != nil {
+		return err
+	}
+	return renderWithTemplate(newRenderer(result), ctx, c.outputFormat, c.out, "", "", c.columns)
+}
+
+func renderIteratorQuery[T any](ctx context.Context, c *cmdIO, i listing.Iterator[T]) error {
+	c.query.applied = true
+	r, err := queryIteratorRenderer(ctx, c.query, i)
+	if err != nil {
+		return err
+	}
+	return renderWithTemplate(r, ctx, c.outputFormat, c.out, "", "", c.columns)
+}
+
+// CheckQueryApplied returns an error if a query is specified but the command
+// printed its output without applying it.
+func CheckQueryApplied(ctx context.Context) error {
+	c, ok := ctx.Value(cmdIOKey).(*cmdIO)
+	if !ok || c.query == nil || c.query.applied {
+		return nil
+	}
+	return errors.New("--query is not supported for the output of this command and was ignored")
+}
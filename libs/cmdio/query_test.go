@@ -0,0 +1,157 @@
+package cmdio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsElementWise(t *testing.T) {
+	assert.True(t, isElementWise("[*]"))
+	assert.True(t, isElementWise("[*].workspace_name"))
+	assert.True(t, isElementWise(" [?workspace_id > `200`].{id: workspace_id}"))
+	assert.True(t, isElementWise("[].tags[*].key"))
+	assert.False(t, isElementWise("[0]"))
+	assert.False(t, isElementWise("length(@)"))
+	assert.False(t, isElementWise("workspaces[*].workspace_name"))
+	assert.False(t, isElementWise("[*].workspace_name | [0]"))
+	assert.False(t, isElementWise("[?workspace_id > `200`] || `[]`"))
+}
+
+func TestSetQueryInvalid(t *testing.T) {
+	cmdIO := NewIO(flags.OutputText, nil, nil, nil, "", "")
+	err := cmdIO.SetQuery("[?")
+	assert.ErrorContains(t, err, `invalid query "[?"`)
+}
+
+func renderWithQuery(t *testing.T, format flags.Output, query string, v any) (string, error) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(format, nil, output, output, "", "{{range .}}{{.WorkspaceId}}{{end}}")
+	require.NoError(t, cmdIO.SetQuery(query))
+	ctx := InContext(context.Background(), cmdIO)
+
+	var err error
+	if it, ok := v.(*dummyIterator); ok {
+		err = RenderIterator(ctx, it)
+	} else {
+		err = Render(ctx, v)
+	}
+	return output.String(), err
+}
+
+func TestRenderQuery(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		v        any
+		format   flags.Output
+		query    string
+		expected string
+	}{
+		{
+			name:     "field of value as text",
+			v:        dummyWorkspace1,
+			format:   flags.OutputText,
+			query:    "workspace_name",
+			expected: "\"abc\"\n",
+		},
+		{
+			name:     "multi-select of value as YAML",
+			v:        dummyWorkspace1,
+			format:   flags.OutputYAML,
+			query:    "{id: workspace_id}",
+			expected: "id: 123\n",
+		},
+		{
+			name:     "projection of iterator as JSON lines",
+			v:        makeIterator(3),
+			format:   flags.OutputJSONL,
+			query:    "[?workspace_id > `200`].{id: workspace_id}",
+			expected: "{\"id\":456}\n",
+		},
+		{
+			name:     "projection of iterator as CSV",
+			v:        makeIterator(3),
+			format:   flags.OutputCSV,
+			query:    "[*].{name: workspace_name}",
+			expected: "name\nabc\ndef\nabc\n",
+		},
+		{
+			name:     "function of iterator as text",
+			v:        makeIterator(3),
+			format:   flags.OutputText,
+			query:    "length(@)",
+			expected: "3\n",
+		},
+		{
+			name:     "pipe of iterator as table",
+			v:        makeIterator(3),
+			format:   flags.OutputTable,
+			query:    "[*].workspace_name | sort(@)",
+			expected: "VALUE\nabc\nabc\ndef\n",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			out, err := renderWithQuery(t, c.format, c.query, c.v)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, out)
+		})
+	}
+}
+
+func TestRenderQueryReader(t *testing.T) {
+	_, err := renderWithQuery(t, flags.OutputText, "a", strings.NewReader("a test"))
+	assert.ErrorContains(t, err, "query not supported for this output")
+}
+
+type failingIterator struct {
+	items []*provisioning.Workspace
+}
+
+func (f *failingIterator) HasNext(_ context.Context) bool {
+	return true
+}
+
+func (f *failingIterator) Next(_ context.Context) (*provisioning.Workspace, error) {
+	if len(f.items) == 0 {
+		return nil, errors.New("listing failed")
+	}
+	item := f.items[0]
+	f.items = f.items[1:]
+	return item, nil
+}
+
+func TestRenderQueryStreamsIterator(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputJSONL, nil, output, output, "", "")
+	require.NoError(t, cmdIO.SetQuery("[*].workspace_id"))
+	ctx := InContext(context.Background(), cmdIO)
+
+	// Results that were rendered before the error are kept.
+	err := RenderIterator(ctx, &failingIterator{items: makeWorkspaces(2)})
+	assert.ErrorContains(t, err, "listing failed")
+	assert.Equal(t, "123\n456\n", output.String())
+}
+
+func TestCheckQueryApplied(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputJSON, nil, output, output, "", "")
+	require.NoError(t, cmdIO.SetQuery("workspace_name"))
+	ctx := InContext(context.Background(), cmdIO)
+
+	// Commands that print their output directly don't apply the query.
+	err := CheckQueryApplied(ctx)
+	assert.EqualError(t, err, "--query is not supported for the output of this command and was ignored")
+
+	require.NoError(t, Render(ctx, dummyWorkspace1))
+	assert.NoError(t, CheckQueryApplied(ctx))
+
+	// Without command IO, there is nothing to check.
+	assert.NoError(t, CheckQueryApplied(context.Background()))
+}
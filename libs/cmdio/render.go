@@ -188,6 +188,9 @@ func (d defaultRenderer) renderTemplate(_ context.Context, t *template.Template,
 
 // Returns something implementing one of the following interfaces:
 //   - jsonRenderer
+//   - jsonlRenderer
+//   - yamlRenderer
+//   - rowsRenderer
 //   - textRenderer
 //   - templateRenderer
 func newRenderer(t any) any {
@@ -226,7 +229,7 @@ func newBufferedFlusher(w io.Writer) writeFlusher {
 	}
 }
 
-func renderWithTemplate(r any, ctx context.Context, outputFormat flags.Output, w io.Writer, headerTemplate, template string) error {
+func renderWithTemplate(r any, ctx context.Context, outputFormat flags.Output, w io.Writer, headerTemplate, template string, columns []string) error {
 	// TODO: add terminal width & white/dark theme detection
 	switch outputFormat {
 	case flags.OutputJSON:
@@ -234,6 +237,21 @@ func renderWithTemplate(r any, ctx context.Context, outputFormat flags.Output, w
 			return jr.renderJson(ctx, newBufferedFlusher(w))
 		}
 		return errors.New("json output not supported")
+	case flags.OutputJSONL:
+		if jr, ok := r.(jsonlRenderer); ok {
+			return jr.renderJsonl(ctx, newBufferedFlusher(w))
+		}
+		return errors.New("jsonl output not supported")
+	case flags.OutputYAML:
+		if yr, ok := r.(yamlRenderer); ok {
+			return yr.renderYaml(ctx, newBufferedFlusher(w))
+		}
+		return errors.New("yaml output not supported")
+	case flags.OutputCSV, flags.OutputTSV, flags.OutputTable:
+		if rr, ok := r.(rowsRenderer); ok {
+			return renderTable(ctx, rr, outputFormat, w, columns)
+		}
+		return fmt.Errorf("%s output not supported", outputFormat)
 	case flags.OutputText:
 		if tr, ok := r.(templateRenderer); ok && template != "" {
 			return renderUsingTemplate(ctx, tr, w, headerTemplate, template)
@@ -259,12 +277,18 @@ func Render(ctx context.Context, v any) error {
 	if _, ok := v.(listingInterface); ok {
 		panic("use RenderIterator instead")
 	}
-	return renderWithTemplate(newRenderer(v), ctx, c.outputFormat, c.out, c.headerTemplate, c.template)
+	if c.query != nil {
+		return c.renderQuery(ctx, v)
+	}
+	return renderWithTemplate(newRenderer(v), ctx, c.outputFormat, c.out, c.headerTemplate, c.template, c.columns)
 }
 
 func RenderIterator[T any](ctx context.Context, i listing.Iterator[T]) error {
 	c := fromContext(ctx)
-	return renderWithTemplate(newIteratorRenderer(i), ctx, c.outputFormat, c.out, c.headerTemplate, c.template)
+	if c.query != nil {
+		return renderIteratorQuery(ctx, c, i)
+	}
+	return renderWithTemplate(newIteratorRenderer(i), ctx, c.outputFormat, c.out, c.headerTemplate, c.template, c.columns)
 }
 
 func RenderWithTemplate(ctx context.Context, v any, headerTemplate, template string) error {
@@ -272,17 +296,26 @@ func RenderWithTemplate(ctx context.Context, v any, headerTemplate, template str
 	if _, ok := v.(listingInterface); ok {
 		panic("use RenderIteratorWithTemplate instead")
 	}
-	return renderWithTemplate(newRenderer(v), ctx, c.outputFormat, c.out, headerTemplate, template)
+	if c.query != nil {
+		return c.renderQuery(ctx, v)
+	}
+	return renderWithTemplate(newRenderer(v), ctx, c.outputFormat, c.out, headerTemplate, template, c.columns)
 }
 
 func RenderIteratorWithTemplate[T any](ctx context.Context, i listing.Iterator[T], headerTemplate, template string) error {
 	c := fromContext(ctx)
-	return renderWithTemplate(newIteratorRenderer(i), ctx, c.outputFormat, c.out, headerTemplate, template)
+	if c.query != nil {
+		return renderIteratorQuery(ctx, c, i)
+	}
+	return renderWithTemplate(newIteratorRenderer(i), ctx, c.outputFormat, c.out, headerTemplate, template, c.columns)
 }
 
 func RenderIteratorJson[T any](ctx context.Context, i listing.Iterator[T]) error {
 	c := fromContext(ctx)
-	return renderWithTemplate(newIteratorRenderer(i), ctx, c.outputFormat, c.out, c.headerTemplate, c.template)
+	if c.query != nil {
+		return renderIteratorQuery(ctx, c, i)
+	}
+	return renderWithTemplate(newIteratorRenderer(i), ctx, c.outputFormat, c.out, c.headerTemplate, c.template, c.columns)
 }
 
 func renderUsingTemplate(ctx context.Context, r templateRenderer, w io.Writer, headerTmpl, tmpl string) error {
@@ -0,0 +1,421 @@
+package cmdio
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/databricks/cli/libs/flags"
+	"github.com/ghodss/yaml"
+)
+
+type jsonlRenderer interface {
+	// Render an object as JSON lines to the provided writeFlusher.
+	// Lists are rendered with one element per line.
+	renderJsonl(context.Context, writeFlusher) error
+}
+
+type yamlRenderer interface {
+	// Render an object as YAML to the provided writeFlusher.
+	renderYaml(context.Context, writeFlusher) error
+}
+
+type rowsRenderer interface {
+	// Call the provided function for every row of a table.
+	// Lists have one row per element, other objects have a single row.
+	renderRows(context.Context, func(any) error) error
+}
+
+func (ir iteratorRenderer[T]) renderJsonl(ctx context.Context, w writeFlusher) error {
+	for ir.t.HasNext(ctx) {
+		n, err := ir.t.Next(ctx)
+		if err != nil {
+			return err
+		}
+		err = writeJsonLine(w, n)
+		if err != nil {
+			return err
+		}
+		// Flush every item, so that consumers can process them while they are listed.
+		err = w.Flush()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ir iteratorRenderer[T]) renderYaml(ctx context.Context, w writeFlusher) error {
+	empty := true
+	for i := 0; ir.t.HasNext(ctx); i++ {
+		n, err := ir.t.Next(ctx)
+		if err != nil {
+			return err
+		}
+		// Every item is rendered as a single element list, so that the output is a YAML list.
+		b, err := toYaml([]any{n})
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		if err != nil {
+			return err
+		}
+		empty = false
+		if (i+1)%ir.getBufferSize() == 0 {
+			err = w.Flush()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if empty {
+		_, err := w.Write([]byte("[]\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func (ir iteratorRenderer[T]) renderRows(ctx context.Context, fn func(any) error) error {
+	for ir.t.HasNext(ctx) {
+		n, err := ir.t.Next(ctx)
+		if err != nil {
+			return err
+		}
+		err = fn(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// elements returns the elements of the object if it is a list.
+func (d defaultRenderer) elements() ([]any, bool) {
+	v := reflect.ValueOf(d.t)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+	out := make([]any, v.Len())
+	for i := range out {
+		out[i] = v.Index(i).Interface()
+	}
+	return out, true
+}
+
+func (d defaultRenderer) renderJsonl(_ context.Context, w writeFlusher) error {
+	elements, ok := d.elements()
+	if !ok {
+		elements = []any{d.t}
+	}
+	for _, e := range elements {
+		err := writeJsonLine(w, e)
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func (d defaultRenderer) renderYaml(_ context.Context, w writeFlusher) error {
+	b, err := toYaml(d.t)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (d defaultRenderer) renderRows(_ context.Context, fn func(any) error) error {
+	elements, ok := d.elements()
+	if !ok {
+		return fn(d.t)
+	}
+	for _, e := range elements {
+		err := fn(e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJsonLine(w io.Writer, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// toYaml converts the object to YAML through its JSON representation,
+// so that the keys and omitted fields are the same as in JSON output.
+func toYaml(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(b)
+}
+
+// Name of the column of rows that are not JSON objects, for example strings.
+const valueColumn = "value"
+
+// Number of rows of CSV and TSV output that determine the default columns.
+// Rows of a table are all used, because they are buffered anyway.
+const columnSampleSize = 100
+
+// tableWriter writes rows to CSV, TSV or an aligned table.
+type tableWriter struct {
+	format  flags.Output
+	columns []string
+
+	csv *csv.Writer
+	tw  *tabwriter.Writer
+
+	headerWritten bool
+	rows          int
+
+	// Rows that are buffered until the columns are known.
+	sample [][]byte
+}
+
+func newTableWriter(format flags.Output, w io.Writer, columns []string) *tableWriter {
+	t := &tableWriter{
+		format:  format,
+		columns: columns,
+	}
+	switch format {
+	case flags.OutputTable:
+		t.tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	case flags.OutputTSV:
+		t.csv = csv.NewWriter(w)
+		t.csv.Comma = '\t'
+	default:
+		t.csv = csv.NewWriter(w)
+	}
+	return t
+}
+
+func (t *tableWriter) writeRecord(record []string) error {
+	if t.csv != nil {
+		return t.csv.Write(record)
+	}
+	_, err := fmt.Fprintln(t.tw, strings.Join(record, "\t"))
+	return err
+}
+
+func (t *tableWriter) writeHeader() error {
+	t.headerWritten = true
+	header := t.columns
+	if t.format == flags.OutputTable {
+		header = make([]string, len(t.columns))
+		for i, c := range t.columns {
+			header[i] = strings.ToUpper(c)
+		}
+	}
+	return t.writeRecord(header)
+}
+
+func (t *tableWriter) writeRow(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	// Without configured columns, a sample of rows determines the columns,
+	// so that fields that are omitted from the first rows are included.
+	if t.columns == nil {
+		t.sample = append(t.sample, b)
+		if t.format == flags.OutputTable || len(t.sample) < columnSampleSize {
+			return nil
+		}
+		return t.writeSample()
+	}
+
+	return t.writeJSON(b)
+}
+
+// writeSample determines the columns from the buffered rows and writes them.
+func (t *tableWriter) writeSample() error {
+	columns := []string{}
+	seen := make(map[string]bool)
+	for _, b := range t.sample {
+		keys, err := scalarKeys(b)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+	}
+
+	t.columns = columns
+	for _, b := range t.sample {
+		err := t.writeJSON(b)
+		if err != nil {
+			return err
+		}
+	}
+	t.sample = nil
+	return nil
+}
+
+func (t *tableWriter) writeJSON(b []byte) error {
+	if !t.headerWritten {
+		err := t.writeHeader()
+		if err != nil {
+			return err
+		}
+	}
+
+	var row any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err := dec.Decode(&row)
+	if err != nil {
+		return err
+	}
+
+	record := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		record[i], err = cellValue(lookupColumn(row, c))
+		if err != nil {
+			return err
+		}
+	}
+	err = t.writeRecord(record)
+	if err != nil {
+		return err
+	}
+
+	// Rows of CSV and TSV output are streamed. The rows of a table are
+	// written at the end, because all rows determine the column widths.
+	t.rows++
+	if t.csv != nil && t.rows%20 == 0 {
+		t.csv.Flush()
+		return t.csv.Error()
+	}
+	return nil
+}
+
+func (t *tableWriter) flush() error {
+	if len(t.sample) > 0 {
+		err := t.writeSample()
+		if err != nil {
+			return err
+		}
+	}
+
+	// The header is written even if there are no rows, if the columns are known.
+	if !t.headerWritten && len(t.columns) > 0 {
+		err := t.writeHeader()
+		if err != nil {
+			return err
+		}
+	}
+	if t.csv != nil {
+		t.csv.Flush()
+		return t.csv.Error()
+	}
+	return t.tw.Flush()
+}
+
+func renderTable(ctx context.Context, r rowsRenderer, format flags.Output, w io.Writer, columns []string) error {
+	t := newTableWriter(format, w, columns)
+	err := r.renderRows(ctx, t.writeRow)
+	if err != nil {
+		return err
+	}
+	return t.flush()
+}
+
+// scalarKeys returns the keys of a JSON object that have a scalar value, in order.
+// Nested objects and lists don't fit in a table cell, so they are not shown unless
+// they are selected explicitly. If the value is not an object, there is a single column.
+func scalarKeys(b []byte) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok != json.Delim('{') {
+		return []string{valueColumn}, nil
+	}
+
+	keys := []string{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var raw json.RawMessage
+		err = dec.Decode(&raw)
+		if err != nil {
+			return nil, err
+		}
+		if len(raw) > 0 && (raw[0] == '{' || raw[0] == '[') {
+			continue
+		}
+		keys = append(keys, tok.(string))
+	}
+	return keys, nil
+}
+
+// lookupColumn returns the value of a column in a row. Columns of nested
+// fields are separated by dots, for example state.life_cycle_state.
+// Elements of lists are selected by their index, for example tasks.0.task_key.
+func lookupColumn(row any, column string) any {
+	if _, ok := row.(map[string]any); !ok && column == valueColumn {
+		return row
+	}
+
+	v := row
+	for _, key := range strings.Split(column, ".") {
+		switch vv := v.(type) {
+		case map[string]any:
+			v = vv[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(vv) {
+				return nil
+			}
+			v = vv[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+func cellValue(v any) (string, error) {
+	switch vv := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return vv, nil
+	case json.Number:
+		return vv.String(), nil
+	case bool:
+		return strconv.FormatBool(vv), nil
+	default:
+		b, err := json.Marshal(vv)
+		return string(b), err
+	}
+}
@@ -165,6 +165,62 @@ var testCases = []testCase{
 		outputFormat: flags.OutputJSON,
 		errMessage:   "json output not supported",
 	},
+	{
+		name:         "Workspace as YAML",
+		v:            dummyWorkspace1,
+		outputFormat: flags.OutputYAML,
+		expected:     "workspace_id: 123\nworkspace_name: abc\n",
+	},
+	{
+		name:         "Workspace Iterator as YAML",
+		v:            makeIterator(2),
+		outputFormat: flags.OutputYAML,
+		expected:     "- workspace_id: 123\n  workspace_name: abc\n- workspace_id: 456\n  workspace_name: def\n",
+	},
+	{
+		name:         "Empty Workspace Iterator as YAML",
+		v:            makeIterator(0),
+		outputFormat: flags.OutputYAML,
+		expected:     "[]\n",
+	},
+	{
+		name:         "Workspace as JSON lines",
+		v:            dummyWorkspace1,
+		outputFormat: flags.OutputJSONL,
+		expected:     `{"workspace_id":123,"workspace_name":"abc"}` + "\n",
+	},
+	{
+		name:         "Workspace Iterator as JSON lines",
+		v:            makeIterator(2),
+		outputFormat: flags.OutputJSONL,
+		template:     "{{range .}}{{.WorkspaceId}}{{end}}",
+		expected:     `{"workspace_id":123,"workspace_name":"abc"}` + "\n" + `{"workspace_id":456,"workspace_name":"def"}` + "\n",
+	},
+	{
+		name:         "Workspace list as CSV",
+		v:            makeWorkspaces(2),
+		outputFormat: flags.OutputCSV,
+		expected:     "workspace_id,workspace_name\n123,abc\n456,def\n",
+	},
+	{
+		name:         "Workspace Iterator as TSV",
+		v:            makeIterator(2),
+		outputFormat: flags.OutputTSV,
+		expected:     "workspace_id\tworkspace_name\n123\tabc\n456\tdef\n",
+	},
+	{
+		name:         "Workspace Iterator as table",
+		v:            makeIterator(2),
+		outputFormat: flags.OutputTable,
+		template:     "{{range .}}{{.WorkspaceId}}{{end}}",
+		expected:     "WORKSPACE_ID  WORKSPACE_NAME\n123           abc\n456           def\n",
+	},
+	{
+		name:         "io.Reader as YAML",
+		v:            strings.NewReader("a test"),
+		outputFormat: flags.OutputYAML,
+		errMessage:   "yaml output not supported",
+	},
 }
 
 func TestRender(t *testing.T) {
@@ -188,3 +244,78 @@ func TestRender(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTableColumns(t *testing.T) {
+	type state struct {
+		LifeCycleState string `json:"life_cycle_state"`
+	}
+	type run struct {
+		RunId int64    `json:"run_id"`
+		Name  string   `json:"name,omitempty"`
+		State *state   `json:"state,omitempty"`
+		Tags  []string `json:"tags,omitempty"`
+	}
+	runs := []run{
+		{RunId: 1, Name: "a, b", State: &state{LifeCycleState: "RUNNING"}, Tags: []string{"x", "y"}},
+		{RunId: 2},
+	}
+
+	for _, c := range []struct {
+		format   flags.Output
+		columns  []string
+		expected string
+		reversed bool
+	}{
+		{
+			// Nested fields are not shown by default.
+			format:   flags.OutputCSV,
+			expected: "run_id,name\n1,\"a, b\"\n2,\n",
+		},
+		{
+			// Fields that are omitted from the first row are shown.
+			format:   flags.OutputTable,
+			expected: "RUN_ID  NAME\n2       \n1       a, b\n",
+			reversed: true,
+		},
+		{
+			format:   flags.OutputCSV,
+			columns:  []string{"name", "state.life_cycle_state", "tags.1", "tags"},
+			expected: "name,state.life_cycle_state,tags.1,tags\n\"a, b\",RUNNING,y,\"[\"\"x\"\",\"\"y\"\"]\"\n,,,\n",
+		},
+		{
+			format:   flags.OutputTable,
+			columns:  []string{"run_id", "state.life_cycle_state"},
+			expected: "RUN_ID  STATE.LIFE_CYCLE_STATE\n1       RUNNING\n2       \n",
+		},
+	} {
+		output := &bytes.Buffer{}
+		cmdIO := NewIO(c.format, nil, output, output, "", "")
+		cmdIO.SetColumns(c.columns)
+		ctx := InContext(context.Background(), cmdIO)
+		v := runs
+		if c.reversed {
+			v = []run{runs[1], runs[0]}
+		}
+		err := Render(ctx, v)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, output.String())
+	}
+}
+
+func TestRenderTableEmpty(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputCSV, nil, output, output, "", "")
+	cmdIO.SetColumns([]string{"id", "name"})
+	ctx := InContext(context.Background(), cmdIO)
+	err := RenderIterator(ctx, makeIterator(0))
+	assert.NoError(t, err)
+	assert.Equal(t, "id,name\n", output.String())
+}
+
+func TestRenderTableScalars(t *testing.T) {
+	output := &bytes.Buffer{}
+	ctx := InContext(context.Background(), NewIO(flags.OutputCSV, nil, output, output, "", ""))
+	err := Render(ctx, []string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "value\na\nb\n", output.String())
+}
@@ -51,3 +51,10 @@ func TestIsPureVariableReference(t *testing.T) {
 	assert.False(t, IsPureVariableReference("prefix ${foo.bar}"))
 	assert.True(t, IsPureVariableReference("${foo.bar}"))
 }
+
+func TestContainsVariableReference(t *testing.T) {
+	assert.False(t, ContainsVariableReference(""))
+	assert.False(t, ContainsVariableReference("/Users/user@example.com"))
+	assert.True(t, ContainsVariableReference("prefix ${foo.bar}"))
+	assert.True(t, ContainsVariableReference("${foo.bar}"))
+}
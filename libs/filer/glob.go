@@ -0,0 +1,166 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// HasGlobMeta returns true if the path contains any of the characters
+// that are interpreted by [Glob].
+func HasGlobMeta(p string) bool {
+	return strings.ContainsAny(p, "*?[")
+}
+
+// Glob returns the paths that match the pattern, in lexicographical order.
+//
+// The pattern syntax is the same as in [path.Match], with the addition of a path
+// component "**" that matches zero or more directories. Unlike shells, wildcards
+// match names that start with a dot. A pattern without meta characters matches
+// the path if it exists.
+//
+// Only the directories that can contain matches are listed, starting from the
+// longest prefix of the pattern without meta characters.
+func Glob(ctx context.Context, f Filer, pattern string) ([]string, error) {
+	// Check the pattern for syntax errors up front.
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	pattern = path.Clean(pattern)
+	if !HasGlobMeta(pattern) {
+		_, err := f.Stat(ctx, pattern)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []string{pattern}, nil
+	}
+
+	base := GlobBase(pattern)
+	segments := strings.Split(pattern, "/")
+	switch base {
+	case ".":
+		// The pattern is relative and starts with meta characters.
+	case "/":
+		segments = segments[1:]
+	default:
+		segments = segments[len(strings.Split(base, "/")):]
+	}
+
+	g := globber{ctx: ctx, f: f}
+	err := g.match(base, segments)
+	if err != nil {
+		return nil, err
+	}
+
+	// A pattern with multiple "**" components can match the same path more than once.
+	slices.Sort(g.matches)
+	return slices.Compact(g.matches), nil
+}
+
+// GlobBase returns the longest directory prefix of the pattern without meta characters.
+// All paths that match the pattern are below this directory.
+func GlobBase(pattern string) string {
+	segments := strings.Split(path.Clean(pattern), "/")
+	i := 0
+	for i < len(segments)-1 && !HasGlobMeta(segments[i]) {
+		i++
+	}
+
+	base := strings.Join(segments[:i], "/")
+	switch {
+	case base == "" && strings.HasPrefix(pattern, "/"):
+		return "/"
+	case base == "":
+		return "."
+	}
+	return base
+}
+
+type globber struct {
+	ctx     context.Context
+	f       Filer
+	matches []string
+}
+
+// readDir returns the entries of a directory, or no entries if the directory
+// doesn't exist or is not a directory.
+func (g *globber) readDir(dir string) ([]fs.DirEntry, error) {
+	entries, err := g.f.ReadDir(g.ctx, dir)
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+		return nil, nil
+	}
+	return entries, err
+}
+
+func globJoin(dir, name string) string {
+	if dir == "." {
+		return name
+	}
+	return path.Join(dir, name)
+}
+
+// match adds the paths below dir that match the remaining segments of the pattern.
+func (g *globber) match(dir string, segments []string) error {
+	if len(segments) == 0 {
+		g.matches = append(g.matches, dir)
+		return nil
+	}
+
+	segment := segments[0]
+	last := len(segments) == 1
+
+	// A trailing "**" matches all files and directories below dir.
+	// Elsewhere it matches zero or more directories.
+	if segment == "**" {
+		if !last {
+			err := g.match(dir, segments[1:])
+			if err != nil {
+				return err
+			}
+		}
+
+		entries, err := g.readDir(dir)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			p := globJoin(dir, entry.Name())
+			if last {
+				g.matches = append(g.matches, p)
+			}
+			if entry.IsDir() {
+				err := g.match(p, segments)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	entries, err := g.readDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		ok, err := path.Match(segment, entry.Name())
+		if err != nil {
+			return err
+		}
+		if !ok || (!last && !entry.IsDir()) {
+			continue
+		}
+		err = g.match(globJoin(dir, entry.Name()), segments[1:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
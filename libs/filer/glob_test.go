@@ -0,0 +1,86 @@
+package filer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeGlobFiler() Filer {
+	return NewFakeFiler(map[string]FakeFileInfo{
+		"/Volumes":                       {FakeDir: true},
+		"/Volumes/raw":                   {FakeDir: true},
+		"/Volumes/raw/a.json":            {FakeSize: 1},
+		"/Volumes/raw/b.json":            {FakeSize: 1},
+		"/Volumes/raw/c.csv":             {FakeSize: 1},
+		"/Volumes/raw/2024":              {FakeDir: true},
+		"/Volumes/raw/2024/d.json":       {FakeSize: 1},
+		"/Volumes/raw/2024/01":           {FakeDir: true},
+		"/Volumes/raw/2024/01/e.parquet": {FakeSize: 1},
+		"/Volumes/raw/2024/f.parquet":    {FakeSize: 1},
+	})
+}
+
+func TestGlob(t *testing.T) {
+	ctx := context.Background()
+	f := fakeGlobFiler()
+
+	for _, tc := range []struct {
+		pattern string
+		matches []string
+	}{
+		{"/Volumes/raw/*.json", []string{"/Volumes/raw/a.json", "/Volumes/raw/b.json"}},
+		{"/Volumes/raw/?.csv", []string{"/Volumes/raw/c.csv"}},
+		{"/Volumes/raw/[ab].json", []string{"/Volumes/raw/a.json", "/Volumes/raw/b.json"}},
+		{"/Volumes/*/2024/*.json", []string{"/Volumes/raw/2024/d.json"}},
+		{"/Volumes/raw/*/01", []string{"/Volumes/raw/2024/01"}},
+		{"/Volumes/raw/**/*.parquet", []string{"/Volumes/raw/2024/01/e.parquet", "/Volumes/raw/2024/f.parquet"}},
+		{"/Volumes/raw/**/*.json", []string{"/Volumes/raw/2024/d.json", "/Volumes/raw/a.json", "/Volumes/raw/b.json"}},
+		{"/Volumes/raw/2024/**", []string{"/Volumes/raw/2024/01", "/Volumes/raw/2024/01/e.parquet", "/Volumes/raw/2024/d.json", "/Volumes/raw/2024/f.parquet"}},
+		{"/Volumes/raw/**/**/e.parquet", []string{"/Volumes/raw/2024/01/e.parquet"}},
+		{"/Volumes/raw/c.csv", []string{"/Volumes/raw/c.csv"}},
+		{"/Volumes/raw/missing.csv", nil},
+		{"/Volumes/missing/*", nil},
+		{"/Volumes/raw/c.csv/*", nil},
+	} {
+		matches, err := Glob(ctx, f, tc.pattern)
+		require.NoError(t, err, tc.pattern)
+		assert.Equal(t, tc.matches, matches, tc.pattern)
+	}
+}
+
+func TestGlobRelativePattern(t *testing.T) {
+	f := NewFakeFiler(map[string]FakeFileInfo{
+		".":         {FakeDir: true},
+		"a.txt":     {FakeSize: 1},
+		"dir":       {FakeDir: true},
+		"dir/b.txt": {FakeSize: 1},
+	})
+
+	matches, err := Glob(context.Background(), f, "**/*.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt", "dir/b.txt"}, matches)
+}
+
+func TestGlobInvalidPattern(t *testing.T) {
+	_, err := Glob(context.Background(), fakeGlobFiler(), "/Volumes/[a")
+	assert.Error(t, err)
+}
+
+func TestHasGlobMeta(t *testing.T) {
+	assert.True(t, HasGlobMeta("/Volumes/*.json"))
+	assert.True(t, HasGlobMeta("file?.txt"))
+	assert.True(t, HasGlobMeta("[ab].txt"))
+	assert.False(t, HasGlobMeta("/Volumes/raw/a.json"))
+}
+
+func TestGlobBase(t *testing.T) {
+	assert.Equal(t, "/Volumes/raw", GlobBase("/Volumes/raw/*.json"))
+	assert.Equal(t, "/Volumes/raw", GlobBase("/Volumes/raw/**/*.json"))
+	assert.Equal(t, "/Volumes", GlobBase("/Volumes/*/2024/*.json"))
+	assert.Equal(t, "/", GlobBase("/*"))
+	assert.Equal(t, ".", GlobBase("*.txt"))
+	assert.Equal(t, "dir", GlobBase("dir/*.txt"))
+}
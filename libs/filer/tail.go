@@ -0,0 +1,50 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+)
+
+// Tail streams the contents of a file that is appended to while it is read,
+// for example the output of a job run that is written to the workspace.
+type Tail struct {
+	filer  Filer
+	name   string
+	out    io.Writer
+	offset int64
+}
+
+func NewTail(f Filer, name string, out io.Writer) *Tail {
+	return &Tail{
+		filer: f,
+		name:  name,
+		out:   out,
+	}
+}
+
+// Flush writes the contents that were appended to the file since the previous call.
+// It is a no-op if the file doesn't exist yet.
+func (t *Tail) Flush(ctx context.Context) error {
+	r, err := t.filer.Read(ctx, t.name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if int64(len(buf)) <= t.offset {
+		return nil
+	}
+
+	_, err = t.out.Write(buf[t.offset:])
+	t.offset = int64(len(buf))
+	return err
+}
@@ -0,0 +1,34 @@
+package filer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTail(t *testing.T) {
+	ctx := context.Background()
+	f, err := NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	tail := NewTail(f, "output.txt", &out)
+
+	// The file doesn't exist yet.
+	require.NoError(t, tail.Flush(ctx))
+	assert.Empty(t, out.String())
+
+	require.NoError(t, f.Write(ctx, "output.txt", strings.NewReader("hello\n")))
+	require.NoError(t, tail.Flush(ctx))
+	assert.Equal(t, "hello\n", out.String())
+
+	// Only the contents that were appended are written.
+	require.NoError(t, f.Write(ctx, "output.txt", strings.NewReader("hello\nworld\n"), OverwriteIfExists))
+	require.NoError(t, tail.Flush(ctx))
+	require.NoError(t, tail.Flush(ctx))
+	assert.Equal(t, "hello\nworld\n", out.String())
+}
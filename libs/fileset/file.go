@@ -1,6 +1,9 @@
 package fileset
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
 	"io/fs"
 	"time"
 
@@ -26,6 +29,9 @@ type File struct {
 	// Type of the file.
 	fileType fileType
 
+	// Hash of the file contents, computed on first use.
+	hash string
+
 	// Relative path within the fileset.
 	// Combine with the [vfs.Path] to interact with the underlying file.
 	Relative string
@@ -67,6 +73,15 @@ func (f File) Modified() (ts time.Time) {
 	return info.ModTime()
 }
 
+// Size returns the size of the file in bytes, or 0 if it cannot be determined.
+func (f File) Size() int64 {
+	info, err := f.entry.Info()
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
 func (f *File) IsNotebook() (bool, error) {
 	if f.fileType != Unknown {
 		return f.fileType == Notebook, nil
@@ -84,3 +99,25 @@ func (f *File) IsNotebook() (bool, error) {
 	}
 	return isNotebook, nil
 }
+
+// Hash returns the hex-encoded SHA-256 hash of the file contents.
+func (f *File) Hash() (string, error) {
+	if f.hash != "" {
+		return f.hash, nil
+	}
+
+	r, err := f.root.Open(f.Relative)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+
+	f.hash = hex.EncodeToString(h.Sum(nil))
+	return f.hash, nil
+}
@@ -0,0 +1,44 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// LogFormat controls the format of log records.
+// It is separate from [Output], because logs only support text and JSON.
+type LogFormat string
+
+const (
+	LogFormatText LogFormat = "text"
+	LogFormatJSON LogFormat = "json"
+)
+
+func (f *LogFormat) String() string {
+	return string(*f)
+}
+
+func (f *LogFormat) Set(s string) error {
+	lower := strings.ToLower(s)
+	switch lower {
+	case `json`, `text`:
+		*f = LogFormat(lower)
+	default:
+		return fmt.Errorf("accepted arguments are json and text")
+	}
+	return nil
+}
+
+func (f *LogFormat) Type() string {
+	return "type"
+}
+
+// Complete is the Cobra compatible completion function for this flag.
+func (f *LogFormat) Complete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		fmt.Sprint(LogFormatText),
+		fmt.Sprint(LogFormatJSON),
+	}, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogFormatFlag(t *testing.T) {
+	var f LogFormat
+	var err error
+
+	// Output formats that are not supported for logs.
+	for _, v := range []string{"foo", "jsonl", "yaml", "csv", "tsv", "table"} {
+		err = f.Set(v)
+		assert.EqualError(t, err, "accepted arguments are json and text")
+	}
+
+	err = f.Set("TEXT")
+	assert.NoError(t, err)
+	assert.Equal(t, "text", f.String())
+
+	err = f.Set("JSON")
+	assert.NoError(t, err)
+	assert.Equal(t, "json", f.String())
+}
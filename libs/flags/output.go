@@ -2,6 +2,7 @@ package flags
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,22 +12,40 @@ import (
 type Output string
 
 const (
-	OutputText Output = "text"
-	OutputJSON Output = "json"
+	OutputText  Output = "text"
+	OutputJSON  Output = "json"
+	OutputJSONL Output = "jsonl"
+	OutputYAML  Output = "yaml"
+	OutputCSV   Output = "csv"
+	OutputTSV   Output = "tsv"
+	OutputTable Output = "table"
 )
 
+var outputs = []Output{
+	OutputText,
+	OutputJSON,
+	OutputJSONL,
+	OutputYAML,
+	OutputCSV,
+	OutputTSV,
+	OutputTable,
+}
+
+// IsTabular returns true if the output is rendered as rows and columns.
+func (f Output) IsTabular() bool {
+	return f == OutputCSV || f == OutputTSV || f == OutputTable
+}
+
 func (f *Output) String() string {
 	return string(*f)
 }
 
 func (f *Output) Set(s string) error {
-	lower := strings.ToLower(s)
-	switch lower {
-	case `json`, `text`:
-		*f = Output(lower)
-	default:
-		return fmt.Errorf("accepted arguments are json and text")
+	lower := Output(strings.ToLower(s))
+	if !slices.Contains(outputs, lower) {
+		return fmt.Errorf("accepted arguments are text, json, jsonl, yaml, csv, tsv and table")
 	}
+	*f = lower
 	return nil
 }
 
@@ -36,8 +55,9 @@ func (f *Output) Type() string {
 
 // Complete is the Cobra compatible completion function for this flag.
 func (f *Output) Complete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{
-		fmt.Sprint(OutputText),
-		fmt.Sprint(OutputJSON),
-	}, cobra.ShellCompDirectiveNoFileComp
+	var out []string
+	for _, o := range outputs {
+		out = append(out, fmt.Sprint(o))
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
 }
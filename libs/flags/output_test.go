@@ -1,6 +1,7 @@
 package flags
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,7 +13,7 @@ func TestOutputFlag(t *testing.T) {
 
 	// Invalid
 	err = f.Set("foo")
-	assert.EqualError(t, err, "accepted arguments are json and text")
+	assert.EqualError(t, err, "accepted arguments are text, json, jsonl, yaml, csv, tsv and table")
 
 	// Lowercase
 	err = f.Set("text")
@@ -33,4 +34,18 @@ func TestOutputFlag(t *testing.T) {
 	err = f.Set("JSON")
 	assert.NoError(t, err)
 	assert.Equal(t, "json", f.String())
+
+	for _, v := range []string{"jsonl", "yaml", "csv", "tsv", "table"} {
+		err = f.Set(strings.ToUpper(v))
+		assert.NoError(t, err)
+		assert.Equal(t, v, f.String())
+	}
+}
+
+func TestOutputIsTabular(t *testing.T) {
+	assert.True(t, OutputCSV.IsTabular())
+	assert.True(t, OutputTSV.IsTabular())
+	assert.True(t, OutputTable.IsTabular())
+	assert.False(t, OutputJSON.IsTabular())
+	assert.False(t, OutputText.IsTabular())
 }
@@ -9,9 +9,11 @@ import (
 	"io"
 	"io/fs"
 	"slices"
+	"sync"
 	"time"
 
 	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/google/uuid"
 )
@@ -47,6 +49,13 @@ const LockFileName = "deploy.lock"
 //     b.  forcefully acquiring a lock(s) on TargetDir can break the assumption
 //     of exclusive access that other clients with non forcefully acquired
 //     locks might have
+//
+//  4. If the locker is configured with a lease duration, the client holding the
+//     lock periodically refreshes it while it is held. A lock that has not been
+//     refreshed within its lease duration is considered stale, for example
+//     because the client holding it was killed, and is taken over by the next
+//     client that tries to acquire it. This comes with the same caveats as
+//     forcefully acquiring a lock.
 type Locker struct {
 	filer filer.Filer
 
@@ -56,8 +65,20 @@ type Locker struct {
 	// This implication break down if locks are forcefully acquired by a user
 	Active bool
 	// if locker is active, this information about the locker is uploaded onto
-	// the workspace so as to let other clients details about the active locker
+	// the workspace so as to let other clients details about the active locker.
+	// It is updated by the heartbeat while the lock is held, so it must be read
+	// through [Locker.CurrentState] while the lock is held.
 	State *LockState
+
+	// guards State, which is written by the heartbeat goroutine
+	mu sync.Mutex
+
+	// LeaseDuration is the duration after which a lock that has not been
+	// refreshed is considered stale. Zero means the lock never becomes stale.
+	LeaseDuration time.Duration
+
+	// stops the goroutine that refreshes the lock while it is held
+	stopHeartbeat func()
 }
 
 type LockState struct {
@@ -70,6 +91,47 @@ type LockState struct {
 	IsForced bool
 	// creator of this locker
 	User string
+	// host name of the machine the locker runs on
+	Host string
+	// command the lock was acquired for, e.g. "bundle deploy"
+	Command string
+	// last timestamp when the lock was refreshed by its holder
+	RefreshTime time.Time
+	// timestamp after which the lock is considered stale if it is not refreshed.
+	// Locks written without a lease duration have a zero expiry time and never
+	// become stale.
+	ExpiryTime time.Time
+}
+
+// IsStale returns true if the lock was not refreshed within its lease duration.
+func (s *LockState) IsStale(now time.Time) bool {
+	return !s.ExpiryTime.IsZero() && now.After(s.ExpiryTime)
+}
+
+// LockHeldError is returned if a lock cannot be acquired because it is held
+// by another locker.
+type LockHeldError struct {
+	State *LockState
+}
+
+func (e LockHeldError) Error() string {
+	if e.State.IsForced {
+		return fmt.Sprintf("deploy lock force acquired by %s at %v. Use --force-lock to override", e.State.User, e.State.AcquisitionTime)
+	}
+	return fmt.Sprintf("deploy lock acquired by %s at %v. Use --force-lock to override", e.State.User, e.State.AcquisitionTime)
+}
+
+// CurrentState returns the state of this locker.
+func (locker *Locker) CurrentState() *LockState {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	return locker.State
+}
+
+func (locker *Locker) setState(state *LockState) {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	locker.State = state
 }
 
 // GetActiveLockState returns current lock state, irrespective of us holding it.
@@ -104,15 +166,32 @@ func (locker *Locker) assertLockHeld(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	if activeLockState.ID != locker.State.ID && !activeLockState.IsForced {
-		return fmt.Errorf("deploy lock acquired by %s at %v. Use --force-lock to override", activeLockState.User, activeLockState.AcquisitionTime)
-	}
-	if activeLockState.ID != locker.State.ID && activeLockState.IsForced {
-		return fmt.Errorf("deploy lock force acquired by %s at %v. Use --force-lock to override", activeLockState.User, activeLockState.AcquisitionTime)
+	if activeLockState.ID != locker.CurrentState().ID {
+		return LockHeldError{State: activeLockState}
 	}
 	return nil
 }
 
+// newState returns the state to write to the lock file, with the acquisition
+// time set to the specified time.
+func (locker *Locker) newState(acquisitionTime time.Time, isForced bool) LockState {
+	now := time.Now()
+	current := locker.CurrentState()
+	state := LockState{
+		ID:              current.ID,
+		AcquisitionTime: acquisitionTime,
+		IsForced:        isForced,
+		User:            current.User,
+		Host:            current.Host,
+		Command:         current.Command,
+		RefreshTime:     now,
+	}
+	if locker.LeaseDuration > 0 {
+		state.ExpiryTime = now.Add(locker.LeaseDuration)
+	}
+	return state
+}
+
 // idempotent function since overwrite is set to true
 func (locker *Locker) Write(ctx context.Context, pathToFile string, content []byte) error {
 	if !locker.Active {
@@ -129,12 +208,7 @@ func (locker *Locker) Read(ctx context.Context, path string) (io.ReadCloser, err
 }
 
 func (locker *Locker) Lock(ctx context.Context, isForced bool) error {
-	newLockerState := LockState{
-		ID:              locker.State.ID,
-		AcquisitionTime: time.Now(),
-		IsForced:        isForced,
-		User:            locker.State.User,
-	}
+	newLockerState := locker.newState(time.Now(), isForced)
 	buf, err := json.Marshal(newLockerState)
 	if err != nil {
 		return err
@@ -159,6 +233,16 @@ func (locker *Locker) Lock(ctx context.Context, isForced bool) error {
 		if !errors.As(err, &filer.FileAlreadyExistsError{}) {
 			return err
 		}
+
+		// Take over the lock if its holder failed to refresh it.
+		activeLockState, err := locker.GetActiveLockState(ctx)
+		if err == nil && activeLockState.IsStale(time.Now()) {
+			log.Warnf(ctx, "Taking over stale deploy lock acquired by %s at %v", activeLockState.User, activeLockState.AcquisitionTime)
+			err = locker.filer.Write(ctx, LockFileName, bytes.NewReader(buf), filer.OverwriteIfExists)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	err = locker.assertLockHeld(ctx)
@@ -166,16 +250,85 @@ func (locker *Locker) Lock(ctx context.Context, isForced bool) error {
 		return err
 	}
 
-	locker.State = &newLockerState
+	locker.setState(&newLockerState)
 	locker.Active = true
+	locker.startHeartbeat(ctx)
+	return nil
+}
+
+// Refresh extends the lease of the lock held by this locker.
+func (locker *Locker) Refresh(ctx context.Context) error {
+	if !locker.Active {
+		return fmt.Errorf("refresh called when lock is not held")
+	}
+
+	err := locker.assertLockHeld(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh called when lock is not held: %w", err)
+	}
+
+	current := locker.CurrentState()
+	newLockerState := locker.newState(current.AcquisitionTime, current.IsForced)
+	buf, err := json.Marshal(newLockerState)
+	if err != nil {
+		return err
+	}
+
+	err = locker.filer.Write(ctx, LockFileName, bytes.NewReader(buf), filer.OverwriteIfExists)
+	if err != nil {
+		return err
+	}
+
+	locker.setState(&newLockerState)
 	return nil
 }
 
+// startHeartbeat periodically refreshes the lock while it is held, such that
+// it does not become stale. It is a no-op if the locker has no lease duration.
+func (locker *Locker) startHeartbeat(ctx context.Context) {
+	if locker.LeaseDuration <= 0 {
+		return
+	}
+
+	// Refresh well before the lease expires to tolerate transient failures.
+	interval := locker.LeaseDuration / 3
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := locker.Refresh(ctx)
+				if err != nil && ctx.Err() == nil {
+					log.Warnf(ctx, "Failed to refresh deploy lock: %v", err)
+				}
+			}
+		}
+	}()
+
+	locker.stopHeartbeat = func() {
+		cancel()
+		<-done
+	}
+}
+
 func (locker *Locker) Unlock(ctx context.Context, opts ...UnlockOption) error {
 	if !locker.Active {
 		return fmt.Errorf("unlock called when lock is not held")
 	}
 
+	// Stop refreshing the lock before removing it.
+	if locker.stopHeartbeat != nil {
+		locker.stopHeartbeat()
+		locker.stopHeartbeat = nil
+	}
+
 	// if allowLockFileNotExist is set, do not throw an error if the lock file does
 	// not exist. This is helpful when destroying a bundle in which case the lock
 	// file will be deleted before we have a chance to unlock
@@ -196,6 +349,38 @@ func (locker *Locker) Unlock(ctx context.Context, opts ...UnlockOption) error {
 	return nil
 }
 
+// Release removes the lock file irrespective of the locker holding it. Unless
+// force is set, it only removes locks that are stale. This is used to clean
+// up locks that were not released because their holder was killed.
+func (locker *Locker) Release(ctx context.Context, force bool) error {
+	activeLockState, err := locker.GetActiveLockState(ctx)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("no active deploy lock")
+	}
+	if err != nil {
+		return err
+	}
+
+	if !force && !activeLockState.IsStale(time.Now()) {
+		return fmt.Errorf("deploy lock acquired by %s at %v is not stale. Use --force to release it anyway", activeLockState.User, activeLockState.AcquisitionTime)
+	}
+
+	// Another client may have taken over or refreshed the lock since it was read.
+	// Read it again immediately before deleting it to keep this window small.
+	currentLockState, err := locker.GetActiveLockState(ctx)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if currentLockState.ID != activeLockState.ID || !currentLockState.RefreshTime.Equal(activeLockState.RefreshTime) {
+		return fmt.Errorf("deploy lock was acquired or refreshed by %s while releasing it", currentLockState.User)
+	}
+
+	return locker.filer.Delete(ctx, LockFileName)
+}
+
 func CreateLocker(user string, targetDir string, w *databricks.WorkspaceClient) (*Locker, error) {
 	filer, err := filer.NewWorkspaceFilesClient(w, targetDir)
 	if err != nil {
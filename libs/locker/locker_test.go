@@ -0,0 +1,183 @@
+package locker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocker(t *testing.T, f filer.Filer, user string, lease time.Duration) *Locker {
+	return &Locker{
+		filer: f,
+		State: &LockState{
+			ID:   uuid.New(),
+			User: user,
+		},
+		LeaseDuration: lease,
+	}
+}
+
+func newTestFiler(t *testing.T) filer.Filer {
+	f, err := filer.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	return f
+}
+
+func writeLockState(t *testing.T, f filer.Filer, state LockState) {
+	buf, err := json.Marshal(state)
+	require.NoError(t, err)
+	err = f.Write(context.Background(), LockFileName, bytes.NewReader(buf), filer.OverwriteIfExists)
+	require.NoError(t, err)
+}
+
+func TestLockHeldByOtherLocker(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFiler(t)
+
+	first := newTestLocker(t, f, "first", 0)
+	require.NoError(t, first.Lock(ctx, false))
+
+	second := newTestLocker(t, f, "second", 0)
+	err := second.Lock(ctx, false)
+	assert.ErrorAs(t, err, &LockHeldError{})
+	assert.ErrorContains(t, err, "deploy lock acquired by first")
+	assert.False(t, second.Active)
+
+	require.NoError(t, first.Unlock(ctx))
+	require.NoError(t, second.Lock(ctx, false))
+	require.NoError(t, second.Unlock(ctx))
+}
+
+func TestLockTakesOverStaleLock(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFiler(t)
+
+	writeLockState(t, f, LockState{
+		ID:              uuid.New(),
+		User:            "killed",
+		AcquisitionTime: time.Now().Add(-time.Hour),
+		ExpiryTime:      time.Now().Add(-time.Minute),
+	})
+
+	l := newTestLocker(t, f, "user", time.Hour)
+	require.NoError(t, l.Lock(ctx, false))
+	assert.True(t, l.Active)
+
+	state, err := l.GetActiveLockState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "user", state.User)
+	assert.False(t, state.IsStale(time.Now()))
+
+	require.NoError(t, l.Unlock(ctx))
+}
+
+func TestLockDoesNotTakeOverLockWithoutExpiry(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFiler(t)
+
+	writeLockState(t, f, LockState{
+		ID:              uuid.New(),
+		User:            "legacy",
+		AcquisitionTime: time.Now().Add(-24 * time.Hour),
+	})
+
+	l := newTestLocker(t, f, "user", time.Hour)
+	err := l.Lock(ctx, false)
+	assert.ErrorContains(t, err, "deploy lock acquired by legacy")
+}
+
+func TestLockHeartbeatRefreshesLock(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFiler(t)
+
+	l := newTestLocker(t, f, "user", 30*time.Millisecond)
+	require.NoError(t, l.Lock(ctx, false))
+	acquired := l.State.AcquisitionTime
+
+	assert.Eventually(t, func() bool {
+		state, err := l.GetActiveLockState(ctx)
+		return err == nil && state.RefreshTime.After(acquired)
+	}, time.Second, 5*time.Millisecond)
+
+	// The state is updated by the heartbeat and can be read concurrently.
+	assert.Eventually(t, func() bool {
+		return l.CurrentState().RefreshTime.After(acquired)
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, l.Unlock(ctx))
+	_, err := l.GetActiveLockState(ctx)
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+}
+
+func TestReleaseStaleLock(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFiler(t)
+	l := newTestLocker(t, f, "user", 0)
+
+	err := l.Release(ctx, false)
+	assert.EqualError(t, err, "no active deploy lock")
+
+	writeLockState(t, f, LockState{
+		ID:         uuid.New(),
+		User:       "other",
+		ExpiryTime: time.Now().Add(time.Hour),
+	})
+
+	err = l.Release(ctx, false)
+	assert.ErrorContains(t, err, "is not stale. Use --force to release it anyway")
+
+	require.NoError(t, l.Release(ctx, true))
+	_, err = l.GetActiveLockState(ctx)
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	writeLockState(t, f, LockState{
+		ID:         uuid.New(),
+		User:       "other",
+		ExpiryTime: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, l.Release(ctx, false))
+}
+
+// takeOverFiler simulates another client taking over the lock after it is first read.
+type takeOverFiler struct {
+	filer.Filer
+	t     *testing.T
+	reads int
+}
+
+func (f *takeOverFiler) Read(ctx context.Context, path string) (io.ReadCloser, error) {
+	f.reads++
+	if f.reads == 2 {
+		writeLockState(f.t, f.Filer, LockState{ID: uuid.New(), User: "new"})
+	}
+	return f.Filer.Read(ctx, path)
+}
+
+func TestReleaseLockTakenOver(t *testing.T) {
+	ctx := context.Background()
+	f := &takeOverFiler{Filer: newTestFiler(t), t: t}
+	l := newTestLocker(t, f, "user", 0)
+
+	writeLockState(t, f.Filer, LockState{
+		ID:         uuid.New(),
+		User:       "other",
+		ExpiryTime: time.Now().Add(-time.Hour),
+	})
+
+	err := l.Release(ctx, false)
+	assert.EqualError(t, err, "deploy lock was acquired or refreshed by new while releasing it")
+
+	state, err := l.GetActiveLockState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "new", state.User)
+}
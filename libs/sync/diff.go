@@ -61,8 +61,8 @@ func (d *diff) addFilesWithRemoteNameChanged(after *SnapshotState, before *Snaps
 
 // Add operators for files that were not being tracked before.
 func (d *diff) addNewFiles(after *SnapshotState, before *SnapshotState) {
-	for localName := range after.LastModifiedTimes {
-		if _, ok := before.LastModifiedTimes[localName]; !ok {
+	for localName := range after.ContentHashes {
+		if _, ok := before.ContentHashes[localName]; !ok {
 			d.put = append(d.put, localName)
 		}
 	}
@@ -74,10 +74,11 @@ func (d *diff) addNewFiles(after *SnapshotState, before *SnapshotState) {
 }
 
 // Add operators for files which had their contents updated.
+// Files with an unknown previous hash are always updated.
 func (d *diff) addUpdatedFiles(after *SnapshotState, before *SnapshotState) {
-	for localName, modTime := range after.LastModifiedTimes {
-		prevModTime, ok := before.LastModifiedTimes[localName]
-		if ok && modTime.After(prevModTime) {
+	for localName, hash := range after.ContentHashes {
+		prevHash, ok := before.ContentHashes[localName]
+		if ok && (prevHash == "" || hash != prevHash) {
 			d.put = append(d.put, localName)
 		}
 	}
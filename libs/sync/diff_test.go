@@ -2,7 +2,6 @@ package sync
 
 import (
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -94,7 +93,6 @@ func TestDiffComputationForRemovedFiles(t *testing.T) {
 }
 
 func TestDiffComputationWhenRemoteNameIsChanged(t *testing.T) {
-	tick := time.Now()
 	before := &SnapshotState{
 		LocalToRemoteNames: map[string]string{
 			"foo/a/b/c.py": "foo/a/b/c",
@@ -102,11 +100,10 @@ func TestDiffComputationWhenRemoteNameIsChanged(t *testing.T) {
 		RemoteToLocalNames: map[string]string{
 			"foo/a/b/c": "foo/a/b/c.py",
 		},
-		LastModifiedTimes: map[string]time.Time{
-			"foo/a/b/c.py": tick,
+		ContentHashes: map[string]string{
+			"foo/a/b/c.py": "before",
 		},
 	}
-	tick = tick.Add(time.Second)
 	after := &SnapshotState{
 		LocalToRemoteNames: map[string]string{
 			"foo/a/b/c.py": "foo/a/b/c.py",
@@ -114,8 +111,8 @@ func TestDiffComputationWhenRemoteNameIsChanged(t *testing.T) {
 		RemoteToLocalNames: map[string]string{
 			"foo/a/b/c.py": "foo/a/b/c.py",
 		},
-		LastModifiedTimes: map[string]time.Time{
-			"foo/a/b/c.py": tick,
+		ContentHashes: map[string]string{
+			"foo/a/b/c.py": "after",
 		},
 	}
 
@@ -136,8 +133,8 @@ func TestDiffComputationForNewFiles(t *testing.T) {
 		RemoteToLocalNames: map[string]string{
 			"foo/a/b/c": "foo/a/b/c.py",
 		},
-		LastModifiedTimes: map[string]time.Time{
-			"foo/a/b/c.py": time.Now(),
+		ContentHashes: map[string]string{
+			"foo/a/b/c.py": "hash",
 		},
 	}
 
@@ -151,7 +148,6 @@ func TestDiffComputationForNewFiles(t *testing.T) {
 }
 
 func TestDiffComputationForUpdatedFiles(t *testing.T) {
-	tick := time.Now()
 	before := &SnapshotState{
 		LocalToRemoteNames: map[string]string{
 			"foo/a/b/c": "foo/a/b/c",
@@ -159,11 +155,10 @@ func TestDiffComputationForUpdatedFiles(t *testing.T) {
 		RemoteToLocalNames: map[string]string{
 			"foo/a/b/c": "foo/a/b/c",
 		},
-		LastModifiedTimes: map[string]time.Time{
-			"foo/a/b/c": tick,
+		ContentHashes: map[string]string{
+			"foo/a/b/c": "before",
 		},
 	}
-	tick = tick.Add(time.Second)
 	after := &SnapshotState{
 		LocalToRemoteNames: map[string]string{
 			"foo/a/b/c": "foo/a/b/c",
@@ -171,8 +166,8 @@ func TestDiffComputationForUpdatedFiles(t *testing.T) {
 		RemoteToLocalNames: map[string]string{
 			"foo/a/b/c": "foo/a/b/c",
 		},
-		LastModifiedTimes: map[string]time.Time{
-			"foo/a/b/c": tick,
+		ContentHashes: map[string]string{
+			"foo/a/b/c": "after",
 		},
 	}
 
@@ -18,14 +18,18 @@ import (
 )
 
 // Bump it up every time a potentially breaking change is made to the snapshot schema
-const LatestSnapshotVersion = "v1"
+const LatestSnapshotVersion = "v2"
+
+// Snapshots of this version record last modified times instead of content hashes.
+// They are migrated to the latest version upon loading.
+const snapshotVersionV1 = "v1"
 
 // A snapshot is a persistant store of knowledge this CLI has about state of files
-// in the remote repo. We use the last modified times (mtime) of files to determine
+// in the remote repo. We use the hashes of the contents of files to determine
 // whether a files need to be updated in the remote repo.
 //
-// 1. Any stale files in the remote repo are updated. That is if the last modified
-// time recorded in the snapshot is less than the actual last modified time of the file
+// 1. Any stale files in the remote repo are updated. That is if the content hash
+// recorded in the snapshot is different from the actual content hash of the file
 //
 // 2. Any files present in snapshot but absent locally are deleted from remote path
 //
@@ -51,23 +55,41 @@ type Snapshot struct {
 	RemotePath string `json:"remote_path"`
 
 	*SnapshotState
+
+	// Last modified times recorded by a v1 snapshot this snapshot was migrated from.
+	// Files that have not been modified since are considered to be unchanged.
+	legacyModifiedTimes map[string]time.Time
+}
+
+// snapshotV1 is the schema of v1 snapshots.
+type snapshotV1 struct {
+	LastModifiedTimes  map[string]time.Time `json:"last_modified_times"`
+	LocalToRemoteNames map[string]string    `json:"local_to_remote_names"`
+	RemoteToLocalNames map[string]string    `json:"remote_to_local_names"`
 }
 
 const syncSnapshotDirName = "sync-snapshots"
 
-func NewSnapshot(localFiles []fileset.File, opts *SyncOptions) (*Snapshot, error) {
+// NewSnapshot returns a snapshot for files that have been synced before, for
+// example as part of a deployment. The hashes map local file names to the
+// hash of their contents when they were synced. Files without a recorded
+// hash are synced again.
+func NewSnapshot(localFiles []fileset.File, hashes map[string]string, opts *SyncOptions) (*Snapshot, error) {
 	snapshotPath, err := SnapshotPath(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	snapshotState, err := NewSnapshotState(localFiles)
+	snapshotState, err := newSnapshotState(localFiles, func(f *fileset.File) (string, error) {
+		return hashes[f.Relative], nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Reset last modified times to make sure all files are synced
-	snapshotState.ResetLastModifiedTimes()
+	// The hashes were not computed from the local files, so the modified times
+	// and sizes of the local files don't indicate that the hashes are current.
+	snapshotState.FileStats = make(map[string]FileStat)
 
 	return &Snapshot{
 		snapshotPath:  snapshotPath,
@@ -114,7 +136,8 @@ func newSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error) {
 		Host:       opts.Host,
 		RemotePath: opts.RemotePath,
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
+			FileStats:          make(map[string]FileStat),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -162,6 +185,11 @@ func loadOrNewSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error
 		return nil, fmt.Errorf("failed to json unmarshal persisted snapshot: %s", err)
 	}
 
+	if fromDisk.Version == snapshotVersionV1 {
+		log.Infof(ctx, "Migrating snapshot from version %s to %s", snapshotVersionV1, LatestSnapshotVersion)
+		return migrateSnapshotV1(snapshot, bytes)
+	}
+
 	// invalidate old snapshot with schema versions
 	if fromDisk.Version != LatestSnapshotVersion {
 		log.Warnf(ctx, "Did not load existing snapshot because its version is %s while the latest version is %s", snapshot.Version, LatestSnapshotVersion)
@@ -183,17 +211,63 @@ func loadOrNewSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error
 	return snapshot, nil
 }
 
-func (s *Snapshot) diff(ctx context.Context, all []fileset.File) (diff, error) {
-	targetState, err := NewSnapshotState(all)
+// migrateSnapshotV1 populates the snapshot from the contents of a v1 snapshot.
+// The content hashes of files are unknown until the next diff, see [Snapshot.diff].
+func migrateSnapshotV1(snapshot *Snapshot, bytes []byte) (*Snapshot, error) {
+	var v1 snapshotV1
+	err := json.Unmarshal(bytes, &v1)
 	if err != nil {
-		return diff{}, fmt.Errorf("error while computing new sync state: %w", err)
+		return nil, fmt.Errorf("failed to json unmarshal persisted snapshot: %s", err)
+	}
+
+	state := &SnapshotState{
+		ContentHashes:      make(map[string]string),
+		LocalToRemoteNames: v1.LocalToRemoteNames,
+		RemoteToLocalNames: v1.RemoteToLocalNames,
+	}
+	for k := range v1.LastModifiedTimes {
+		state.ContentHashes[k] = ""
 	}
 
+	snapshot.SnapshotState = state.ToSlash()
+	snapshot.legacyModifiedTimes = make(map[string]time.Time)
+	for k, v := range v1.LastModifiedTimes {
+		snapshot.legacyModifiedTimes[filepath.ToSlash(k)] = v
+	}
+	snapshot.New = false
+	return snapshot, nil
+}
+
+func (s *Snapshot) diff(ctx context.Context, all []fileset.File) (diff, error) {
 	currentState := s.SnapshotState
 	if err := currentState.validate(); err != nil {
 		return diff{}, fmt.Errorf("error parsing existing sync state. Please delete your existing sync snapshot file (%s) and retry: %w", s.snapshotPath, err)
 	}
 
+	targetState, err := newSnapshotState(all, currentState.cachedHash)
+	if err != nil {
+		return diff{}, fmt.Errorf("error while computing new sync state: %w", err)
+	}
+
+	// Files from a migrated v1 snapshot that have not been modified since they
+	// were last synced have the same contents as their remote version.
+	if s.legacyModifiedTimes != nil {
+		for _, f := range all {
+			modTime, ok := s.legacyModifiedTimes[f.Relative]
+			if !ok || f.Modified().After(modTime) {
+				continue
+			}
+			prevHash, ok := currentState.ContentHashes[f.Relative]
+			if !ok || prevHash != "" {
+				continue
+			}
+			if hash, ok := targetState.ContentHashes[f.Relative]; ok {
+				currentState.ContentHashes[f.Relative] = hash
+			}
+		}
+		s.legacyModifiedTimes = nil
+	}
+
 	// Compute diff to apply to get from current state to new target state.
 	diff := computeDiff(targetState, currentState)
 
@@ -13,9 +13,15 @@ import (
 // SnapshotState keeps track of files on the local filesystem and their corresponding
 // entries in WSFS.
 type SnapshotState struct {
-	// Map of local file names to their last recorded modified time. Files found
-	// to have a newer mtime have their content synced to their remote version.
-	LastModifiedTimes map[string]time.Time `json:"last_modified_times"`
+	// Map of local file names to the hash of their contents when they were last
+	// synced. Files found to have a different hash have their content synced to
+	// their remote version. An empty hash means the contents are unknown.
+	ContentHashes map[string]string `json:"content_hashes"`
+
+	// Map of local file names to the last modified time and size of the file when
+	// its content hash was computed. Files whose modified time and size are unchanged
+	// are not read again to compute their hash.
+	FileStats map[string]FileStat `json:"file_stats,omitempty"`
 
 	// Map of local file names to corresponding remote names.
 	// For example: A notebook named "foo.py" locally would be stored as "foo"
@@ -27,10 +33,23 @@ type SnapshotState struct {
 	RemoteToLocalNames map[string]string `json:"remote_to_local_names"`
 }
 
+// FileStat holds the properties of a local file that indicate it has changed.
+type FileStat struct {
+	ModTime time.Time `json:"mod_time"`
+	Size    int64     `json:"size"`
+}
+
 // Convert an array of files on the local file system to a SnapshotState representation.
 func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
+	return newSnapshotState(localFiles, func(f *fileset.File) (string, error) {
+		return f.Hash()
+	})
+}
+
+func newSnapshotState(localFiles []fileset.File, hash func(f *fileset.File) (string, error)) (*SnapshotState, error) {
 	fs := &SnapshotState{
-		LastModifiedTimes:  make(map[string]time.Time),
+		ContentHashes:      make(map[string]string),
+		FileStats:          make(map[string]FileStat),
 		LocalToRemoteNames: make(map[string]string),
 		RemoteToLocalNames: make(map[string]string),
 	}
@@ -62,8 +81,16 @@ func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
 			remoteName = strings.TrimSuffix(remoteName, ext)
 		}
 
+		// Don't ignore files that cannot be read. They would be considered
+		// removed and their remote version would be deleted.
+		h, err := hash(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute hash of %s: %w", f.Relative, err)
+		}
+
 		// Add the file to snapshot state
-		fs.LastModifiedTimes[f.Relative] = f.Modified()
+		fs.ContentHashes[f.Relative] = h
+		fs.FileStats[f.Relative] = FileStat{ModTime: f.Modified(), Size: f.Size()}
 		if existingLocalName, ok := fs.RemoteToLocalNames[remoteName]; ok {
 			return nil, fmt.Errorf("both %s and %s point to the same remote file location %s. Please remove one of them from your local project", existingLocalName, f.Relative, remoteName)
 		}
@@ -74,30 +101,36 @@ func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
 	return fs, nil
 }
 
-func (fs *SnapshotState) ResetLastModifiedTimes() {
-	for k := range fs.LastModifiedTimes {
-		fs.LastModifiedTimes[k] = time.Unix(0, 0)
+// cachedHash returns the hash of the file recorded in this state if the file has the
+// same modified time and size as when the hash was computed. Otherwise, it computes
+// the hash from the contents of the file.
+func (fs *SnapshotState) cachedHash(f *fileset.File) (string, error) {
+	h := fs.ContentHashes[f.Relative]
+	stat, ok := fs.FileStats[f.Relative]
+	if h != "" && ok && !stat.ModTime.IsZero() && stat.ModTime.Equal(f.Modified()) && stat.Size == f.Size() {
+		return h, nil
 	}
+	return f.Hash()
 }
 
 // Consistency checks for the sync files state representation. These are invariants
 // that downstream code for computing changes to apply to WSFS depends on.
 //
 // Invariants:
-//  1. All entries in LastModifiedTimes have a corresponding entry in LocalToRemoteNames
+//  1. All entries in ContentHashes have a corresponding entry in LocalToRemoteNames
 //     and vice versa.
 //  2. LocalToRemoteNames and RemoteToLocalNames together form a 1:1 mapping of
 //     local <-> remote file names.
 func (fs *SnapshotState) validate() error {
 	// Validate invariant (1)
-	for localName := range fs.LastModifiedTimes {
+	for localName := range fs.ContentHashes {
 		if _, ok := fs.LocalToRemoteNames[localName]; !ok {
 			return fmt.Errorf("invalid sync state representation. Local file %s is missing the corresponding remote file", localName)
 		}
 	}
 	for localName := range fs.LocalToRemoteNames {
-		if _, ok := fs.LastModifiedTimes[localName]; !ok {
-			return fmt.Errorf("invalid sync state representation. Local file %s is missing it's last modified time", localName)
+		if _, ok := fs.ContentHashes[localName]; !ok {
+			return fmt.Errorf("invalid sync state representation. Local file %s is missing its content hash", localName)
 		}
 	}
 
@@ -125,14 +158,20 @@ func (fs *SnapshotState) validate() error {
 // are slash-separated. Returns a new snapshot state.
 func (old SnapshotState) ToSlash() *SnapshotState {
 	new := SnapshotState{
-		LastModifiedTimes:  make(map[string]time.Time),
+		ContentHashes:      make(map[string]string),
+		FileStats:          make(map[string]FileStat),
 		LocalToRemoteNames: make(map[string]string),
 		RemoteToLocalNames: make(map[string]string),
 	}
 
 	// Keys are local paths.
-	for k, v := range old.LastModifiedTimes {
-		new.LastModifiedTimes[filepath.ToSlash(k)] = v
+	for k, v := range old.ContentHashes {
+		new.ContentHashes[filepath.ToSlash(k)] = v
+	}
+
+	// Keys are local paths.
+	for k, v := range old.FileStats {
+		new.FileStats[filepath.ToSlash(k)] = v
 	}
 
 	// Keys are local paths.
@@ -1,9 +1,9 @@
 package sync
 
 import (
+	"fmt"
 	"runtime"
 	"testing"
-	"time"
 
 	"github.com/databricks/cli/libs/fileset"
 	"github.com/databricks/cli/libs/vfs"
@@ -27,16 +27,29 @@ func TestSnapshotState(t *testing.T) {
 	// has been ignored.
 	s, err := NewSnapshotState(files)
 	require.NoError(t, err)
-	assertKeysOfMap(t, s.LastModifiedTimes, []string{"valid-nb.ipynb", "my-nb.py", "my-script.py"})
+	assertKeysOfMap(t, s.ContentHashes, []string{"valid-nb.ipynb", "my-nb.py", "my-script.py"})
 	assertKeysOfMap(t, s.LocalToRemoteNames, []string{"valid-nb.ipynb", "my-nb.py", "my-script.py"})
 	assertKeysOfMap(t, s.RemoteToLocalNames, []string{"valid-nb", "my-nb", "my-script.py"})
 	assert.NoError(t, s.validate())
 }
 
+func TestSnapshotStateHashError(t *testing.T) {
+	fileSet := fileset.New(vfs.MustNew("./testdata/sync-fileset"))
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+
+	// Files that cannot be read must not be left out of the state,
+	// otherwise their remote version would be deleted.
+	_, err = newSnapshotState(files, func(f *fileset.File) (string, error) {
+		return "", fmt.Errorf("permission denied")
+	})
+	assert.ErrorContains(t, err, "failed to compute hash of my-nb.py: permission denied")
+}
+
 func TestSnapshotStateValidationErrors(t *testing.T) {
 	s := &SnapshotState{
-		LastModifiedTimes: map[string]time.Time{
-			"a": time.Now(),
+		ContentHashes: map[string]string{
+			"a": "hash",
 		},
 		LocalToRemoteNames: make(map[string]string),
 		RemoteToLocalNames: make(map[string]string),
@@ -44,7 +57,7 @@ func TestSnapshotStateValidationErrors(t *testing.T) {
 	assert.EqualError(t, s.validate(), "invalid sync state representation. Local file a is missing the corresponding remote file")
 
 	s = &SnapshotState{
-		LastModifiedTimes:  map[string]time.Time{},
+		ContentHashes:      map[string]string{},
 		LocalToRemoteNames: make(map[string]string),
 		RemoteToLocalNames: map[string]string{
 			"a": "b",
@@ -53,8 +66,8 @@ func TestSnapshotStateValidationErrors(t *testing.T) {
 	assert.EqualError(t, s.validate(), "invalid sync state representation. local file b is missing the corresponding remote file")
 
 	s = &SnapshotState{
-		LastModifiedTimes: map[string]time.Time{
-			"a": time.Now(),
+		ContentHashes: map[string]string{
+			"a": "hash",
 		},
 		LocalToRemoteNames: map[string]string{
 			"a": "b",
@@ -64,7 +77,7 @@ func TestSnapshotStateValidationErrors(t *testing.T) {
 	assert.EqualError(t, s.validate(), "invalid sync state representation. Remote file b is missing the corresponding local file")
 
 	s = &SnapshotState{
-		LastModifiedTimes: make(map[string]time.Time),
+		ContentHashes: make(map[string]string),
 		LocalToRemoteNames: map[string]string{
 			"a": "b",
 		},
@@ -72,11 +85,11 @@ func TestSnapshotStateValidationErrors(t *testing.T) {
 			"b": "a",
 		},
 	}
-	assert.EqualError(t, s.validate(), "invalid sync state representation. Local file a is missing it's last modified time")
+	assert.EqualError(t, s.validate(), "invalid sync state representation. Local file a is missing its content hash")
 
 	s = &SnapshotState{
-		LastModifiedTimes: map[string]time.Time{
-			"a": time.Now(),
+		ContentHashes: map[string]string{
+			"a": "hash",
 		},
 		LocalToRemoteNames: map[string]string{
 			"a": "b",
@@ -88,9 +101,9 @@ func TestSnapshotStateValidationErrors(t *testing.T) {
 	assert.EqualError(t, s.validate(), "invalid sync state representation. Inconsistent values found. Local file a points to b. Remote file b points to b")
 
 	s = &SnapshotState{
-		LastModifiedTimes: map[string]time.Time{
-			"a": time.Now(),
-			"c": time.Now(),
+		ContentHashes: map[string]string{
+			"a": "hash",
+			"c": "hash",
 		},
 		LocalToRemoteNames: map[string]string{
 			"a": "b",
@@ -103,8 +116,8 @@ func TestSnapshotStateValidationErrors(t *testing.T) {
 	assert.EqualError(t, s.validate(), "invalid sync state representation. Inconsistent values found. Local file c points to b. Remote file b points to a")
 
 	s = &SnapshotState{
-		LastModifiedTimes: map[string]time.Time{
-			"a": time.Now(),
+		ContentHashes: map[string]string{
+			"a": "hash",
 		},
 		LocalToRemoteNames: map[string]string{
 			"a": "b",
@@ -122,10 +135,9 @@ func TestSnapshotStateWithBackslashes(t *testing.T) {
 		t.Skip("Skipping test on non-Windows platform")
 	}
 
-	now := time.Now()
 	s1 := &SnapshotState{
-		LastModifiedTimes: map[string]time.Time{
-			"foo\\bar.py": now,
+		ContentHashes: map[string]string{
+			"foo\\bar.py": "hash",
 		},
 		LocalToRemoteNames: map[string]string{
 			"foo\\bar.py": "foo/bar",
@@ -139,7 +151,7 @@ func TestSnapshotStateWithBackslashes(t *testing.T) {
 
 	s2 := s1.ToSlash()
 	assert.NoError(t, s1.validate())
-	assert.Equal(t, map[string]time.Time{"foo/bar.py": now}, s2.LastModifiedTimes)
+	assert.Equal(t, map[string]string{"foo/bar.py": "hash"}, s2.ContentHashes)
 	assert.Equal(t, map[string]string{"foo/bar.py": "foo/bar"}, s2.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"foo/bar": "foo/bar.py"}, s2.RemoteToLocalNames)
 }
@@ -34,7 +34,7 @@ func TestDiff(t *testing.T) {
 	require.NoError(t, err)
 	state := Snapshot{
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -55,7 +55,7 @@ func TestDiff(t *testing.T) {
 	assert.Len(t, change.put, 2)
 	assert.Contains(t, change.put, "hello.txt")
 	assert.Contains(t, change.put, "world.txt")
-	assertKeysOfMap(t, state.LastModifiedTimes, []string{"hello.txt", "world.txt"})
+	assertKeysOfMap(t, state.ContentHashes, []string{"hello.txt", "world.txt"})
 	assert.Equal(t, map[string]string{"hello.txt": "hello.txt", "world.txt": "world.txt"}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"hello.txt": "hello.txt", "world.txt": "world.txt"}, state.RemoteToLocalNames)
 
@@ -70,7 +70,7 @@ func TestDiff(t *testing.T) {
 	assert.Len(t, change.delete, 0)
 	assert.Len(t, change.put, 1)
 	assert.Contains(t, change.put, "world.txt")
-	assertKeysOfMap(t, state.LastModifiedTimes, []string{"hello.txt", "world.txt"})
+	assertKeysOfMap(t, state.ContentHashes, []string{"hello.txt", "world.txt"})
 	assert.Equal(t, map[string]string{"hello.txt": "hello.txt", "world.txt": "world.txt"}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"hello.txt": "hello.txt", "world.txt": "world.txt"}, state.RemoteToLocalNames)
 
@@ -84,7 +84,7 @@ func TestDiff(t *testing.T) {
 	assert.Len(t, change.delete, 1)
 	assert.Len(t, change.put, 0)
 	assert.Contains(t, change.delete, "hello.txt")
-	assertKeysOfMap(t, state.LastModifiedTimes, []string{"world.txt"})
+	assertKeysOfMap(t, state.ContentHashes, []string{"world.txt"})
 	assert.Equal(t, map[string]string{"world.txt": "world.txt"}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"world.txt": "world.txt"}, state.RemoteToLocalNames)
 }
@@ -98,7 +98,7 @@ func TestSymlinkDiff(t *testing.T) {
 	require.NoError(t, err)
 	state := Snapshot{
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -129,7 +129,7 @@ func TestFolderDiff(t *testing.T) {
 	require.NoError(t, err)
 	state := Snapshot{
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -174,7 +174,7 @@ func TestPythonNotebookDiff(t *testing.T) {
 	require.NoError(t, err)
 	state := Snapshot{
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -192,7 +192,7 @@ func TestPythonNotebookDiff(t *testing.T) {
 	assert.Len(t, change.delete, 0)
 	assert.Len(t, change.put, 1)
 	assert.Contains(t, change.put, "foo.py")
-	assertKeysOfMap(t, state.LastModifiedTimes, []string{"foo.py"})
+	assertKeysOfMap(t, state.ContentHashes, []string{"foo.py"})
 	assert.Equal(t, map[string]string{"foo.py": "foo"}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"foo": "foo.py"}, state.RemoteToLocalNames)
 
@@ -207,7 +207,7 @@ func TestPythonNotebookDiff(t *testing.T) {
 	assert.Len(t, change.put, 1)
 	assert.Contains(t, change.put, "foo.py")
 	assert.Contains(t, change.delete, "foo")
-	assertKeysOfMap(t, state.LastModifiedTimes, []string{"foo.py"})
+	assertKeysOfMap(t, state.ContentHashes, []string{"foo.py"})
 	assert.Equal(t, map[string]string{"foo.py": "foo.py"}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"foo.py": "foo.py"}, state.RemoteToLocalNames)
 
@@ -221,7 +221,7 @@ func TestPythonNotebookDiff(t *testing.T) {
 	assert.Len(t, change.put, 1)
 	assert.Contains(t, change.put, "foo.py")
 	assert.Contains(t, change.delete, "foo.py")
-	assertKeysOfMap(t, state.LastModifiedTimes, []string{"foo.py"})
+	assertKeysOfMap(t, state.ContentHashes, []string{"foo.py"})
 	assert.Equal(t, map[string]string{"foo.py": "foo"}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{"foo": "foo.py"}, state.RemoteToLocalNames)
 
@@ -235,7 +235,7 @@ func TestPythonNotebookDiff(t *testing.T) {
 	assert.Len(t, change.delete, 1)
 	assert.Len(t, change.put, 0)
 	assert.Contains(t, change.delete, "foo")
-	assert.Len(t, state.LastModifiedTimes, 0)
+	assert.Len(t, state.ContentHashes, 0)
 	assert.Equal(t, map[string]string{}, state.LocalToRemoteNames)
 	assert.Equal(t, map[string]string{}, state.RemoteToLocalNames)
 }
@@ -249,7 +249,7 @@ func TestErrorWhenIdenticalRemoteName(t *testing.T) {
 	require.NoError(t, err)
 	state := Snapshot{
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -286,7 +286,7 @@ func TestNoErrorRenameWithIdenticalRemoteName(t *testing.T) {
 	require.NoError(t, err)
 	state := Snapshot{
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
+			ContentHashes:      make(map[string]string),
 			LocalToRemoteNames: make(map[string]string),
 			RemoteToLocalNames: make(map[string]string),
 		},
@@ -334,7 +334,7 @@ func TestNewSnapshotDefaults(t *testing.T) {
 	assert.Equal(t, LatestSnapshotVersion, snapshot.Version)
 	assert.Equal(t, opts.RemotePath, snapshot.RemotePath)
 	assert.Equal(t, opts.Host, snapshot.Host)
-	assert.Empty(t, snapshot.LastModifiedTimes)
+	assert.Empty(t, snapshot.ContentHashes)
 	assert.Empty(t, snapshot.RemoteToLocalNames)
 	assert.Empty(t, snapshot.LocalToRemoteNames)
 }
@@ -409,3 +409,213 @@ func TestLatestVersionSnapshotGetsLoaded(t *testing.T) {
 	assert.Equal(t, "www.foobar.com", snapshot.Host)
 	assert.Equal(t, "/Repos/foo/bar", snapshot.RemotePath)
 }
+
+func TestDiffIgnoresModifiedTimeWithoutContentChange(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(vfs.MustNew(projectDir))
+	require.NoError(t, err)
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			ContentHashes:      make(map[string]string),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+	}
+
+	helloFilePath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloFilePath)
+	defer f1.Close(t)
+	f1.Overwrite(t, "hello")
+
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+
+	// Touching the file does not change its contents.
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(helloFilePath, future, future))
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.True(t, change.IsEmpty())
+
+	// Editing the file without changing its modified time changes its size.
+	f1.Overwrite(t, "hello world")
+	require.NoError(t, os.Chtimes(helloFilePath, future, future))
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+}
+
+func TestDiffReusesHashOfUnchangedFiles(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(vfs.MustNew(projectDir))
+	require.NoError(t, err)
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			ContentHashes:      make(map[string]string),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+	}
+
+	helloFilePath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloFilePath)
+	defer f1.Close(t)
+	f1.Overwrite(t, "hello")
+
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+	_, err = state.diff(ctx, files)
+	require.NoError(t, err)
+	hash := state.ContentHashes["hello.txt"]
+
+	// Files with the same modified time and size are not read again.
+	// This is observable by replacing the recorded hash.
+	state.ContentHashes["hello.txt"] = "recorded"
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.True(t, change.IsEmpty())
+	assert.Equal(t, "recorded", state.ContentHashes["hello.txt"])
+
+	// Files with a different modified time are read again.
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(helloFilePath, future, future))
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+	assert.Equal(t, hash, state.ContentHashes["hello.txt"])
+}
+
+func TestV1SnapshotGetsMigrated(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(vfs.MustNew(projectDir))
+	require.NoError(t, err)
+
+	f1 := testfile.CreateFile(t, filepath.Join(projectDir, "hello.txt"))
+	defer f1.Close(t)
+	f2 := testfile.CreateFile(t, filepath.Join(projectDir, "world.txt"))
+	defer f2.Close(t)
+
+	// The snapshot records hello.txt as unchanged and world.txt as modified since the last sync.
+	v1Snapshot := fmt.Sprintf(`{
+		"version": "v1",
+		"host": "www.foobar.com",
+		"remote_path": "/Repos/foo/bar",
+		"last_modified_times": {
+			"hello.txt": "%s",
+			"world.txt": "%s"
+		},
+		"local_to_remote_names": {
+			"hello.txt": "hello.txt",
+			"world.txt": "world.txt"
+		},
+		"remote_to_local_names": {
+			"hello.txt": "hello.txt",
+			"world.txt": "world.txt"
+		}
+	}`, time.Now().Add(time.Hour).Format(time.RFC3339), time.Unix(0, 0).Format(time.RFC3339))
+
+	opts := defaultOptions(t)
+	snapshotPath, err := SnapshotPath(opts)
+	require.NoError(t, err)
+	snapshotFile := testfile.CreateFile(t, snapshotPath)
+	snapshotFile.Overwrite(t, v1Snapshot)
+	snapshotFile.Close(t)
+
+	snapshot, err := loadOrNewSnapshot(ctx, opts)
+	require.NoError(t, err)
+	assert.False(t, snapshot.New)
+	assert.Equal(t, LatestSnapshotVersion, snapshot.Version)
+	assert.Equal(t, map[string]string{"hello.txt": "", "world.txt": ""}, snapshot.ContentHashes)
+
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+	change, err := snapshot.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"world.txt"}, change.put)
+	assert.Empty(t, change.delete)
+	assertKeysOfMap(t, snapshot.ContentHashes, []string{"hello.txt", "world.txt"})
+	assert.NotEmpty(t, snapshot.ContentHashes["hello.txt"])
+}
+
+func TestNewSnapshotWithHashes(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	root := vfs.MustNew(projectDir)
+	fileSet, err := git.NewFileSet(root)
+	require.NoError(t, err)
+
+	f1 := testfile.CreateFile(t, filepath.Join(projectDir, "hello.txt"))
+	defer f1.Close(t)
+	f2 := testfile.CreateFile(t, filepath.Join(projectDir, "world.txt"))
+	defer f2.Close(t)
+	f3 := testfile.CreateFile(t, filepath.Join(projectDir, "new.txt"))
+	defer f3.Close(t)
+
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+	state, err := NewSnapshotState(files)
+	require.NoError(t, err)
+
+	// Only hello.txt has a recorded hash that matches its contents.
+	snapshot, err := NewSnapshot(files, map[string]string{
+		"hello.txt": state.ContentHashes["hello.txt"],
+	}, defaultOptions(t))
+	require.NoError(t, err)
+
+	change, err := snapshot.diff(ctx, files)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"new.txt", "world.txt"}, change.put)
+}
+
+func TestNewSnapshotDetectsChangeWithSameModifiedTimeAndSize(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(vfs.MustNew(projectDir))
+	require.NoError(t, err)
+
+	helloFilePath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloFilePath)
+	defer f1.Close(t)
+	f1.Overwrite(t, "hello")
+
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+	state, err := NewSnapshotState(files)
+	require.NoError(t, err)
+	info, err := os.Stat(helloFilePath)
+	require.NoError(t, err)
+
+	// Edit the file after it was deployed without changing its modified time or size.
+	f1.Overwrite(t, "jello")
+	require.NoError(t, os.Chtimes(helloFilePath, info.ModTime(), info.ModTime()))
+
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	snapshot, err := NewSnapshot(files, map[string]string{
+		"hello.txt": state.ContentHashes["hello.txt"],
+	}, defaultOptions(t))
+	require.NoError(t, err)
+
+	change, err := snapshot.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+}
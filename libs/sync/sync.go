@@ -224,20 +224,3 @@ func (s *Sync) GetFileList(ctx context.Context) ([]fileset.File, error) {
 
 	return all.Iter(), nil
 }
-
-func (s *Sync) RunContinuous(ctx context.Context) error {
-	ticker := time.NewTicker(s.PollInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
-			_, err := s.RunOnce(ctx)
-			if err != nil {
-				return err
-			}
-		}
-	}
-}
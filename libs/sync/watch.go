@@ -0,0 +1,127 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/databricks/cli/libs/log"
+)
+
+// Quiet period after a change before a sync iteration starts.
+// Changes that arrive during this period are batched into the same iteration.
+const watchDebounce = 100 * time.Millisecond
+
+// Maximum delay between the first change of a batch and the sync iteration
+// that includes it, for changes that arrive continuously.
+const watchMaxDelay = time.Second
+
+// A watcher notifies about changes to files under the local root.
+type watcher interface {
+	// Changes receives a value whenever files under the local root may have changed.
+	Changes() <-chan struct{}
+
+	// Errors receives a value if the watcher can no longer notify about changes,
+	// for example because the limit of watches is exceeded.
+	Errors() <-chan error
+
+	Close() error
+}
+
+// watchIgnorer returns the function the watcher uses to decide whether a
+// directory must be watched. Directories with files that are synchronized
+// must not be ignored.
+func (s *Sync) watchIgnorer() func(dir string) (bool, error) {
+	// Include patterns may match files in directories that are ignored
+	// by git, so only skip directories that are never synchronized.
+	if len(s.Include) > 0 {
+		return func(dir string) (bool, error) {
+			return dir == ".git" || dir == ".databricks", nil
+		}
+	}
+
+	return nil
+}
+
+// RunContinuous synchronizes the local root to the remote path whenever files change.
+// It relies on file system notifications if available and falls back to polling
+// the local root every [SyncOptions.PollInterval] otherwise.
+func (s *Sync) RunContinuous(ctx context.Context) error {
+	w, err := newWatcher(ctx, s.LocalRoot, s.Paths, s.watchIgnorer())
+	if err != nil {
+		log.Infof(ctx, "Polling for changes every %s: %s", s.PollInterval, err)
+		return s.runPolling(ctx)
+	}
+
+	return s.runWatching(ctx, w)
+}
+
+func (s *Sync) runWatching(ctx context.Context, w watcher) error {
+	defer w.Close()
+
+	// Synchronize changes made before the watcher was started.
+	_, err := s.RunOnce(ctx)
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(0)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+
+	// Time of the first change that is not yet synchronized.
+	var first time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-w.Changes():
+			now := time.Now()
+			if first.IsZero() {
+				first = now
+			}
+
+			// Wait for the burst of changes to end, but not longer than the maximum delay.
+			delay := min(watchDebounce, first.Add(watchMaxDelay).Sub(now))
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(max(delay, 0))
+
+		case err := <-w.Errors():
+			log.Warnf(ctx, "Polling for changes every %s: %s", s.PollInterval, err)
+			w.Close()
+			return s.runPolling(ctx)
+
+		case <-timer.C:
+			first = time.Time{}
+			_, err := s.RunOnce(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Sync) runPolling(ctx context.Context) error {
+	ticker := time.NewTicker(s.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			_, err := s.RunOnce(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/databricks/cli/libs/git"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/vfs"
+	"github.com/fsnotify/fsnotify"
+)
+
+type fsnotifyWatcher struct {
+	ctx  context.Context
+	root vfs.Path
+
+	// Paths relative to the root to watch.
+	paths []string
+
+	// Returns whether a directory must not be watched.
+	ignore func(dir string) (bool, error)
+
+	// If set, the ignore rules are loaded from the git view
+	// and reloaded when a .gitignore file changes.
+	useGitIgnore bool
+
+	watcher *fsnotify.Watcher
+
+	// Watched directories by path relative to the root.
+	// Only accessed by the goroutine reading events after initialization.
+	watched map[string]struct{}
+
+	changes chan struct{}
+	errors  chan error
+}
+
+// newWatcher returns a watcher that uses the file system notifications of the
+// platform to watch the specified paths relative to the root.
+// If ignore is nil, directories ignored by git are not watched.
+func newWatcher(ctx context.Context, root vfs.Path, paths []string, ignore func(dir string) (bool, error)) (watcher, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize file system notifications: %w", err)
+	}
+
+	w := &fsnotifyWatcher{
+		ctx:          ctx,
+		root:         root,
+		paths:        paths,
+		ignore:       ignore,
+		useGitIgnore: ignore == nil,
+		watcher:      fw,
+		watched:      make(map[string]struct{}),
+		changes:      make(chan struct{}, 1),
+		errors:       make(chan error, 1),
+	}
+
+	err = w.loadIgnoreRules()
+	if err != nil {
+		fw.Close()
+		return nil, err
+	}
+
+	err = w.addAll()
+	if err != nil {
+		fw.Close()
+		return nil, err
+	}
+
+	go w.read()
+	return w, nil
+}
+
+func (w *fsnotifyWatcher) Changes() <-chan struct{} {
+	return w.changes
+}
+
+func (w *fsnotifyWatcher) Errors() <-chan error {
+	return w.errors
+}
+
+func (w *fsnotifyWatcher) Close() error {
+	return w.watcher.Close()
+}
+
+// loadIgnoreRules loads a fresh copy of the git ignore rules. The watcher
+// uses its own view because the view of the syncer is not safe for concurrent use.
+func (w *fsnotifyWatcher) loadIgnoreRules() error {
+	if !w.useGitIgnore {
+		return nil
+	}
+
+	view, err := git.NewView(w.root)
+	if err != nil {
+		return err
+	}
+
+	w.ignore = view.IgnoreDirectory
+	return nil
+}
+
+// addAll adds watches for all directories under the watched paths.
+func (w *fsnotifyWatcher) addAll() error {
+	for _, p := range w.paths {
+		err := w.add(path.Clean(p))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add adds watches for the specified directory and its subdirectories.
+// Directories that are already watched are skipped.
+func (w *fsnotifyWatcher) add(dir string) error {
+	return fs.WalkDir(w.root, dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// The directory may have been removed in the meantime.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		if name != "." {
+			ign, err := w.ignore(name)
+			if err != nil {
+				return fmt.Errorf("cannot check if %s should be ignored: %w", name, err)
+			}
+			if ign {
+				return fs.SkipDir
+			}
+		}
+
+		if _, ok := w.watched[name]; ok {
+			return nil
+		}
+
+		err = w.watcher.Add(w.nativePath(name))
+		if errors.Is(err, syscall.ENOSPC) {
+			return fmt.Errorf("watch limit exceeded; consider increasing fs.inotify.max_user_watches")
+		}
+		if err != nil {
+			// The directory may have been removed in the meantime.
+			if errors.Is(err, fs.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+				return fs.SkipDir
+			}
+			return fmt.Errorf("cannot watch %s: %w", name, err)
+		}
+
+		w.watched[name] = struct{}{}
+		return nil
+	})
+}
+
+// remove removes the watches for the specified directory and its subdirectories.
+func (w *fsnotifyWatcher) remove(dir string) {
+	for name := range w.watched {
+		if name != dir && !strings.HasPrefix(name, dir+"/") {
+			continue
+		}
+
+		// The watch may already have been removed because the directory was deleted.
+		_ = w.watcher.Remove(w.nativePath(name))
+		delete(w.watched, name)
+	}
+}
+
+func (w *fsnotifyWatcher) nativePath(name string) string {
+	return filepath.Join(w.root.Native(), filepath.FromSlash(name))
+}
+
+func (w *fsnotifyWatcher) notify() {
+	select {
+	case w.changes <- struct{}{}:
+	default:
+		// A change is already pending.
+	}
+}
+
+func (w *fsnotifyWatcher) fail(err error) {
+	select {
+	case w.errors <- err:
+	default:
+	}
+}
+
+func (w *fsnotifyWatcher) read() {
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			err := w.handle(event)
+			if err != nil {
+				w.fail(err)
+				return
+			}
+
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			// Events were dropped, so any file may have changed.
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				w.notify()
+				continue
+			}
+
+			w.fail(fmt.Errorf("cannot read file system notifications: %w", err))
+			return
+		}
+	}
+}
+
+// handle processes a single file system notification.
+func (w *fsnotifyWatcher) handle(event fsnotify.Event) error {
+	rel, err := filepath.Rel(w.root.Native(), event.Name)
+	if err != nil {
+		return nil
+	}
+
+	name := filepath.ToSlash(rel)
+	defer w.notify()
+
+	switch {
+	case path.Base(name) == ".gitignore" && w.useGitIgnore:
+		// Directories that were ignored before may have to be watched now.
+		log.Debugf(w.ctx, "Reloading ignore rules after change to %s", name)
+		err := w.loadIgnoreRules()
+		if err != nil {
+			return err
+		}
+		return w.addAll()
+
+	case event.Has(fsnotify.Create):
+		// Only directories are added; files are skipped by the walk.
+		return w.add(name)
+
+	case event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename):
+		w.remove(name)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/vfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func expectChange(t *testing.T, w watcher) {
+	select {
+	case <-w.Changes():
+	case err := <-w.Errors():
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a change notification")
+	}
+
+	// A single change may produce multiple events, for example
+	// when a file is created and written to. Drain them.
+	for {
+		select {
+		case <-w.Changes():
+		case <-time.After(50 * time.Millisecond):
+			return
+		}
+	}
+}
+
+func expectNoChange(t *testing.T, w watcher) {
+	select {
+	case <-w.Changes():
+		t.Fatal("unexpected change notification")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestFsnotifyWatcher(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("ignored/\n"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "ignored"), 0755))
+
+	w, err := newWatcher(context.Background(), vfs.MustNew(dir), []string{"."}, nil)
+	require.NoError(t, err)
+	defer w.Close()
+
+	// Changes to files in the root are reported.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.py"), []byte("foo"), 0644))
+	expectChange(t, w)
+
+	// Changes to files in new directories are reported.
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "src"), 0755))
+	expectChange(t, w)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "bar.py"), []byte("bar"), 0644))
+	expectChange(t, w)
+
+	// Changes to files in ignored directories are not reported.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored", "baz.py"), []byte("baz"), 0644))
+	expectNoChange(t, w)
+
+	// Directories that are no longer ignored are watched.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte(""), 0644))
+	expectChange(t, w)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored", "baz.py"), []byte("qux"), 0644))
+	expectChange(t, w)
+
+	assert.NoError(t, w.Close())
+}
+
+func TestFsnotifyWatcherWithIgnoreFunc(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("build/\n"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "build"), 0755))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".databricks"), 0755))
+
+	s := &Sync{SyncOptions: &SyncOptions{Include: []string{"build/*.whl"}}}
+	w, err := newWatcher(context.Background(), vfs.MustNew(dir), []string{"."}, s.watchIgnorer())
+	require.NoError(t, err)
+	defer w.Close()
+
+	// Directories ignored by git are watched if include patterns are specified.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "foo.whl"), []byte("foo"), 0644))
+	expectChange(t, w)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".databricks", "snapshot.json"), []byte("{}"), 0644))
+	expectNoChange(t, w)
+}